@@ -0,0 +1,82 @@
+package main
+
+import (
+	"github.com/GDH-Project/api/internal/authcookie"
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/handler"
+	"github.com/GDH-Project/api/internal/health"
+	m "github.com/GDH-Project/api/internal/middleware"
+	"github.com/danielgtaylor/huma/v2"
+	"go.uber.org/zap"
+)
+
+// dependencies handler 등록에 필요한 의존성 입니다.
+type dependencies struct {
+	authUseCase            domain.AuthUseCase
+	userUseCase            domain.UserUseCase
+	metaUseCase            domain.MetaUseCase
+	deviceUseCase          domain.DeviceUseCase
+	auditUseCase           domain.AuditUseCase
+	oauthUseCase           domain.OAuthUseCase
+	emailUseCase           domain.EmailUseCase
+	adminUseCase           domain.AdminUseCase
+	tokenUseCase           domain.PersonalAccessTokenUseCase
+	sessionUseCase         domain.SessionUseCase
+	twoFactorUseCase       domain.TwoFactorUseCase
+	accountDeletionUseCase domain.AccountDeletionUseCase
+	rollupUseCase          domain.RollupUseCase
+	retentionUseCase       domain.RetentionUseCase
+	healthChecker          *health.Checker
+	cacheTTL               handler.MetaCacheTTL
+	cookie                 *authcookie.Options
+	oauthCookie            *authcookie.Options
+}
+
+// newHumaConfig huma 설정을 생성합니다.
+func newHumaConfig() huma.Config {
+	humaConfig := huma.DefaultConfig("GDH-API 서버 입니다.", Version)
+	humaConfig.CreateHooks = nil
+	// 에러 응답에 요청 ID 를 포함한다.
+	huma.NewError = handler.NewError
+	humaConfig.Transformers = append(humaConfig.Transformers, handler.ErrorTransformer)
+	humaConfig.SchemasPath = ""
+	humaConfig.Components.SecuritySchemes = map[string]*huma.SecurityScheme{
+		"bearer": {
+			Type:         "http",
+			Scheme:       "bearer",
+			BearerFormat: "JWT",
+		},
+		"cookie": {
+			Type:        "apiKey",
+			In:          "cookie",
+			Name:        authcookie.AccessTokenCookie,
+			Description: "쿠키 인증이 활성화 된 경우 사용할 수 있습니다. GET 이외의 요청은 X-CSRF-Token 헤더가 필요합니다.",
+		},
+		"personal_access_token": {
+			Type:        "http",
+			Scheme:      "bearer",
+			Description: "개인 액세스 토큰(gdhp_ 로 시작) 입니다. API 에 표시된 범위(scope)를 모두 가진 토큰만 사용할 수 있습니다.",
+		},
+	}
+
+	return humaConfig
+}
+
+// registerHandlers 모든 API 를 등록합니다.
+//
+// openapi 명령에서 DB, gRPC 연결 없이 문서를 생성할 수 있도록 handler 등록 시점에는 의존성을 호출하지 않아야 합니다.
+func registerHandlers(api huma.API, log *zap.Logger, middleware m.Middleware, d *dependencies) {
+	handler.RegisterAuthHandler(api, log, d.authUseCase, d.userUseCase, d.accountDeletionUseCase, d.oauthUseCase, d.emailUseCase, d.auditUseCase, d.cookie, d.oauthCookie, middleware)
+	handler.RegisterOAuthHandler(api, log, d.oauthUseCase, d.oauthCookie)
+	handler.RegisterEmailHandler(api, log, d.emailUseCase, d.auditUseCase, middleware)
+	handler.RegisterPersonalAccessTokenHandler(api, log, d.tokenUseCase, d.auditUseCase, middleware)
+	handler.RegisterSessionHandler(api, log, d.sessionUseCase, d.auditUseCase, middleware)
+	handler.RegisterTwoFactorHandler(api, log, d.twoFactorUseCase, d.auditUseCase, middleware)
+	handler.RegisterDeviceHandler(api, log, d.deviceUseCase, d.auditUseCase, middleware)
+	handler.RegisterAccountDeletionHandler(api, log, d.accountDeletionUseCase, middleware)
+	handler.RegisterRollupHandler(api, log, d.rollupUseCase, middleware)
+	handler.RegisterRetentionHandler(api, log, d.retentionUseCase, d.auditUseCase, middleware)
+	handler.RegisterAdminHandler(api, log, d.authUseCase, d.adminUseCase, d.auditUseCase, middleware)
+	handler.RegisterMetaHandler(api, log, d.metaUseCase, d.cacheTTL, middleware)
+	handler.RegisterHealthHandler(api, log, d.healthChecker)
+}
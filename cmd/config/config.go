@@ -0,0 +1,577 @@
+package config
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/GDH-Project/api/internal/authcookie"
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/handler"
+	"github.com/GDH-Project/api/internal/mailer"
+	"github.com/GDH-Project/api/internal/oauth"
+	"github.com/GDH-Project/api/internal/resource"
+)
+
+// Config
+//
+// 서버 전체 설정 입니다.
+// 기본값 -> 설정 파일(yaml, toml) -> 환경변수 순서로 덮어씁니다.
+// secret 태그가 있는 값은 출력시 마스킹 됩니다.
+type Config struct {
+	Server  ServerConfig  `json:"server" yaml:"server" toml:"server"`
+	DB      DBConfig      `json:"db" yaml:"db" toml:"db"`
+	Grpc    GrpcConfig    `json:"grpc" yaml:"grpc" toml:"grpc"`
+	Log     LogConfig     `json:"log" yaml:"log" toml:"log"`
+	Cors    CorsConfig    `json:"cors" yaml:"cors" toml:"cors"`
+	Cache   CacheConfig   `json:"cache" yaml:"cache" toml:"cache"`
+	Health  HealthConfig  `json:"health" yaml:"health" toml:"health"`
+	Metrics MetricsConfig `json:"metrics" yaml:"metrics" toml:"metrics"`
+	Trace   TraceConfig   `json:"trace" yaml:"trace" toml:"trace"`
+
+	RateLimit RateLimitConfig `json:"rate_limit" yaml:"rate_limit" toml:"rate_limit"`
+	SignIn    SignInConfig    `json:"sign_in" yaml:"sign_in" toml:"sign_in"`
+	Cookie    CookieConfig    `json:"cookie" yaml:"cookie" toml:"cookie"`
+	OAuth     OAuthConfig     `json:"oauth" yaml:"oauth" toml:"oauth"`
+	Email     EmailConfig     `json:"email" yaml:"email" toml:"email"`
+
+	PersonalAccessToken PersonalAccessTokenConfig `json:"personal_access_token" yaml:"personal_access_token" toml:"personal_access_token"`
+	Session             SessionConfig             `json:"session" yaml:"session" toml:"session"`
+	TwoFactor           TwoFactorConfig           `json:"two_factor" yaml:"two_factor" toml:"two_factor"`
+	AccountDeletion     AccountDeletionConfig     `json:"account_deletion" yaml:"account_deletion" toml:"account_deletion"`
+	Rollup              RollupConfig              `json:"rollup" yaml:"rollup" toml:"rollup"`
+	Retention           RetentionConfig           `json:"retention" yaml:"retention" toml:"retention"`
+}
+
+// ServerConfig HTTP 서버 설정
+//
+// TrustedPlatform, TrustedProxies 는 클라이언트 IP 헤더를 신뢰할 플랫폼과 프록시 입니다.
+// 클라이언트가 헤더로 IP 를 위조할 수 있으므로 해당 플랫폼, 프록시 뒤에서 실행하는 경우에만 설정합니다.
+// DrainDelay 는 종료시 readiness 를 false 로 바꾼 뒤 로드밸런서가 확인할 수 있도록 요청을 계속 받는 시간 입니다.
+type ServerConfig struct {
+	ListenAddr        string   `json:"listen_addr" yaml:"listen_addr" toml:"listen_addr" env:"SERVER_LISTEN_ADDR"`
+	HostUrl           string   `json:"host_url" yaml:"host_url" toml:"host_url" env:"HOST_URL"`
+	TrustedPlatform   string   `json:"trusted_platform" yaml:"trusted_platform" toml:"trusted_platform" env:"SERVER_TRUSTED_PLATFORM"`
+	TrustedProxies    []string `json:"trusted_proxies" yaml:"trusted_proxies" toml:"trusted_proxies" env:"SERVER_TRUSTED_PROXIES" envSeparator:","`
+	ReadTimeout       Duration `json:"read_timeout" yaml:"read_timeout" toml:"read_timeout" env:"SERVER_READ_TIMEOUT"`
+	ReadHeaderTimeout Duration `json:"read_header_timeout" yaml:"read_header_timeout" toml:"read_header_timeout" env:"SERVER_READ_HEADER_TIMEOUT"`
+	WriteTimeout      Duration `json:"write_timeout" yaml:"write_timeout" toml:"write_timeout" env:"SERVER_WRITE_TIMEOUT"`
+	IdleTimeout       Duration `json:"idle_timeout" yaml:"idle_timeout" toml:"idle_timeout" env:"SERVER_IDLE_TIMEOUT"`
+	ShutdownTimeout   Duration `json:"shutdown_timeout" yaml:"shutdown_timeout" toml:"shutdown_timeout" env:"SERVER_SHUTDOWN_TIMEOUT"`
+	DrainDelay        Duration `json:"drain_delay" yaml:"drain_delay" toml:"drain_delay" env:"SERVER_DRAIN_DELAY"`
+}
+
+// DBConfig Postgres 커넥션 풀 설정
+//
+// MaxConns 가 0 이면 pgx 기본값(max(4, CPU 수))을 사용합니다.
+type DBConfig struct {
+	Url               string   `json:"url" yaml:"url" toml:"url" env:"DB_URL" secret:"true"`
+	MaxConns          int32    `json:"max_conns" yaml:"max_conns" toml:"max_conns" env:"DB_MAX_CONNS"`
+	MinConns          int32    `json:"min_conns" yaml:"min_conns" toml:"min_conns" env:"DB_MIN_CONNS"`
+	MaxConnLifetime   Duration `json:"max_conn_lifetime" yaml:"max_conn_lifetime" toml:"max_conn_lifetime" env:"DB_MAX_CONN_LIFETIME"`
+	MaxConnIdleTime   Duration `json:"max_conn_idle_time" yaml:"max_conn_idle_time" toml:"max_conn_idle_time" env:"DB_MAX_CONN_IDLE_TIME"`
+	HealthCheckPeriod Duration `json:"health_check_period" yaml:"health_check_period" toml:"health_check_period" env:"DB_HEALTH_CHECK_PERIOD"`
+	AutoMigrate       bool     `json:"auto_migrate" yaml:"auto_migrate" toml:"auto_migrate" env:"DB_AUTO_MIGRATE"`
+}
+
+// PoolConfig resource.PoolConfig 로 변환합니다.
+func (c *DBConfig) PoolConfig() resource.PoolConfig {
+	return resource.PoolConfig{
+		Url:               c.Url,
+		MaxConns:          c.MaxConns,
+		MinConns:          c.MinConns,
+		MaxConnLifetime:   c.MaxConnLifetime.Std(),
+		MaxConnIdleTime:   c.MaxConnIdleTime.Std(),
+		HealthCheckPeriod: c.HealthCheckPeriod.Std(),
+	}
+}
+
+// GrpcConfig gRPC 서버 주소 설정
+//
+// UserServer 가 비어있으면 AuthServer 를 사용합니다.
+type GrpcConfig struct {
+	AuthServer string `json:"auth_server" yaml:"auth_server" toml:"auth_server" env:"AUTH_GRPC_SERVER"`
+	UserServer string `json:"user_server" yaml:"user_server" toml:"user_server" env:"USER_GRPC_SERVER"`
+}
+
+// LogConfig 로그 설정
+type LogConfig struct {
+	Level  string `json:"level" yaml:"level" toml:"level" env:"LOG_LEVEL"`
+	Format string `json:"format" yaml:"format" toml:"format" env:"LOG_FORMAT"`
+}
+
+// CorsConfig CORS 설정
+type CorsConfig struct {
+	HostList []string `json:"host_list" yaml:"host_list" toml:"host_list" env:"CORS_HOST_LIST" envSeparator:","`
+}
+
+// CacheConfig 응답 Cache-Control max-age 설정
+type CacheConfig struct {
+	SensorTTL      Duration `json:"sensor_ttl" yaml:"sensor_ttl" toml:"sensor_ttl" env:"CACHE_SENSOR_TTL"`
+	CropTTL        Duration `json:"crop_ttl" yaml:"crop_ttl" toml:"crop_ttl" env:"CACHE_CROP_TTL"`
+	AddressTTL     Duration `json:"address_ttl" yaml:"address_ttl" toml:"address_ttl" env:"CACHE_ADDRESS_TTL"`
+	UpdateCycleTTL Duration `json:"update_cycle_ttl" yaml:"update_cycle_ttl" toml:"update_cycle_ttl" env:"CACHE_UPDATE_CYCLE_TTL"`
+}
+
+// MetaTTL handler.MetaCacheTTL 로 변환합니다.
+func (c *CacheConfig) MetaTTL() handler.MetaCacheTTL {
+	return handler.MetaCacheTTL{
+		Sensor:      c.SensorTTL.Std(),
+		Crop:        c.CropTTL.Std(),
+		Address:     c.AddressTTL.Std(),
+		UpdateCycle: c.UpdateCycleTTL.Std(),
+	}
+}
+
+// HealthConfig readiness 검사 설정
+type HealthConfig struct {
+	Timeout Duration `json:"timeout" yaml:"timeout" toml:"timeout" env:"HEALTH_CHECK_TIMEOUT"`
+}
+
+// MetricsConfig /metrics 접근 제한 설정
+type MetricsConfig struct {
+	User      string   `json:"user" yaml:"user" toml:"user" env:"METRICS_USER"`
+	Password  string   `json:"password" yaml:"password" toml:"password" env:"METRICS_PASSWORD" secret:"true"`
+	AllowList []string `json:"allow_list" yaml:"allow_list" toml:"allow_list" env:"METRICS_ALLOW_LIST" envSeparator:","`
+}
+
+// TraceConfig 트레이싱 설정
+type TraceConfig struct {
+	Exporter    string  `json:"exporter" yaml:"exporter" toml:"exporter" env:"TRACE_EXPORTER"`
+	SampleRatio float64 `json:"sample_ratio" yaml:"sample_ratio" toml:"sample_ratio" env:"TRACE_SAMPLE_RATIO"`
+}
+
+// RateLimitConfig 요청 제한 설정
+//
+// 모든 요청은 클라이언트 IP 기준, 인증된 요청은 추가로 사용자 기준으로 오퍼레이션 별 제한됩니다.
+// Store 가 postgres 인 경우 인스턴스간 카운터를 공유합니다.
+type RateLimitConfig struct {
+	Enabled    bool           `json:"enabled" yaml:"enabled" toml:"enabled" env:"RATE_LIMIT_ENABLED"`
+	Store      string         `json:"store" yaml:"store" toml:"store" env:"RATE_LIMIT_STORE"`
+	Default    RateLimitRule  `json:"default" yaml:"default" toml:"default" env:"RATE_LIMIT_DEFAULT"`
+	Operations RateLimitRules `json:"operations" yaml:"operations" toml:"operations" env:"RATE_LIMIT_OPERATIONS"`
+}
+
+// SignInConfig 로그인 실패 잠금 설정
+//
+// 이메일, IP 별 로그인 실패와 사용자별 2단계 인증 코드 실패를 FailureWindow 안에서 세고 최대 횟수에 도달하면 LockoutDuration 동안 잠급니다.
+// 잠금 전까지는 실패할 때마다 FailureDelay 부터 두배씩(MaxFailureDelay 까지) 다음 시도를 지연합니다.
+type SignInConfig struct {
+	MaxEmailFailures int      `json:"max_email_failures" yaml:"max_email_failures" toml:"max_email_failures" env:"SIGN_IN_MAX_EMAIL_FAILURES"`
+	MaxIPFailures    int      `json:"max_ip_failures" yaml:"max_ip_failures" toml:"max_ip_failures" env:"SIGN_IN_MAX_IP_FAILURES"`
+	FailureWindow    Duration `json:"failure_window" yaml:"failure_window" toml:"failure_window" env:"SIGN_IN_FAILURE_WINDOW"`
+	LockoutDuration  Duration `json:"lockout_duration" yaml:"lockout_duration" toml:"lockout_duration" env:"SIGN_IN_LOCKOUT_DURATION"`
+	FailureDelay     Duration `json:"failure_delay" yaml:"failure_delay" toml:"failure_delay" env:"SIGN_IN_FAILURE_DELAY"`
+	MaxFailureDelay  Duration `json:"max_failure_delay" yaml:"max_failure_delay" toml:"max_failure_delay" env:"SIGN_IN_MAX_FAILURE_DELAY"`
+
+	MaxTwoFactorFailures int `json:"max_two_factor_failures" yaml:"max_two_factor_failures" toml:"max_two_factor_failures" env:"SIGN_IN_MAX_TWO_FACTOR_FAILURES"`
+}
+
+// Policy domain.SignInPolicy 로 변환합니다.
+func (c *SignInConfig) Policy() domain.SignInPolicy {
+	return domain.SignInPolicy{
+		MaxEmailFailures: c.MaxEmailFailures,
+		MaxIPFailures:    c.MaxIPFailures,
+		Window:           c.FailureWindow.Std(),
+		LockoutDuration:  c.LockoutDuration.Std(),
+		Delay:            c.FailureDelay.Std(),
+		MaxDelay:         c.MaxFailureDelay.Std(),
+
+		MaxTwoFactorFailures: c.MaxTwoFactorFailures,
+	}
+}
+
+// CookieConfig 브라우저 쿠키 인증 설정
+//
+// Enabled 인 경우 로그인, 토큰 재발급 요청에서 token_delivery=cookie 로 쿠키 인증을 선택할 수 있습니다.
+type CookieConfig struct {
+	Enabled       bool     `json:"enabled" yaml:"enabled" toml:"enabled" env:"COOKIE_AUTH_ENABLED"`
+	Domain        string   `json:"domain" yaml:"domain" toml:"domain" env:"COOKIE_DOMAIN"`
+	Secure        bool     `json:"secure" yaml:"secure" toml:"secure" env:"COOKIE_SECURE"`
+	SameSite      string   `json:"same_site" yaml:"same_site" toml:"same_site" env:"COOKIE_SAME_SITE"`
+	AccessMaxAge  Duration `json:"access_max_age" yaml:"access_max_age" toml:"access_max_age" env:"COOKIE_ACCESS_MAX_AGE"`
+	RefreshMaxAge Duration `json:"refresh_max_age" yaml:"refresh_max_age" toml:"refresh_max_age" env:"COOKIE_REFRESH_MAX_AGE"`
+}
+
+var sameSiteModes = map[string]http.SameSite{
+	"lax":    http.SameSiteLaxMode,
+	"strict": http.SameSiteStrictMode,
+	"none":   http.SameSiteNoneMode,
+}
+
+// Options authcookie.Options 로 변환합니다. 비활성화 되어 있으면 nil 을 반환합니다.
+func (c *CookieConfig) Options() *authcookie.Options {
+	if !c.Enabled {
+		return nil
+	}
+	return &authcookie.Options{
+		Domain:        c.Domain,
+		Secure:        c.Secure,
+		SameSite:      sameSiteModes[c.SameSite],
+		AccessMaxAge:  c.AccessMaxAge.Std(),
+		RefreshMaxAge: c.RefreshMaxAge.Std(),
+	}
+}
+
+// StateOptions 소셜 로그인 state 쿠키 설정 입니다. 쿠키 인증을 사용하지 않아도 Domain, Secure, SameSite 를 사용한다.
+func (c *CookieConfig) StateOptions(stateTTL time.Duration) *authcookie.Options {
+	return &authcookie.Options{
+		Domain:      c.Domain,
+		Secure:      c.Secure,
+		SameSite:    sameSiteModes[c.SameSite],
+		StateMaxAge: stateTTL,
+	}
+}
+
+// OAuthConfig 소셜 로그인 설정
+//
+// 제공자 별로 ClientID 가 설정된 경우에만 활성화 됩니다.
+// Issuer 를 변경하면 로컬 OIDC 서버(mock-oauth2-server 등)로 테스트 할 수 있습니다.
+type OAuthConfig struct {
+	RedirectURLs  []string            `json:"redirect_urls" yaml:"redirect_urls" toml:"redirect_urls" env:"OAUTH_REDIRECT_URLS" envSeparator:","`
+	StateTTL      Duration            `json:"state_ttl" yaml:"state_ttl" toml:"state_ttl" env:"OAUTH_STATE_TTL"`
+	CredentialKey string              `json:"credential_key" yaml:"credential_key" toml:"credential_key" env:"OAUTH_CREDENTIAL_KEY" secret:"true"`
+	Google        OAuthProviderConfig `json:"google" yaml:"google" toml:"google" envPrefix:"OAUTH_GOOGLE_"`
+	Kakao         OAuthProviderConfig `json:"kakao" yaml:"kakao" toml:"kakao" envPrefix:"OAUTH_KAKAO_"`
+	Naver         OAuthProviderConfig `json:"naver" yaml:"naver" toml:"naver" envPrefix:"OAUTH_NAVER_"`
+}
+
+// OAuthProviderConfig 소셜 로그인 제공자 설정
+//
+// Google, Kakao 는 Issuer(OIDC), Naver 는 AuthURL, TokenURL, UserInfoURL 을 사용합니다.
+type OAuthProviderConfig struct {
+	ClientID     string   `json:"client_id" yaml:"client_id" toml:"client_id" env:"CLIENT_ID"`
+	ClientSecret string   `json:"client_secret" yaml:"client_secret" toml:"client_secret" env:"CLIENT_SECRET" secret:"true"`
+	Issuer       string   `json:"issuer" yaml:"issuer" toml:"issuer" env:"ISSUER"`
+	AuthURL      string   `json:"auth_url" yaml:"auth_url" toml:"auth_url" env:"AUTH_URL"`
+	TokenURL     string   `json:"token_url" yaml:"token_url" toml:"token_url" env:"TOKEN_URL"`
+	UserInfoURL  string   `json:"user_info_url" yaml:"user_info_url" toml:"user_info_url" env:"USER_INFO_URL"`
+	Scopes       []string `json:"scopes" yaml:"scopes" toml:"scopes" env:"SCOPES" envSeparator:","`
+}
+
+// Enabled ClientID 가 설정되어 있는지 확인합니다.
+func (c *OAuthProviderConfig) Enabled() bool {
+	return c.ClientID != ""
+}
+
+// Config oauth.Config 로 변환합니다.
+func (c *OAuthProviderConfig) Config() oauth.Config {
+	return oauth.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		Issuer:       c.Issuer,
+		AuthURL:      c.AuthURL,
+		TokenURL:     c.TokenURL,
+		UserInfoURL:  c.UserInfoURL,
+		Scopes:       c.Scopes,
+	}
+}
+
+// Enabled 활성화 된 제공자가 있는지 확인합니다.
+func (c *OAuthConfig) Enabled() bool {
+	return c.Google.Enabled() || c.Kakao.Enabled() || c.Naver.Enabled()
+}
+
+// Policy domain.OAuthPolicy 로 변환합니다. CredentialKey 는 base64 로 인코딩된 32 byte 키 입니다.
+func (c *OAuthConfig) Policy() (domain.OAuthPolicy, error) {
+	key, err := base64.StdEncoding.DecodeString(c.CredentialKey)
+	if err != nil {
+		return domain.OAuthPolicy{}, err
+	}
+	if len(key) != 32 {
+		return domain.OAuthPolicy{}, fmt.Errorf("32 byte 가 필요합니다 (%d byte)", len(key))
+	}
+
+	return domain.OAuthPolicy{
+		RedirectURLs:  c.RedirectURLs,
+		StateTTL:      c.StateTTL.Std(),
+		CredentialKey: key,
+	}, nil
+}
+
+// EmailConfig 이메일 인증, 비밀번호 재설정 설정
+//
+// TokenSecret 이 설정된 경우에만 활성화 됩니다.
+// Mailer 가 log 인 경우 메일을 발송하지 않고 로그로 남깁니다. (개발용)
+type EmailConfig struct {
+	TokenSecret      string          `json:"token_secret" yaml:"token_secret" toml:"token_secret" env:"EMAIL_TOKEN_SECRET" secret:"true"`
+	VerifyURL        string          `json:"verify_url" yaml:"verify_url" toml:"verify_url" env:"EMAIL_VERIFY_URL"`
+	ResetPasswordURL string          `json:"reset_password_url" yaml:"reset_password_url" toml:"reset_password_url" env:"EMAIL_RESET_PASSWORD_URL"`
+	VerifyTokenTTL   Duration        `json:"verify_token_ttl" yaml:"verify_token_ttl" toml:"verify_token_ttl" env:"EMAIL_VERIFY_TOKEN_TTL"`
+	ResetTokenTTL    Duration        `json:"reset_token_ttl" yaml:"reset_token_ttl" toml:"reset_token_ttl" env:"EMAIL_RESET_TOKEN_TTL"`
+	Mailer           string          `json:"mailer" yaml:"mailer" toml:"mailer" env:"EMAIL_MAILER"`
+	From             string          `json:"from" yaml:"from" toml:"from" env:"EMAIL_FROM"`
+	SMTP             EmailSMTPConfig `json:"smtp" yaml:"smtp" toml:"smtp" envPrefix:"EMAIL_SMTP_"`
+}
+
+// EmailSMTPConfig SMTP 서버 설정
+//
+// TLS 는 starttls, tls, none(로컬 테스트 서버) 중 하나 입니다.
+type EmailSMTPConfig struct {
+	Host     string `json:"host" yaml:"host" toml:"host" env:"HOST"`
+	Port     int    `json:"port" yaml:"port" toml:"port" env:"PORT"`
+	Username string `json:"username" yaml:"username" toml:"username" env:"USERNAME"`
+	Password string `json:"password" yaml:"password" toml:"password" env:"PASSWORD" secret:"true"`
+	TLS      string `json:"tls" yaml:"tls" toml:"tls" env:"TLS"`
+}
+
+// Enabled TokenSecret 이 설정되어 있는지 확인합니다.
+func (c *EmailConfig) Enabled() bool {
+	return c.TokenSecret != ""
+}
+
+// Policy domain.EmailPolicy 로 변환합니다.
+func (c *EmailConfig) Policy() domain.EmailPolicy {
+	return domain.EmailPolicy{
+		TokenSecret:      []byte(c.TokenSecret),
+		VerifyURL:        c.VerifyURL,
+		ResetPasswordURL: c.ResetPasswordURL,
+		VerifyTokenTTL:   c.VerifyTokenTTL.Std(),
+		ResetTokenTTL:    c.ResetTokenTTL.Std(),
+	}
+}
+
+// SMTPConfig mailer.SMTPConfig 로 변환합니다.
+func (c *EmailConfig) SMTPConfig() mailer.SMTPConfig {
+	return mailer.SMTPConfig{
+		Host:     c.SMTP.Host,
+		Port:     c.SMTP.Port,
+		Username: c.SMTP.Username,
+		Password: c.SMTP.Password,
+		TLS:      c.SMTP.TLS,
+		From:     c.From,
+	}
+}
+
+// PersonalAccessTokenConfig 개인 액세스 토큰 설정
+type PersonalAccessTokenConfig struct {
+	MaxTTL     Duration `json:"max_ttl" yaml:"max_ttl" toml:"max_ttl" env:"PERSONAL_ACCESS_TOKEN_MAX_TTL"`
+	MaxPerUser int      `json:"max_per_user" yaml:"max_per_user" toml:"max_per_user" env:"PERSONAL_ACCESS_TOKEN_MAX_PER_USER"`
+}
+
+// Policy domain.PersonalAccessTokenPolicy 로 변환합니다.
+func (c *PersonalAccessTokenConfig) Policy() domain.PersonalAccessTokenPolicy {
+	return domain.PersonalAccessTokenPolicy{
+		MaxTTL:     c.MaxTTL.Std(),
+		MaxPerUser: c.MaxPerUser,
+	}
+}
+
+// SessionConfig 로그인 세션 설정
+//
+// IPLocationFile 은 "대역,위치" 형식의 CSV 파일 입니다. 없으면 사설망 주소의 위치만 표시합니다.
+type SessionConfig struct {
+	IPLocationFile string `json:"ip_location_file" yaml:"ip_location_file" toml:"ip_location_file" env:"SESSION_IP_LOCATION_FILE"`
+}
+
+// TwoFactorConfig 2단계 인증(TOTP) 설정
+//
+// EncryptionKey 가 설정된 경우에만 활성화 됩니다.
+type TwoFactorConfig struct {
+	EncryptionKey string   `json:"encryption_key" yaml:"encryption_key" toml:"encryption_key" env:"TWO_FACTOR_ENCRYPTION_KEY" secret:"true"`
+	Issuer        string   `json:"issuer" yaml:"issuer" toml:"issuer" env:"TWO_FACTOR_ISSUER"`
+	ChallengeTTL  Duration `json:"challenge_ttl" yaml:"challenge_ttl" toml:"challenge_ttl" env:"TWO_FACTOR_CHALLENGE_TTL"`
+	MaxAttempts   int      `json:"max_attempts" yaml:"max_attempts" toml:"max_attempts" env:"TWO_FACTOR_MAX_ATTEMPTS"`
+}
+
+// Enabled EncryptionKey 가 설정되어 있는지 확인합니다.
+func (c *TwoFactorConfig) Enabled() bool {
+	return c.EncryptionKey != ""
+}
+
+// Policy domain.TwoFactorPolicy 로 변환합니다. EncryptionKey 는 base64 로 인코딩된 32 byte 키 입니다.
+func (c *TwoFactorConfig) Policy() (domain.TwoFactorPolicy, error) {
+	key, err := base64.StdEncoding.DecodeString(c.EncryptionKey)
+	if err != nil {
+		return domain.TwoFactorPolicy{}, err
+	}
+	if len(key) != 32 {
+		return domain.TwoFactorPolicy{}, fmt.Errorf("32 byte 가 필요합니다 (%d byte)", len(key))
+	}
+
+	return domain.TwoFactorPolicy{
+		Issuer:        c.Issuer,
+		EncryptionKey: key,
+		ChallengeTTL:  c.ChallengeTTL.Std(),
+		MaxAttempts:   c.MaxAttempts,
+	}, nil
+}
+
+// AccountDeletionConfig 회원 탈퇴 데이터 정리 설정
+//
+// DefaultMode 는 사용자가 처리 방식을 선택하지 않은 경우 사용합니다. (purge, anonymize)
+type AccountDeletionConfig struct {
+	DefaultMode string   `json:"default_mode" yaml:"default_mode" toml:"default_mode" env:"ACCOUNT_DELETION_DEFAULT_MODE"`
+	RetryAfter  Duration `json:"retry_after" yaml:"retry_after" toml:"retry_after" env:"ACCOUNT_DELETION_RETRY_AFTER"`
+}
+
+// Policy domain.AccountDeletionPolicy 로 변환합니다.
+func (c *AccountDeletionConfig) Policy() domain.AccountDeletionPolicy {
+	return domain.AccountDeletionPolicy{
+		DefaultMode: domain.AccountDeletionMode(c.DefaultMode),
+		RetryAfter:  c.RetryAfter.Std(),
+	}
+}
+
+// RollupConfig 센서 데이터 시간, 일 단위 집계 설정
+//
+// Interval 이 0 이면 이 인스턴스에서는 집계 작업을 실행하지 않습니다.
+type RollupConfig struct {
+	Interval  Duration `json:"interval" yaml:"interval" toml:"interval" env:"ROLLUP_INTERVAL"`
+	BatchSize int      `json:"batch_size" yaml:"batch_size" toml:"batch_size" env:"ROLLUP_BATCH_SIZE"`
+	MaxPoints int      `json:"max_points" yaml:"max_points" toml:"max_points" env:"ROLLUP_MAX_POINTS"`
+}
+
+// Policy domain.RollupPolicy 로 변환합니다.
+func (c *RollupConfig) Policy() domain.RollupPolicy {
+	return domain.RollupPolicy{
+		Interval:  c.Interval.Std(),
+		BatchSize: c.BatchSize,
+		MaxPoints: c.MaxPoints,
+	}
+}
+
+// RetentionConfig 센서 데이터 보관 기간 정리 작업 설정
+//
+// 보관 일수는 관리자 API 로 장치 등급별로 설정합니다. Interval 이 0 이면 이 인스턴스에서는 정리하지 않습니다.
+type RetentionConfig struct {
+	Interval   Duration `json:"interval" yaml:"interval" toml:"interval" env:"RETENTION_INTERVAL"`
+	BatchSize  int      `json:"batch_size" yaml:"batch_size" toml:"batch_size" env:"RETENTION_BATCH_SIZE"`
+	BatchPause Duration `json:"batch_pause" yaml:"batch_pause" toml:"batch_pause" env:"RETENTION_BATCH_PAUSE"`
+}
+
+// Schedule domain.RetentionSchedule 로 변환합니다.
+func (c *RetentionConfig) Schedule() domain.RetentionSchedule {
+	return domain.RetentionSchedule{
+		Interval:   c.Interval.Std(),
+		BatchSize:  c.BatchSize,
+		BatchPause: c.BatchPause.Std(),
+	}
+}
+
+// Default 기본 설정을 반환합니다.
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			ListenAddr:        ":8080",
+			TrustedPlatform:   "none",
+			ReadTimeout:       Duration(15 * time.Second),
+			ReadHeaderTimeout: Duration(5 * time.Second),
+			WriteTimeout:      Duration(30 * time.Second),
+			IdleTimeout:       Duration(60 * time.Second),
+			ShutdownTimeout:   Duration(5 * time.Second),
+			DrainDelay:        Duration(5 * time.Second),
+		},
+		DB: DBConfig{
+			MaxConnLifetime:   Duration(time.Hour),
+			MaxConnIdleTime:   Duration(30 * time.Minute),
+			HealthCheckPeriod: Duration(time.Minute),
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "json",
+		},
+		Cache: CacheConfig{
+			SensorTTL:      Duration(time.Minute),
+			CropTTL:        Duration(time.Minute),
+			AddressTTL:     Duration(30 * time.Minute),
+			UpdateCycleTTL: Duration(30 * time.Minute),
+		},
+		Health: HealthConfig{
+			Timeout: Duration(3 * time.Second),
+		},
+		Trace: TraceConfig{
+			Exporter:    "none",
+			SampleRatio: 1,
+		},
+		RateLimit: RateLimitConfig{
+			Enabled: true,
+			Store:   "memory",
+			Default: RateLimitRule{Requests: 120, Period: time.Minute},
+			Operations: RateLimitRules{
+				"v1AuthSignIn":                {Requests: 10, Period: time.Minute},
+				"v1AuthSignInTwoFactor":       {Requests: 10, Period: time.Minute},
+				"v1AuthSignUp":                {Requests: 5, Period: 10 * time.Minute},
+				"v1AuthCheckUser":             {Requests: 30, Period: time.Minute},
+				"v1AuthSendEmailVerification": {Requests: 5, Period: 10 * time.Minute},
+				"v1AuthRequestPasswordReset":  {Requests: 5, Period: 10 * time.Minute},
+				"v1MetaGetGrowingDegreeDays":  {Requests: 10, Period: time.Minute},
+				"healthz":                     {},
+				"readyz":                      {},
+			},
+		},
+		SignIn: SignInConfig{
+			MaxEmailFailures: 5,
+			MaxIPFailures:    20,
+			FailureWindow:    Duration(15 * time.Minute),
+			LockoutDuration:  Duration(15 * time.Minute),
+			FailureDelay:     Duration(time.Second),
+			MaxFailureDelay:  Duration(30 * time.Second),
+
+			MaxTwoFactorFailures: 10,
+		},
+		Cookie: CookieConfig{
+			Secure:        true,
+			SameSite:      "lax",
+			AccessMaxAge:  Duration(time.Hour),
+			RefreshMaxAge: Duration(14 * 24 * time.Hour),
+		},
+		OAuth: OAuthConfig{
+			StateTTL: Duration(10 * time.Minute),
+			Google: OAuthProviderConfig{
+				Issuer: "https://accounts.google.com",
+				Scopes: []string{"openid", "email", "profile"},
+			},
+			Kakao: OAuthProviderConfig{
+				Issuer: "https://kauth.kakao.com",
+				Scopes: []string{"openid", "account_email", "profile_nickname"},
+			},
+			Naver: OAuthProviderConfig{
+				AuthURL:     "https://nid.naver.com/oauth2.0/authorize",
+				TokenURL:    "https://nid.naver.com/oauth2.0/token",
+				UserInfoURL: "https://openapi.naver.com/v1/nid/me",
+			},
+		},
+		Email: EmailConfig{
+			VerifyTokenTTL: Duration(24 * time.Hour),
+			ResetTokenTTL:  Duration(time.Hour),
+			Mailer:         "log",
+			SMTP: EmailSMTPConfig{
+				Port: 587,
+				TLS:  "starttls",
+			},
+		},
+		PersonalAccessToken: PersonalAccessTokenConfig{
+			MaxTTL:     Duration(365 * 24 * time.Hour),
+			MaxPerUser: 20,
+		},
+		TwoFactor: TwoFactorConfig{
+			Issuer:       "GDH",
+			ChallengeTTL: Duration(5 * time.Minute),
+			MaxAttempts:  5,
+		},
+		AccountDeletion: AccountDeletionConfig{
+			DefaultMode: string(domain.AccountDeletionModePurge),
+			RetryAfter:  Duration(5 * time.Minute),
+		},
+		Rollup: RollupConfig{
+			Interval:  Duration(time.Minute),
+			BatchSize: 1000,
+			MaxPoints: 5000,
+		},
+		Retention: RetentionConfig{
+			Interval:   Duration(time.Hour),
+			BatchSize:  5000,
+			BatchPause: Duration(100 * time.Millisecond),
+		},
+	}
+}
+
+// UserGrpcServer 사용자 gRPC 서버 주소를 반환합니다.
+func (c *GrpcConfig) UserGrpcServer() string {
+	if c.UserServer == "" {
+		return c.AuthServer
+	}
+	return c.UserServer
+}
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"time"
+)
+
+// Duration
+//
+// 설정 파일과 환경변수에서 "5s", "30m" 형식으로 입력 받는 시간 값 입니다.
+type Duration time.Duration
+
+func (d Duration) Std() time.Duration {
+	return time.Duration(d)
+}
+
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Duration) UnmarshalText(b []byte) error {
+	v, err := time.ParseDuration(string(b))
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
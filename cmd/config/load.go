@@ -0,0 +1,57 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/goccy/go-yaml"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// Load
+//
+// 기본값에 설정 파일(path 가 비어있지 않은 경우)과 환경변수를 순서대로 적용한 뒤 검증합니다.
+func Load(path string) (*Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		if err := loadFile(path, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := env.Parse(cfg); err != nil {
+		return nil, fmt.Errorf("환경변수 로드에 실패했습니다: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("설정 검증에 실패했습니다:\n%w", err)
+	}
+
+	return cfg, nil
+}
+
+func loadFile(path string, cfg *Config) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("설정 파일을 읽을 수 없습니다: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalWithOptions(b, cfg, yaml.Strict())
+	case ".toml":
+		err = toml.NewDecoder(strings.NewReader(string(b))).DisallowUnknownFields().Decode(cfg)
+	default:
+		return fmt.Errorf("지원하지 않는 설정 파일 형식 입니다: %s (yaml, toml 지원)", path)
+	}
+	if err != nil {
+		return fmt.Errorf("설정 파일 해석에 실패했습니다 (%s): %w", path, err)
+	}
+
+	return nil
+}
@@ -5,12 +5,22 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
-func InitLogger(isDebugMode bool) *zap.Logger {
+// InitLogger
+//
+// 로그 설정으로 zap 로거를 생성합니다. 디버그 모드인 경우 설정과 관계없이 개발용 로거를 사용합니다.
+func InitLogger(logConfig LogConfig, isDebugMode bool) *zap.Logger {
 	var cfg zap.Config
 	if isDebugMode {
 		cfg = zap.NewDevelopmentConfig()
 	} else {
 		cfg = zap.NewProductionConfig()
+		if level, err := zap.ParseAtomicLevel(logConfig.Level); err == nil {
+			cfg.Level = level
+		}
+		if logConfig.Format == "console" {
+			cfg.Encoding = "console"
+			cfg.EncoderConfig = zap.NewDevelopmentEncoderConfig()
+		}
 	}
 	cfg.EncoderConfig.EncodeDuration = zapcore.MillisDurationEncoder
 
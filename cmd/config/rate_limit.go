@@ -0,0 +1,100 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-yaml"
+)
+
+// RateLimitRule
+//
+// "{요청수}/{기간}" 형식의 rate limit 규칙 입니다. (예: "10/1m")
+// "unlimited" 혹은 "0" 인 경우 제한하지 않습니다.
+type RateLimitRule struct {
+	Requests int
+	Period   time.Duration
+}
+
+func (r RateLimitRule) String() string {
+	if r.Requests <= 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d/%s", r.Requests, r.Period)
+}
+
+func (r RateLimitRule) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *RateLimitRule) UnmarshalText(b []byte) error {
+	s := strings.TrimSpace(string(b))
+	if s == "unlimited" || s == "0" {
+		*r = RateLimitRule{}
+		return nil
+	}
+
+	requests, period, ok := strings.Cut(s, "/")
+	if !ok {
+		return fmt.Errorf("rate limit 규칙은 \"요청수/기간\" 형식이어야 합니다: %q", s)
+	}
+	n, err := strconv.Atoi(requests)
+	if err != nil || n < 0 {
+		return fmt.Errorf("rate limit 요청수가 올바르지 않습니다: %q", s)
+	}
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return fmt.Errorf("rate limit 기간이 올바르지 않습니다: %q", s)
+	}
+
+	*r = RateLimitRule{Requests: n, Period: d}
+	return nil
+}
+
+// RateLimitRules
+//
+// OperationID 별 rate limit 규칙 입니다. 설정 파일과 환경변수의 값은 기본값에 병합됩니다.
+// 환경변수는 "{OperationID}:{규칙},..." 형식 입니다. (예: "v1AuthSignIn:10/1m,v1AuthSignUp:5/10m")
+type RateLimitRules map[string]RateLimitRule
+
+func (m *RateLimitRules) merge(rules map[string]RateLimitRule) {
+	if *m == nil {
+		*m = make(RateLimitRules, len(rules))
+	}
+	for k, v := range rules {
+		(*m)[k] = v
+	}
+}
+
+func (m *RateLimitRules) UnmarshalText(b []byte) error {
+	rules := make(map[string]RateLimitRule)
+	for _, kv := range strings.Split(string(b), ",") {
+		if strings.TrimSpace(kv) == "" {
+			continue
+		}
+		operationID, rule, ok := strings.Cut(kv, ":")
+		if !ok {
+			return fmt.Errorf("rate limit 오퍼레이션 규칙은 \"OperationID:규칙\" 형식이어야 합니다: %q", kv)
+		}
+		var r RateLimitRule
+		if err := r.UnmarshalText([]byte(rule)); err != nil {
+			return err
+		}
+		rules[strings.TrimSpace(operationID)] = r
+	}
+
+	m.merge(rules)
+	return nil
+}
+
+func (m *RateLimitRules) UnmarshalYAML(b []byte) error {
+	var rules map[string]RateLimitRule
+	if err := yaml.Unmarshal(b, &rules); err != nil {
+		return err
+	}
+
+	m.merge(rules)
+	return nil
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pelletier/go-toml/v2"
+)
+
+const redactedValue = "******"
+
+// Redacted
+//
+// secret 태그가 있는 값을 마스킹한 복사본을 반환합니다.
+// 비밀번호가 포함된 URL 형식인 경우 비밀번호 부분만 마스킹합니다.
+func (c *Config) Redacted() *Config {
+	cp := *c
+	cp.Cors.HostList = append([]string(nil), c.Cors.HostList...)
+	cp.Metrics.AllowList = append([]string(nil), c.Metrics.AllowList...)
+	cp.OAuth.RedirectURLs = append([]string(nil), c.OAuth.RedirectURLs...)
+
+	redact(reflect.ValueOf(&cp).Elem())
+
+	return &cp
+}
+
+func redact(v reflect.Value) {
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Kind() == reflect.Struct {
+			redact(f)
+			continue
+		}
+		if v.Type().Field(i).Tag.Get("secret") != "true" || f.Kind() != reflect.String || f.String() == "" {
+			continue
+		}
+		f.SetString(redactString(f.String()))
+	}
+}
+
+func redactString(s string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" || u.User == nil {
+		return redactedValue
+	}
+	if _, ok := u.User.Password(); !ok {
+		return redactedValue
+	}
+
+	return u.Redacted()
+}
+
+// Marshal
+//
+// 설정을 지정한 형식(yaml, toml, json)으로 변환합니다.
+func (c *Config) Marshal(format string) ([]byte, error) {
+	switch format {
+	case "yaml", "yml":
+		return yaml.Marshal(c)
+	case "toml":
+		return toml.Marshal(c)
+	case "json":
+		return json.MarshalIndent(c, "", "  ")
+	default:
+		return nil, fmt.Errorf("지원하지 않는 형식 입니다: %s (yaml, toml, json 지원)", format)
+	}
+}
@@ -0,0 +1,331 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap/zapcore"
+)
+
+var trustedPlatforms = map[string]string{
+	"":                  "",
+	"none":              "",
+	"cloudflare":        gin.PlatformCloudflare,
+	"google-app-engine": gin.PlatformGoogleAppEngine,
+	"flyio":             gin.PlatformFlyIO,
+}
+
+// TrustedPlatformHeader gin.Engine.TrustedPlatform 에 설정할 헤더 이름을 반환합니다.
+func (c *ServerConfig) TrustedPlatformHeader() string {
+	return trustedPlatforms[c.TrustedPlatform]
+}
+
+func isAbsoluteURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.IsAbs() && u.Host != ""
+}
+
+// Validate
+//
+// 설정값을 검증하고 잘못된 항목을 모두 모아서 반환합니다.
+func (c *Config) Validate() error {
+	var errs []error
+	invalid := func(field string, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", field, fmt.Sprintf(format, args...)))
+	}
+
+	// server
+	if _, _, err := net.SplitHostPort(c.Server.ListenAddr); err != nil {
+		invalid("server.listen_addr", "올바른 host:port 형식이 아닙니다 (%q)", c.Server.ListenAddr)
+	}
+	if _, ok := trustedPlatforms[c.Server.TrustedPlatform]; !ok {
+		invalid("server.trusted_platform", "cloudflare, google-app-engine, flyio, none 중 하나여야 합니다 (%q)", c.Server.TrustedPlatform)
+	}
+	for _, v := range c.Server.TrustedProxies {
+		if net.ParseIP(v) == nil {
+			if _, _, err := net.ParseCIDR(v); err != nil {
+				invalid("server.trusted_proxies", "IP 혹은 CIDR 형식이 아닙니다 (%q)", v)
+			}
+		}
+	}
+	for field, d := range map[string]Duration{
+		"server.read_timeout":        c.Server.ReadTimeout,
+		"server.read_header_timeout": c.Server.ReadHeaderTimeout,
+		"server.write_timeout":       c.Server.WriteTimeout,
+		"server.idle_timeout":        c.Server.IdleTimeout,
+		"server.drain_delay":         c.Server.DrainDelay,
+	} {
+		if d < 0 {
+			invalid(field, "0 이상이어야 합니다 (%s)", d)
+		}
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		invalid("server.shutdown_timeout", "0 보다 커야 합니다 (%s)", c.Server.ShutdownTimeout)
+	}
+
+	// db
+	if c.DB.Url == "" {
+		invalid("db.url", "필수 값 입니다 (DB_URL)")
+	} else if _, err := pgxpool.ParseConfig(c.DB.Url); err != nil {
+		invalid("db.url", "해석할 수 없습니다")
+	}
+	if c.DB.MaxConns < 0 {
+		invalid("db.max_conns", "0 이상이어야 합니다 (%d)", c.DB.MaxConns)
+	}
+	if c.DB.MinConns < 0 {
+		invalid("db.min_conns", "0 이상이어야 합니다 (%d)", c.DB.MinConns)
+	}
+	if c.DB.MaxConns > 0 && c.DB.MinConns > c.DB.MaxConns {
+		invalid("db.min_conns", "db.max_conns(%d) 보다 클 수 없습니다 (%d)", c.DB.MaxConns, c.DB.MinConns)
+	}
+
+	// grpc
+	if c.Grpc.AuthServer == "" {
+		invalid("grpc.auth_server", "필수 값 입니다 (AUTH_GRPC_SERVER)")
+	}
+
+	// log
+	if _, err := zapcore.ParseLevel(c.Log.Level); err != nil {
+		invalid("log.level", "debug, info, warn, error 중 하나여야 합니다 (%q)", c.Log.Level)
+	}
+	if !slices.Contains([]string{"json", "console"}, c.Log.Format) {
+		invalid("log.format", "json, console 중 하나여야 합니다 (%q)", c.Log.Format)
+	}
+
+	// cors
+	if len(c.Cors.HostList) == 0 {
+		invalid("cors.host_list", "최소 1개 이상의 호스트가 필요합니다 (CORS_HOST_LIST)")
+	}
+
+	// cache
+	for field, d := range map[string]Duration{
+		"cache.sensor_ttl":       c.Cache.SensorTTL,
+		"cache.crop_ttl":         c.Cache.CropTTL,
+		"cache.address_ttl":      c.Cache.AddressTTL,
+		"cache.update_cycle_ttl": c.Cache.UpdateCycleTTL,
+	} {
+		if d < 0 {
+			invalid(field, "0 이상이어야 합니다 (%s)", d)
+		}
+	}
+
+	// health
+	if c.Health.Timeout <= 0 {
+		invalid("health.timeout", "0 보다 커야 합니다 (%s)", c.Health.Timeout)
+	}
+
+	// metrics
+	if (c.Metrics.User == "") != (c.Metrics.Password == "") {
+		invalid("metrics.user", "metrics.user 와 metrics.password 는 함께 설정해야 합니다")
+	}
+	for _, v := range c.Metrics.AllowList {
+		if net.ParseIP(v) == nil {
+			if _, _, err := net.ParseCIDR(v); err != nil {
+				invalid("metrics.allow_list", "IP 혹은 CIDR 형식이 아닙니다 (%q)", v)
+			}
+		}
+	}
+
+	// trace
+	if !slices.Contains([]string{"none", "stdout", "otlp"}, c.Trace.Exporter) {
+		invalid("trace.exporter", "none, stdout, otlp 중 하나여야 합니다 (%q)", c.Trace.Exporter)
+	}
+	if c.Trace.SampleRatio < 0 || c.Trace.SampleRatio > 1 {
+		invalid("trace.sample_ratio", "0 이상 1 이하여야 합니다 (%v)", c.Trace.SampleRatio)
+	}
+
+	// rate limit
+	if !slices.Contains([]string{"memory", "postgres"}, c.RateLimit.Store) {
+		invalid("rate_limit.store", "memory, postgres 중 하나여야 합니다 (%q)", c.RateLimit.Store)
+	}
+
+	// sign in
+	if c.SignIn.MaxEmailFailures < 1 {
+		invalid("sign_in.max_email_failures", "1 이상이어야 합니다 (%d)", c.SignIn.MaxEmailFailures)
+	}
+	if c.SignIn.MaxIPFailures < 1 {
+		invalid("sign_in.max_ip_failures", "1 이상이어야 합니다 (%d)", c.SignIn.MaxIPFailures)
+	}
+	if c.SignIn.MaxTwoFactorFailures < 1 {
+		invalid("sign_in.max_two_factor_failures", "1 이상이어야 합니다 (%d)", c.SignIn.MaxTwoFactorFailures)
+	}
+	for field, d := range map[string]Duration{
+		"sign_in.failure_window":   c.SignIn.FailureWindow,
+		"sign_in.lockout_duration": c.SignIn.LockoutDuration,
+	} {
+		if d <= 0 {
+			invalid(field, "0 보다 커야 합니다 (%s)", d)
+		}
+	}
+	if c.SignIn.FailureDelay < 0 {
+		invalid("sign_in.failure_delay", "0 이상이어야 합니다 (%s)", c.SignIn.FailureDelay)
+	}
+	if c.SignIn.MaxFailureDelay < c.SignIn.FailureDelay {
+		invalid("sign_in.max_failure_delay", "sign_in.failure_delay(%s) 보다 작을 수 없습니다 (%s)", c.SignIn.FailureDelay, c.SignIn.MaxFailureDelay)
+	}
+
+	// cookie
+	if _, ok := sameSiteModes[c.Cookie.SameSite]; !ok {
+		invalid("cookie.same_site", "lax, strict, none 중 하나여야 합니다 (%q)", c.Cookie.SameSite)
+	}
+	if c.Cookie.SameSite == "none" && !c.Cookie.Secure {
+		invalid("cookie.secure", "cookie.same_site 가 none 이면 true 여야 합니다")
+	}
+	if c.Cookie.Enabled && slices.Contains(c.Cors.HostList, "*") {
+		invalid("cors.host_list", "쿠키 인증을 사용하는 경우 * 를 사용할 수 없습니다")
+	}
+	if c.Cookie.AccessMaxAge <= 0 {
+		invalid("cookie.access_max_age", "0 보다 커야 합니다 (%s)", c.Cookie.AccessMaxAge)
+	}
+	if c.Cookie.RefreshMaxAge <= 0 {
+		invalid("cookie.refresh_max_age", "0 보다 커야 합니다 (%s)", c.Cookie.RefreshMaxAge)
+	}
+
+	// oauth
+	if c.OAuth.Enabled() {
+		if _, err := c.OAuth.Policy(); err != nil {
+			invalid("oauth.credential_key", "base64 로 인코딩된 32 byte 키가 필요합니다 (OAUTH_CREDENTIAL_KEY)")
+		}
+		if len(c.OAuth.RedirectURLs) == 0 {
+			invalid("oauth.redirect_urls", "최소 1개 이상의 redirect_uri 가 필요합니다 (OAUTH_REDIRECT_URLS)")
+		}
+		for _, v := range c.OAuth.RedirectURLs {
+			if !isAbsoluteURL(v) {
+				invalid("oauth.redirect_urls", "절대 URL 이어야 합니다 (%q)", v)
+			}
+		}
+		if c.OAuth.StateTTL <= 0 {
+			invalid("oauth.state_ttl", "0 보다 커야 합니다 (%s)", c.OAuth.StateTTL)
+		}
+		if slices.Contains(c.Cors.HostList, "*") {
+			invalid("cors.host_list", "소셜 로그인을 사용하는 경우 * 를 사용할 수 없습니다")
+		}
+	}
+	for field, p := range map[string]OAuthProviderConfig{
+		"oauth.google": c.OAuth.Google,
+		"oauth.kakao":  c.OAuth.Kakao,
+	} {
+		if p.Enabled() && !isAbsoluteURL(p.Issuer) {
+			invalid(field+".issuer", "절대 URL 이어야 합니다 (%q)", p.Issuer)
+		}
+	}
+	if c.OAuth.Naver.Enabled() {
+		for field, v := range map[string]string{
+			"oauth.naver.auth_url":      c.OAuth.Naver.AuthURL,
+			"oauth.naver.token_url":     c.OAuth.Naver.TokenURL,
+			"oauth.naver.user_info_url": c.OAuth.Naver.UserInfoURL,
+		} {
+			if !isAbsoluteURL(v) {
+				invalid(field, "절대 URL 이어야 합니다 (%q)", v)
+			}
+		}
+	}
+
+	// email
+	if c.Email.Enabled() {
+		if len(c.Email.TokenSecret) < 32 {
+			invalid("email.token_secret", "32자 이상이어야 합니다 (EMAIL_TOKEN_SECRET)")
+		}
+		for field, v := range map[string]string{
+			"email.verify_url":         c.Email.VerifyURL,
+			"email.reset_password_url": c.Email.ResetPasswordURL,
+		} {
+			if !isAbsoluteURL(v) {
+				invalid(field, "절대 URL 이어야 합니다 (%q)", v)
+			}
+		}
+		for field, d := range map[string]Duration{
+			"email.verify_token_ttl": c.Email.VerifyTokenTTL,
+			"email.reset_token_ttl":  c.Email.ResetTokenTTL,
+		} {
+			if d <= 0 {
+				invalid(field, "0 보다 커야 합니다 (%s)", d)
+			}
+		}
+	}
+	switch c.Email.Mailer {
+	case "log":
+	case "smtp":
+		if _, err := mail.ParseAddress(c.Email.From); err != nil {
+			invalid("email.from", "메일 주소 형식이 아닙니다 (%q)", c.Email.From)
+		}
+		if c.Email.SMTP.Host == "" {
+			invalid("email.smtp.host", "필수 값 입니다 (EMAIL_SMTP_HOST)")
+		}
+		if c.Email.SMTP.Port < 1 || c.Email.SMTP.Port > 65535 {
+			invalid("email.smtp.port", "1 이상 65535 이하여야 합니다 (%d)", c.Email.SMTP.Port)
+		}
+		if !slices.Contains([]string{"starttls", "tls", "none"}, c.Email.SMTP.TLS) {
+			invalid("email.smtp.tls", "starttls, tls, none 중 하나여야 합니다 (%q)", c.Email.SMTP.TLS)
+		}
+	default:
+		invalid("email.mailer", "log, smtp 중 하나여야 합니다 (%q)", c.Email.Mailer)
+	}
+
+	// personal access token
+	if c.PersonalAccessToken.MaxTTL <= 0 {
+		invalid("personal_access_token.max_ttl", "0 보다 커야 합니다 (%s)", c.PersonalAccessToken.MaxTTL)
+	}
+	if c.PersonalAccessToken.MaxPerUser < 1 {
+		invalid("personal_access_token.max_per_user", "1 이상이어야 합니다 (%d)", c.PersonalAccessToken.MaxPerUser)
+	}
+
+	// two factor
+	if c.TwoFactor.Enabled() {
+		if _, err := c.TwoFactor.Policy(); err != nil {
+			invalid("two_factor.encryption_key", "base64 로 인코딩된 32 byte 키가 필요합니다 (TWO_FACTOR_ENCRYPTION_KEY)")
+		}
+		if c.TwoFactor.Issuer == "" {
+			invalid("two_factor.issuer", "필수 값 입니다 (TWO_FACTOR_ISSUER)")
+		}
+		if c.TwoFactor.ChallengeTTL <= 0 {
+			invalid("two_factor.challenge_ttl", "0 보다 커야 합니다 (%s)", c.TwoFactor.ChallengeTTL)
+		}
+		if c.TwoFactor.MaxAttempts < 1 {
+			invalid("two_factor.max_attempts", "1 이상이어야 합니다 (%d)", c.TwoFactor.MaxAttempts)
+		}
+	}
+
+	// account deletion
+	if !slices.Contains([]string{"purge", "anonymize"}, c.AccountDeletion.DefaultMode) {
+		invalid("account_deletion.default_mode", "purge, anonymize 중 하나여야 합니다 (%q)", c.AccountDeletion.DefaultMode)
+	}
+	if c.AccountDeletion.RetryAfter <= 0 {
+		invalid("account_deletion.retry_after", "0 보다 커야 합니다 (%s)", c.AccountDeletion.RetryAfter)
+	}
+
+	// rollup
+	if c.Rollup.Interval < 0 {
+		invalid("rollup.interval", "0 이상이어야 합니다 (%s)", c.Rollup.Interval)
+	}
+	if c.Rollup.BatchSize < 1 {
+		invalid("rollup.batch_size", "1 이상이어야 합니다 (%d)", c.Rollup.BatchSize)
+	}
+	if c.Rollup.MaxPoints < 1 {
+		invalid("rollup.max_points", "1 이상이어야 합니다 (%d)", c.Rollup.MaxPoints)
+	}
+
+	// retention
+	if c.Retention.Interval < 0 {
+		invalid("retention.interval", "0 이상이어야 합니다 (%s)", c.Retention.Interval)
+	}
+	if c.Retention.BatchSize < 1 {
+		invalid("retention.batch_size", "1 이상이어야 합니다 (%d)", c.Retention.BatchSize)
+	}
+	if c.Retention.BatchPause < 0 {
+		invalid("retention.batch_pause", "0 이상이어야 합니다 (%s)", c.Retention.BatchPause)
+	}
+
+	slices.SortFunc(errs, func(a, b error) int {
+		return strings.Compare(a.Error(), b.Error())
+	})
+
+	return errors.Join(errs...)
+}
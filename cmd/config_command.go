@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/GDH-Project/api/cmd/config"
+	"github.com/spf13/cobra"
+)
+
+// configCommand 적용된 설정을 비밀값을 마스킹하여 출력합니다.
+func configCommand() *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Print the effective configuration with secrets redacted",
+		RunE: withOptionsE(func(cmd *cobra.Command, args []string, opts *Options) error {
+			cfg, err := config.Load(opts.Config)
+			if err != nil {
+				return err
+			}
+
+			out, err := cfg.Redacted().Marshal(format)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(out))
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&format, "format", "f", "yaml", "Output format (yaml, toml, json)")
+
+	return cmd
+}
@@ -1,27 +1,10 @@
 package main
 
 import (
-	"context"
-	"errors"
-	"net/http"
-	"strings"
-	"time"
+	"os"
 
-	"github.com/GDH-Project/api/cmd/config"
-	"github.com/GDH-Project/api/internal/grpc"
-	"github.com/GDH-Project/api/internal/handler"
-	m "github.com/GDH-Project/api/internal/middleware"
-	"github.com/GDH-Project/api/internal/repository"
-	"github.com/GDH-Project/api/internal/resource"
-	"github.com/GDH-Project/api/internal/service"
-	usecase "github.com/GDH-Project/api/internal/use_case"
-	"github.com/danielgtaylor/huma/v2"
-	"github.com/danielgtaylor/huma/v2/adapters/humagin"
 	"github.com/danielgtaylor/huma/v2/humacli"
-	"github.com/gin-contrib/cors"
-	ginzap "github.com/gin-contrib/zap"
-	"github.com/gin-gonic/gin"
-	"go.uber.org/zap"
+	"github.com/spf13/cobra"
 )
 
 var (
@@ -29,114 +12,81 @@ var (
 )
 
 type Options struct {
-	Debug bool `doc:"Enable debug logging" short:"d"`
+	Debug  bool   `doc:"Enable debug logging" short:"d"`
+	Config string `doc:"Config file path (yaml, toml)" short:"c"`
 }
 
-func main() {
-
-	cli := humacli.New(func(hooks humacli.Hooks, opts *Options) {
-		log := config.InitLogger(opts.Debug)
-		log.Info("GDH Project API SERVER", zap.String("version", Version))
-		cfg := config.GetConfig(log)
-
-		if opts.Debug {
-			gin.SetMode(gin.DebugMode)
-		} else {
-			gin.SetMode(gin.ReleaseMode)
-		}
-
-		var r *gin.Engine
-
-		if opts.Debug {
-			r = gin.Default()
-		} else {
-			r = gin.New()
-			r.TrustedPlatform = gin.PlatformCloudflare
-			r.Use(ginzap.Ginzap(log, time.RFC3339, true))
-			r.Use(ginzap.RecoveryWithZap(log, true))
-		}
+// withOptionsE humacli.WithOptions 와 같지만 오류를 반환하여 defer 가 실행된 뒤 cobra 가 오류를 처리합니다.
+func withOptionsE(f func(cmd *cobra.Command, args []string, opts *Options) error) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var err error
+		humacli.WithOptions(func(cmd *cobra.Command, args []string, opts *Options) {
+			err = f(cmd, args, opts)
+		})(cmd, args)
+		return err
+	}
+}
 
-		// cors 설정
-		var corsHosts []string
-		corsConfig := cors.DefaultConfig()
-		if cfg.CorsHostList != "" {
-			corsHosts = strings.Split(cfg.CorsHostList, ",")
-			log.Info("CORS host list", zap.Any("hostlist", corsHosts))
+// recordFailures humacli 의 Run 은 cobra 가 반환한 오류를 무시하므로
+// 하위 명령이 오류를 반환하면 failed 에 기록하여 종료 코드를 설정할 수 있게 합니다.
+func recordFailures(cmd *cobra.Command, failed *bool) {
+	record := func(f func(*cobra.Command, []string) error) func(*cobra.Command, []string) error {
+		if f == nil {
+			return nil
 		}
-		corsConfig.AllowOrigins = corsHosts
-		corsConfig.AllowHeaders = append(corsConfig.AllowHeaders, "Authorization")
-		r.Use(cors.New(corsConfig))
-
-		// huma config
-		humaConfig := huma.DefaultConfig("GDH-API 서버 입니다.", Version)
-		humaConfig.CreateHooks = nil
-		humaConfig.SchemasPath = ""
-		humaConfig.Components.SecuritySchemes = map[string]*huma.SecurityScheme{
-			"bearer": {
-				Type:         "http",
-				Scheme:       "bearer",
-				BearerFormat: "JWT",
-			},
+		return func(cmd *cobra.Command, args []string) error {
+			err := f(cmd, args)
+			if err != nil {
+				*failed = true
+			}
+			return err
 		}
+	}
 
-		api := humagin.New(r, humaConfig)
-
-		// Dependency
-		db := resource.InitDB(cfg.DbUrl, log)
-		// gRPC Client 생성
-		grpcClientConn := grpc.NewBaseClient(log, cfg)
-
-		userGrpcClient := grpc.NewUserClient(log, grpcClientConn)
-		userService := service.NewUserService(log, userGrpcClient)
-		userUseCase := usecase.NewUserUseCase(log, userService)
-
-		authGrpcClient := grpc.NewAuthClient(log, grpcClientConn)
-		authService := service.NewAuthService(log, authGrpcClient)
-		authUseCase := usecase.NewAuthService(log, authService)
-
-		metaRepository := repository.MetaRepository(log, db)
-		metaService := service.NewMetaService(log, metaRepository)
-		metaUseCase := usecase.NewMetaUseCase(log, metaService)
-
-		middleware := m.NewMiddleware(api, log, authUseCase)
+	cmd.PreRunE = record(cmd.PreRunE)
+	cmd.RunE = record(cmd.RunE)
+	cmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		*failed = true
+		return err
+	})
+	for _, sub := range cmd.Commands() {
+		recordFailures(sub, failed)
+	}
+}
 
-		// gRPC 미들웨어 적용
-		r.Use(middleware.WithGrpcMeta())
+func main() {
 
-		// Register Handler
-		handler.RegisterAuthHandler(api, log, authUseCase, userUseCase, middleware)
-		handler.RegisterMetaHandler(api, log, metaUseCase)
+	cli := humacli.New(func(hooks humacli.Hooks, opts *Options) {
+		// onParsed 는 모든 하위 명령 실행 전에 호출되므로
+		// DB, gRPC 연결은 서버 시작 시점에 생성한다.
+		var s *server
+		built := make(chan struct{})
 
-		server := http.Server{
-			Addr:    ":8080",
-			Handler: r,
-		}
 		// 서버 시작시
 		hooks.OnStart(func() {
-			log.Info("서버를 시작합니다 :8080")
-			if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-				log.Fatal("서버를 초기화 하지 못했습니다.", zap.Error(err))
-			}
+			s = newServer(opts)
+			close(built)
+			s.start()
 		})
 		// 서버 종료시
 		hooks.OnStop(func() {
-			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-			defer cancel()
+			<-built
+			s.stop()
+		})
+	})
 
-			stopped := make(chan struct{})
+	cli.Root().AddCommand(configCommand())
+	cli.Root().AddCommand(migrateCommand())
+	cli.Root().AddCommand(seedCommand())
+	cli.Root().AddCommand(openapiCommand())
 
-			go func() {
-				_ = server.Shutdown(ctx)
-				close(stopped)
-			}()
+	// 실행 중 오류에는 사용법 대신 오류만 출력한다.
+	cli.Root().SilenceUsage = true
 
-			select {
-			case <-stopped:
-				log.Info("api서버가 정상적으로 종료되었습니다.")
-			case <-ctx.Done():
-				log.Warn("서버가 종료 제한시간에 도달하여 강제 종료 되었습니다.")
-			}
-		})
-	})
+	var failed bool
+	recordFailures(cli.Root(), &failed)
 	cli.Run()
+	if failed {
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/GDH-Project/api/internal/migration"
+	"github.com/GDH-Project/api/internal/resource"
+	"github.com/spf13/cobra"
+)
+
+// migrateCommand 내장된 스키마 마이그레이션을 관리합니다.
+func migrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Manage embedded database schema migrations",
+	}
+
+	var upSteps int
+	up := &cobra.Command{
+		Use:   "up",
+		Short: "Apply pending migrations",
+		RunE: withOptionsE(func(cmd *cobra.Command, args []string, opts *Options) error {
+			migrator, closeDB, err := newMigrator(opts)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			applied, err := migrator.Up(cmd.Context(), upSteps)
+			for _, m := range applied {
+				fmt.Printf("applied %04d_%s\n", m.Version, m.Name)
+			}
+			if err != nil {
+				return fmt.Errorf("마이그레이션 적용에 실패했습니다: %w", err)
+			}
+			fmt.Printf("%d migration(s) applied\n", len(applied))
+			return nil
+		}),
+	}
+	up.Flags().IntVarP(&upSteps, "steps", "n", 0, "Number of migrations to apply (0 = all)")
+
+	var downSteps int
+	var downAll bool
+	down := &cobra.Command{
+		Use:   "down",
+		Short: "Revert applied migrations",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if downAll && cmd.Flags().Changed("steps") {
+				return errors.New("--steps 와 --all 은 함께 사용할 수 없습니다")
+			}
+			if !downAll && downSteps < 1 {
+				return fmt.Errorf("--steps 는 1 이상이어야 합니다 (%d), 모두 되돌리려면 --all 을 사용하세요", downSteps)
+			}
+			return nil
+		},
+		RunE: withOptionsE(func(cmd *cobra.Command, args []string, opts *Options) error {
+			migrator, closeDB, err := newMigrator(opts)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			var reverted []*migration.Migration
+			if downAll {
+				reverted, err = migrator.DownAll(cmd.Context())
+			} else {
+				reverted, err = migrator.Down(cmd.Context(), downSteps)
+			}
+			for _, m := range reverted {
+				fmt.Printf("reverted %04d_%s\n", m.Version, m.Name)
+			}
+			if err != nil {
+				return fmt.Errorf("마이그레이션 되돌리기에 실패했습니다: %w", err)
+			}
+			fmt.Printf("%d migration(s) reverted\n", len(reverted))
+			return nil
+		}),
+	}
+	down.Flags().IntVarP(&downSteps, "steps", "n", 1, "Number of migrations to revert")
+	down.Flags().BoolVar(&downAll, "all", false, "Revert every applied migration")
+
+	status := &cobra.Command{
+		Use:   "status",
+		Short: "Show migration status",
+		RunE: withOptionsE(func(cmd *cobra.Command, args []string, opts *Options) error {
+			migrator, closeDB, err := newMigrator(opts)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			statusList, err := migrator.Status(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("마이그레이션 상태 조회에 실패했습니다: %w", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+			for _, s := range statusList {
+				state, appliedAt := "pending", "-"
+				if s.Applied {
+					state, appliedAt = "applied", s.AppliedAt.Local().Format("2006-01-02 15:04:05")
+				}
+				fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", s.Version, s.Name, state, appliedAt)
+			}
+			return w.Flush()
+		}),
+	}
+
+	cmd.AddCommand(up, down, status)
+
+	return cmd
+}
+
+// newMigrator 마이그레이션이 끝나면 반환된 closeDB 로 커넥션 풀을 닫아야 합니다.
+func newMigrator(opts *Options) (migrator *migration.Migrator, closeDB func(), err error) {
+	log, cfg := loadConfig(opts)
+	db := resource.InitDB(cfg.DB.PoolConfig(), log)
+
+	migrator, err = migration.NewMigrator(log, db)
+	if err != nil {
+		db.Close()
+		return nil, nil, fmt.Errorf("마이그레이션을 불러오지 못했습니다: %w", err)
+	}
+
+	return migrator, db.Close, nil
+}
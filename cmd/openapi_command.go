@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/GDH-Project/api/cmd/config"
+	"github.com/GDH-Project/api/internal/health"
+	m "github.com/GDH-Project/api/internal/middleware"
+	"github.com/GDH-Project/api/internal/openapi"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/adapters/humagin"
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// openapiCommand DB, gRPC 연결 없이 OpenAPI 문서를 생성하거나 이전 문서와 비교합니다.
+func openapiCommand() *cobra.Command {
+	var format, specVersion, output, compare string
+
+	cmd := &cobra.Command{
+		Use:   "openapi",
+		Short: "Write the OpenAPI spec or check it for breaking changes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			api := newSpecAPI()
+
+			if compare != "" {
+				return compareSpec(api, compare)
+			}
+
+			out, err := marshalSpec(api.OpenAPI(), format, specVersion)
+			if err != nil {
+				return err
+			}
+
+			if output == "" {
+				fmt.Println(string(out))
+				return nil
+			}
+			return os.WriteFile(output, out, 0o644)
+		},
+	}
+	cmd.Flags().StringVarP(&format, "format", "f", "json", "Output format (json, yaml)")
+	cmd.Flags().StringVar(&specVersion, "spec-version", "3.1", "OpenAPI version (3.1, 3.0)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file (default stdout)")
+	cmd.Flags().StringVar(&compare, "compare", "", "Previous spec file to check for breaking changes")
+
+	return cmd
+}
+
+// newSpecAPI 의존성 없이 handler 만 등록한 API 를 생성합니다.
+func newSpecAPI() huma.API {
+	gin.SetMode(gin.ReleaseMode)
+	log := zap.NewNop()
+
+	api := humagin.New(gin.New(), newHumaConfig())
+	registerHandlers(api, log, m.NewMiddleware(api, log, nil, nil, nil, nil, nil, nil), &dependencies{
+		healthChecker: health.NewChecker(log, time.Second),
+		cacheTTL:      config.Default().Cache.MetaTTL(),
+	})
+
+	return api
+}
+
+func marshalSpec(spec *huma.OpenAPI, format string, specVersion string) ([]byte, error) {
+	switch {
+	case specVersion == "3.1" && format == "json":
+		return spec.MarshalJSON()
+	case specVersion == "3.1" && format == "yaml":
+		return spec.YAML()
+	case specVersion == "3.0" && format == "json":
+		return spec.Downgrade()
+	case specVersion == "3.0" && format == "yaml":
+		return spec.DowngradeYAML()
+	default:
+		return nil, fmt.Errorf("지원하지 않는 형식 입니다: format=%s, spec-version=%s", format, specVersion)
+	}
+}
+
+func compareSpec(api huma.API, path string) error {
+	oldSpec, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	newSpec, err := api.OpenAPI().MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	changes, err := openapi.Compare(oldSpec, newSpec)
+	if err != nil {
+		return err
+	}
+
+	breaking := 0
+	for _, c := range changes {
+		fmt.Println(c)
+		if c.Breaking {
+			breaking++
+		}
+	}
+	fmt.Printf("%d change(s), %d breaking\n", len(changes), breaking)
+
+	if breaking > 0 {
+		return fmt.Errorf("%s 대비 breaking change 가 %d 건 있습니다", path, breaking)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/GDH-Project/api/internal/resource"
+	"github.com/GDH-Project/api/internal/seed"
+	"github.com/spf13/cobra"
+)
+
+// seedCommand 기본 참조 데이터(센서, 작물, 통신 주기, 주소)를 적재합니다.
+func seedCommand() *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Load the default reference data catalogue (idempotent)",
+		RunE: withOptionsE(func(cmd *cobra.Command, args []string, opts *Options) error {
+			log, cfg := loadConfig(opts)
+			db := resource.InitDB(cfg.DB.PoolConfig(), log)
+			defer db.Close()
+
+			seeder, err := seed.NewSeeder(log, db)
+			if err != nil {
+				return fmt.Errorf("참조 데이터를 불러오지 못했습니다: %w", err)
+			}
+
+			changes, err := seeder.Run(cmd.Context(), dryRun)
+			if err != nil {
+				return fmt.Errorf("참조 데이터 적재에 실패했습니다: %w", err)
+			}
+
+			for _, c := range changes {
+				fmt.Println(c)
+			}
+			if dryRun {
+				fmt.Printf("%d change(s) would be applied (dry-run)\n", len(changes))
+				return nil
+			}
+			fmt.Printf("%d change(s) applied\n", len(changes))
+			return nil
+		}),
+	}
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show what would change without writing")
+
+	return cmd
+}
@@ -0,0 +1,469 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/GDH-Project/api/cmd/config"
+	"github.com/GDH-Project/api/internal/authcookie"
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/grpc"
+	"github.com/GDH-Project/api/internal/health"
+	"github.com/GDH-Project/api/internal/iplocation"
+	"github.com/GDH-Project/api/internal/job"
+	"github.com/GDH-Project/api/internal/mailer"
+	"github.com/GDH-Project/api/internal/metrics"
+	m "github.com/GDH-Project/api/internal/middleware"
+	"github.com/GDH-Project/api/internal/migration"
+	"github.com/GDH-Project/api/internal/oauth"
+	"github.com/GDH-Project/api/internal/ratelimit"
+	"github.com/GDH-Project/api/internal/repository"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/GDH-Project/api/internal/resource"
+	"github.com/GDH-Project/api/internal/service"
+	"github.com/GDH-Project/api/internal/tracing"
+	usecase "github.com/GDH-Project/api/internal/use_case"
+	"github.com/danielgtaylor/huma/v2/adapters/humagin"
+	"github.com/gin-contrib/cors"
+	ginzap "github.com/gin-contrib/zap"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	ggrpc "google.golang.org/grpc"
+)
+
+type server struct {
+	log             *zap.Logger
+	cfg             *config.Config
+	http            *http.Server
+	healthChecker   *health.Checker
+	jobs            *job.Runner
+	shutdownTracing func(context.Context) error
+
+	// ctx 서버가 종료되면 취소되어 주기 작업을 중단한다.
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// loadConfig 설정을 로드하고 설정에 맞는 로거를 생성합니다.
+func loadConfig(opts *Options) (*zap.Logger, *config.Config) {
+	cfg, err := config.Load(opts.Config)
+	if err != nil {
+		log := config.InitLogger(config.Default().Log, opts.Debug)
+		log.Fatal("설정 로드에 실패했습니다.", zap.Error(err),
+			zap.String("config", opts.Config),
+		)
+	}
+
+	log := config.InitLogger(cfg.Log, opts.Debug)
+	log.Info("설정 로드 성공", zap.String("config", opts.Config))
+
+	return log, cfg
+}
+
+func newServer(opts *Options) *server {
+	log, cfg := loadConfig(opts)
+	log.Info("GDH Project API SERVER", zap.String("version", Version))
+
+	// tracing 설정
+	shutdownTracing, err := tracing.Init(context.Background(), log, tracing.Config{
+		Exporter:    tracing.Exporter(cfg.Trace.Exporter),
+		SampleRatio: cfg.Trace.SampleRatio,
+		Version:     Version,
+	})
+	if err != nil {
+		log.Fatal("트레이싱을 초기화 하지 못했습니다.", zap.Error(err))
+	}
+
+	if opts.Debug {
+		gin.SetMode(gin.DebugMode)
+	} else {
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	var r *gin.Engine
+
+	if opts.Debug {
+		r = gin.Default()
+	} else {
+		r = gin.New()
+		r.Use(ginzap.GinzapWithConfig(log, &ginzap.Config{
+			TimeFormat:   time.RFC3339,
+			UTC:          true,
+			DefaultLevel: zapcore.InfoLevel,
+			Context: func(c *gin.Context) []zapcore.Field {
+				return []zapcore.Field{zap.String("request_id", requestid.FromContext(c.Request.Context()))}
+			},
+		}))
+		r.Use(ginzap.RecoveryWithZap(log, true))
+	}
+	r.Use(otelgin.Middleware(tracing.ServiceName))
+
+	// 클라이언트 IP 는 설정된 플랫폼 헤더, 프록시의 X-Forwarded-For 만 신뢰한다. (기본값은 접속 주소)
+	r.TrustedPlatform = cfg.Server.TrustedPlatformHeader()
+	if err := r.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		log.Fatal("신뢰할 프록시 설정이 올바르지 않습니다.", zap.Error(err))
+	}
+	log.Info("클라이언트 IP 설정", zap.String("trusted_platform", cfg.Server.TrustedPlatform), zap.Strings("trusted_proxies", cfg.Server.TrustedProxies))
+
+	// cors 설정
+	corsConfig := cors.DefaultConfig()
+	log.Info("CORS host list", zap.Any("hostlist", cfg.Cors.HostList))
+	corsConfig.AllowOrigins = cfg.Cors.HostList
+	corsConfig.AllowHeaders = append(corsConfig.AllowHeaders, "Authorization", requestid.Header, authcookie.CSRFHeader)
+	// 쿠키 인증, 소셜 로그인 state 쿠키를 위해 등록된 호스트에 한해 credentials 를 허용한다.
+	corsConfig.AllowCredentials = cfg.Cookie.Enabled || cfg.OAuth.Enabled()
+	corsConfig.ExposeHeaders = append(corsConfig.ExposeHeaders, "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After", requestid.Header)
+	r.Use(cors.New(corsConfig))
+
+	api := humagin.New(r, newHumaConfig())
+
+	// prometheus 지표
+	appMetrics := metrics.NewMetrics()
+	api.UseMiddleware(appMetrics.HumaMiddleware())
+	api.UseMiddleware(tracing.HumaMiddleware())
+
+	// Dependency
+	db := resource.InitDB(cfg.DB.PoolConfig(), log)
+	appMetrics.RegisterDBPool(db)
+	if cfg.DB.AutoMigrate {
+		migrateUp(log, db)
+	}
+	// 주기 작업, 서버 시작시 실행하고 종료시 중단한다.
+	ctx, cancel := context.WithCancel(context.Background())
+	jobs := job.NewRunner(log)
+
+	// gRPC Client 생성
+	grpcDialOptions := []ggrpc.DialOption{
+		ggrpc.WithChainUnaryInterceptor(appMetrics.GrpcUnaryClientInterceptor()),
+		ggrpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}
+	authGrpcClientConn := grpc.NewBaseClient(log, cfg.Grpc.AuthServer, grpcDialOptions...)
+	userGrpcClientConn := authGrpcClientConn
+	if cfg.Grpc.UserGrpcServer() != cfg.Grpc.AuthServer {
+		userGrpcClientConn = grpc.NewBaseClient(log, cfg.Grpc.UserGrpcServer(), grpcDialOptions...)
+	}
+
+	// 소셜 로그인, 비밀번호 변경, 재설정시 소셜 전용 계정의 비밀번호를 갱신하므로 먼저 생성한다.
+	var oauthService domain.OAuthService
+	if cfg.OAuth.Enabled() {
+		oauthService = newOAuthService(log, cfg.OAuth, db, jobs)
+	}
+
+	userGrpcClient := grpc.NewUserClient(log, userGrpcClientConn)
+	userService := service.NewUserService(log, userGrpcClient)
+	userUseCase := usecase.NewUserUseCase(log, userService, oauthService)
+
+	authGrpcClient := grpc.NewAuthClient(log, authGrpcClientConn)
+	authService := service.NewAuthService(log, authGrpcClient)
+	lockoutRepository := repository.LockoutRepository(log, db)
+	lockoutService := service.NewLockoutService(log, lockoutRepository, cfg.SignIn.Policy(), jobs)
+	tokenRepository := repository.TokenRevocationRepository(log, db)
+	tokenService := service.NewTokenRevocationService(log, tokenRepository, jobs)
+	suspensionRepository := repository.UserSuspensionRepository(log, db)
+	suspensionService := service.NewUserSuspensionService(log, suspensionRepository)
+	sessionRepository := repository.SessionRepository(log, db)
+	sessionService := service.NewSessionService(log, sessionRepository, newIPLocator(log, cfg.Session), jobs)
+	sessionUseCase := usecase.NewSessionUseCase(log, sessionService)
+
+	// 2단계 인증
+	var twoFactorService domain.TwoFactorService
+	var twoFactorUseCase domain.TwoFactorUseCase
+	if cfg.TwoFactor.Enabled() {
+		twoFactorService = newTwoFactorService(log, cfg.TwoFactor, db, jobs)
+		twoFactorUseCase = usecase.NewTwoFactorUseCase(log, twoFactorService, userUseCase, lockoutService)
+		log.Info("2단계 인증 활성화", zap.String("issuer", cfg.TwoFactor.Issuer))
+	}
+	authUseCase := usecase.NewAuthService(log, authService, lockoutService, tokenService, suspensionService, sessionService, twoFactorService)
+
+	auditRepository := repository.AuditRepository(log, db)
+	auditService := service.NewAuditService(log, auditRepository)
+	auditUseCase := usecase.NewAuditUseCase(log, auditService)
+
+	// 회원 탈퇴 데이터 정리
+	accountDeletionRepository := repository.AccountDeletionRepository(log, db)
+	accountDeletionService := service.NewAccountDeletionService(log, accountDeletionRepository, userGrpcClient, auditService, cfg.AccountDeletion.Policy(), jobs)
+	accountDeletionUseCase := usecase.NewAccountDeletionUseCase(log, accountDeletionService, tokenService, userUseCase)
+
+	// 이메일 인증, 비밀번호 재설정
+	var emailService domain.EmailService
+	var emailUseCase domain.EmailUseCase
+	if cfg.Email.Enabled() {
+		emailService = service.NewEmailService(log, repository.EmailRepository(log, db), newMailer(log, cfg.Email), cfg.Email.Policy(), jobs)
+		emailUseCase = usecase.NewEmailUseCase(log, emailService, userUseCase, tokenService, oauthService)
+		log.Info("이메일 인증 활성화", zap.String("mailer", cfg.Email.Mailer))
+	}
+
+	personalAccessTokenRepository := repository.PersonalAccessTokenRepository(log, db)
+	personalAccessTokenService := service.NewPersonalAccessTokenService(log, personalAccessTokenRepository, cfg.PersonalAccessToken.Policy())
+	personalAccessTokenUseCase := usecase.NewPersonalAccessTokenUseCase(log, personalAccessTokenService, suspensionService)
+
+	// 소셜 로그인
+	var oauthUseCase domain.OAuthUseCase
+	if oauthService != nil {
+		oauthUseCase = usecase.NewOAuthUseCase(log, oauthService, emailService, userUseCase, authUseCase)
+		log.Info("소셜 로그인 활성화", zap.Strings("providers", oauthUseCase.GetOAuthProviderList()))
+	}
+
+	// 사용자 장치
+	deviceRepository := repository.DeviceRepository(log, db)
+	deviceService := service.NewDeviceService(log, deviceRepository)
+	deviceUseCase := usecase.NewDeviceUseCase(log, deviceService)
+
+	adminUseCase := usecase.NewAdminUseCase(log, suspensionService, tokenService, userUseCase, emailUseCase, deviceService)
+
+	rollupRepository := repository.RollupRepository(log, db)
+
+	metaRepository := repository.MetaRepository(log, db)
+	metaService := service.NewMetaService(log, metaRepository, rollupRepository)
+	metaUseCase := usecase.NewMetaUseCase(log, metaService, deviceService)
+
+	// 센서 데이터 집계
+	rollupService := service.NewRollupService(log, rollupRepository, metaRepository, cfg.Rollup.Policy(), jobs)
+	rollupUseCase := usecase.NewRollupUseCase(log, rollupService, deviceService)
+
+	// 센서 데이터 보관 기간 정리
+	retentionRepository := repository.RetentionRepository(log, db)
+	retentionService := service.NewRetentionService(log, retentionRepository, cfg.Retention.Schedule(), jobs)
+	retentionUseCase := usecase.NewRetentionUseCase(log, retentionService)
+
+	healthChecks := []health.Check{
+		health.PostgresCheck(db),
+		health.GrpcConnCheck("auth_grpc_conn", authGrpcClientConn),
+		health.GrpcHealthCheck("auth_grpc_health", authGrpcClientConn),
+	}
+	if userGrpcClientConn != authGrpcClientConn {
+		healthChecks = append(healthChecks,
+			health.GrpcConnCheck("user_grpc_conn", userGrpcClientConn),
+			health.GrpcHealthCheck("user_grpc_health", userGrpcClientConn),
+		)
+	}
+	healthChecker := health.NewChecker(log, cfg.Health.Timeout.Std(), healthChecks...)
+
+	// 요청 제한
+	var limiter *ratelimit.Limiter
+	if cfg.RateLimit.Enabled {
+		limiter = newRateLimiter(log, cfg.RateLimit, db, jobs)
+	}
+
+	// 쿠키 인증
+	cookie := cfg.Cookie.Options()
+	if cookie != nil {
+		log.Info("쿠키 인증 활성화", zap.String("same_site", cfg.Cookie.SameSite), zap.Bool("secure", cfg.Cookie.Secure))
+	}
+
+	middleware := m.NewMiddleware(api, log, authUseCase, limiter, cookie, emailUseCase, personalAccessTokenUseCase, twoFactorUseCase)
+	api.UseMiddleware(middleware.WithRateLimit())
+
+	// 요청 ID, gRPC 미들웨어 적용
+	r.Use(middleware.WithRequestID())
+	r.Use(middleware.WithGrpcMeta())
+
+	// Register Handler
+	registerHandlers(api, log, middleware, &dependencies{
+		authUseCase:            authUseCase,
+		userUseCase:            userUseCase,
+		metaUseCase:            metaUseCase,
+		deviceUseCase:          deviceUseCase,
+		auditUseCase:           auditUseCase,
+		oauthUseCase:           oauthUseCase,
+		emailUseCase:           emailUseCase,
+		adminUseCase:           adminUseCase,
+		tokenUseCase:           personalAccessTokenUseCase,
+		sessionUseCase:         sessionUseCase,
+		twoFactorUseCase:       twoFactorUseCase,
+		accountDeletionUseCase: accountDeletionUseCase,
+		rollupUseCase:          rollupUseCase,
+		retentionUseCase:       retentionUseCase,
+		cookie:                 cookie,
+		oauthCookie:            cfg.Cookie.StateOptions(cfg.OAuth.StateTTL.Std()),
+		healthChecker:          healthChecker,
+		cacheTTL:               cfg.Cache.MetaTTL(),
+	})
+
+	appMetrics.RegisterRoute(r, log, metrics.Guard{
+		User:      cfg.Metrics.User,
+		Password:  cfg.Metrics.Password,
+		AllowList: cfg.Metrics.AllowList,
+	})
+
+	return &server{
+		log: log,
+		cfg: cfg,
+		http: &http.Server{
+			Addr:              cfg.Server.ListenAddr,
+			Handler:           r,
+			ReadTimeout:       cfg.Server.ReadTimeout.Std(),
+			ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout.Std(),
+			WriteTimeout:      cfg.Server.WriteTimeout.Std(),
+			IdleTimeout:       cfg.Server.IdleTimeout.Std(),
+		},
+		healthChecker:   healthChecker,
+		jobs:            jobs,
+		shutdownTracing: shutdownTracing,
+		ctx:             ctx,
+		cancel:          cancel,
+	}
+}
+
+// newRateLimiter 설정에 맞는 저장소로 요청 제한기를 생성합니다.
+func newRateLimiter(log *zap.Logger, cfg config.RateLimitConfig, db *pgxpool.Pool, jobs *job.Runner) *ratelimit.Limiter {
+	var store ratelimit.Store
+	switch cfg.Store {
+	case "postgres":
+		store = ratelimit.NewPostgresStore(log, db, jobs)
+	default:
+		store = ratelimit.NewMemoryStore(jobs)
+	}
+
+	operations := make(map[string]ratelimit.Rule, len(cfg.Operations))
+	for operationID, rule := range cfg.Operations {
+		operations[operationID] = ratelimit.Rule(rule)
+	}
+
+	log.Info("요청 제한 활성화",
+		zap.String("store", cfg.Store),
+		zap.Stringer("default", cfg.Default),
+		zap.Any("operations", cfg.Operations),
+	)
+
+	return ratelimit.NewLimiter(log, store, ratelimit.Rule(cfg.Default), operations)
+}
+
+// newOAuthService 설정된 제공자로 소셜 로그인 service 를 생성합니다.
+func newOAuthService(log *zap.Logger, cfg config.OAuthConfig, db *pgxpool.Pool, jobs *job.Runner) domain.OAuthService {
+	providers := make(map[string]domain.OAuthProvider)
+	if cfg.Google.Enabled() {
+		providers[oauth.ProviderGoogle] = oauth.NewOIDCProvider(log, cfg.Google.Config())
+	}
+	if cfg.Kakao.Enabled() {
+		providers[oauth.ProviderKakao] = oauth.NewOIDCProvider(log, cfg.Kakao.Config())
+	}
+	if cfg.Naver.Enabled() {
+		providers[oauth.ProviderNaver] = oauth.NewNaverProvider(log, cfg.Naver.Config())
+	}
+
+	policy, err := cfg.Policy()
+	if err != nil {
+		log.Fatal("소셜 로그인 설정이 올바르지 않습니다.", zap.Error(err))
+	}
+	oauthService, err := service.NewOAuthService(log, repository.OAuthRepository(log, db), providers, policy, jobs)
+	if err != nil {
+		log.Fatal("소셜 로그인을 초기화 하지 못했습니다.", zap.Error(err))
+	}
+
+	return oauthService
+}
+
+// newMailer 설정에 맞는 메일 발송 방식을 생성합니다.
+func newMailer(log *zap.Logger, cfg config.EmailConfig) domain.Mailer {
+	if cfg.Mailer != "smtp" {
+		log.Warn("메일을 발송하지 않고 로그로 남깁니다. 운영 환경에서는 EMAIL_MAILER=smtp 를 사용하세요.")
+		return mailer.NewLogMailer(log)
+	}
+
+	smtpMailer, err := mailer.NewSMTPMailer(log, cfg.SMTPConfig())
+	if err != nil {
+		log.Fatal("메일 발송을 초기화 하지 못했습니다.", zap.Error(err))
+	}
+
+	return smtpMailer
+}
+
+// newTwoFactorService 2단계 인증 서비스를 생성합니다.
+func newTwoFactorService(log *zap.Logger, cfg config.TwoFactorConfig, db *pgxpool.Pool, jobs *job.Runner) domain.TwoFactorService {
+	policy, err := cfg.Policy()
+	if err != nil {
+		log.Fatal("2단계 인증 설정이 올바르지 않습니다.", zap.Error(err))
+	}
+	twoFactorService, err := service.NewTwoFactorService(log, repository.TwoFactorRepository(log, db), policy, jobs)
+	if err != nil {
+		log.Fatal("2단계 인증을 초기화 하지 못했습니다.", zap.Error(err))
+	}
+
+	return twoFactorService
+}
+
+// newIPLocator 세션 위치 표시에 사용할 IP 대역 파일을 읽습니다.
+func newIPLocator(log *zap.Logger, cfg config.SessionConfig) *iplocation.Locator {
+	if cfg.IPLocationFile == "" {
+		return nil
+	}
+
+	locator, err := iplocation.Load(cfg.IPLocationFile)
+	if err != nil {
+		log.Fatal("IP 대역 파일을 읽지 못했습니다.", zap.Error(err), zap.String("file", cfg.IPLocationFile))
+	}
+	log.Info("IP 대역 파일 로드", zap.Int("prefixes", locator.Len()))
+
+	return locator
+}
+
+// migrateUp 서버 시작 전 적용되지 않은 마이그레이션을 모두 적용합니다.
+func migrateUp(log *zap.Logger, db *pgxpool.Pool) {
+	migrator, err := migration.NewMigrator(log, db)
+	if err != nil {
+		log.Fatal("마이그레이션을 불러오지 못했습니다.", zap.Error(err))
+	}
+
+	applied, err := migrator.Up(context.Background(), 0)
+	if err != nil {
+		log.Fatal("자동 마이그레이션에 실패했습니다.", zap.Error(err))
+	}
+
+	log.Info("자동 마이그레이션 완료", zap.Int("applied", len(applied)))
+}
+
+func (s *server) start() {
+	s.jobs.Start(s.ctx)
+
+	s.log.Info("서버를 시작합니다", zap.String("addr", s.cfg.Server.ListenAddr))
+	if err := s.http.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		s.log.Fatal("서버를 초기화 하지 못했습니다.", zap.Error(err))
+	}
+}
+
+func (s *server) stop() {
+	// 종료 중에는 readiness 를 false 로 변경하여 신규 트래픽 유입을 막는다.
+	s.healthChecker.SetReady(false)
+	if delay := s.cfg.Server.DrainDelay.Std(); delay > 0 {
+		s.log.Info("로드밸런서가 readiness 변경을 확인할 때까지 대기합니다.", zap.Duration("drain_delay", delay))
+		time.Sleep(delay)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Server.ShutdownTimeout.Std())
+	defer cancel()
+
+	stopped := make(chan struct{})
+
+	go func() {
+		_ = s.http.Shutdown(ctx)
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		s.log.Info("api서버가 정상적으로 종료되었습니다.")
+	case <-ctx.Done():
+		s.log.Warn("서버가 종료 제한시간에 도달하여 강제 종료 되었습니다.")
+	}
+
+	// 요청 처리가 끝난 뒤 주기 작업을 중단한다.
+	s.cancel()
+	jobCtx, jobCancel := context.WithTimeout(context.Background(), s.cfg.Server.ShutdownTimeout.Std())
+	defer jobCancel()
+	if err := s.jobs.Wait(jobCtx); err != nil {
+		s.log.Warn("주기 작업이 종료 제한시간 내에 끝나지 않았습니다.", zap.Error(err))
+	}
+
+	traceCtx, traceCancel := context.WithTimeout(context.Background(), s.cfg.Server.ShutdownTimeout.Std())
+	defer traceCancel()
+	if err := s.shutdownTracing(traceCtx); err != nil {
+		s.log.Warn("트레이싱 종료 중 오류가 발생했습니다.", zap.Error(err))
+	}
+}
@@ -0,0 +1,106 @@
+// Package agro 기온, 상대습도로 재배 환경 지표와 생육 도일을 계산합니다.
+//
+// 포화 수증기압은 Tetens 식, 이슬점은 Magnus 식을 사용합니다.
+package agro
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// Magnus 식 계수 (-40 ~ 50°C)
+	magnusA = 17.27
+	magnusB = 237.3
+
+	// waterVapourConstant 수증기압(kPa)과 절대온도(K)로 절대습도(g/m³)를 구하는 상수 (Mw / R * 1000)
+	waterVapourConstant = 2165
+)
+
+// SaturationVapourPressure 기온(°C)의 포화 수증기압(kPa)
+func SaturationVapourPressure(temp float64) float64 {
+	return 0.6108 * math.Exp(magnusA*temp/(temp+magnusB))
+}
+
+// VapourPressure 기온(°C), 상대습도(%)의 실제 수증기압(kPa)
+func VapourPressure(temp, rh float64) float64 {
+	return SaturationVapourPressure(temp) * rh / 100
+}
+
+// VapourPressureDeficit 수증기압차(kPa)
+func VapourPressureDeficit(temp, rh float64) float64 {
+	return SaturationVapourPressure(temp) - VapourPressure(temp, rh)
+}
+
+// DewPoint 이슬점(°C), 상대습도는 0 보다 커야 합니다.
+func DewPoint(temp, rh float64) float64 {
+	gamma := math.Log(rh/100) + magnusA*temp/(temp+magnusB)
+	return magnusB * gamma / (magnusA - gamma)
+}
+
+// AbsoluteHumidity 공기 1m³ 에 포함된 수증기량(g/m³)
+func AbsoluteHumidity(temp, rh float64) float64 {
+	return waterVapourConstant * VapourPressure(temp, rh) / (temp + 273.15)
+}
+
+// HumidityDeficit 포화될 때까지 더 포함할 수 있는 수증기량(g/m³)
+func HumidityDeficit(temp, rh float64) float64 {
+	return waterVapourConstant * VapourPressureDeficit(temp, rh) / (temp + 273.15)
+}
+
+// Formula
+//
+// 파생 센서의 계산식 입니다. 입력은 Inputs 순서대로 전달합니다.
+type Formula struct {
+	Name   string
+	Inputs []FormulaInput
+	calc   func(temp, rh float64) float64
+}
+
+// FormulaInput 계산식 입력 값의 의미와 단위 입니다.
+type FormulaInput struct {
+	Name string
+	Unit string
+}
+
+var climateInputs = []FormulaInput{
+	{Name: "temperature", Unit: "°C"},
+	{Name: "relative_humidity", Unit: "%"},
+}
+
+// Calc 입력 값으로 계산합니다. 입력 값이 범위를 벗어나면 false 를 반환합니다.
+func (f *Formula) Calc(in ...float64) (float64, bool) {
+	if len(in) != len(f.Inputs) {
+		return 0, false
+	}
+	temp, rh := in[0], in[1]
+	// 기온 -40 ~ 60°C, 상대습도 0 초과 100 이하만 계산
+	if math.IsNaN(temp) || temp < -40 || temp > 60 || math.IsNaN(rh) || rh <= 0 || rh > 100 {
+		return 0, false
+	}
+
+	// 소수점 3자리까지 사용
+	return round(f.calc(temp, rh)), true
+}
+
+var formulas = map[string]*Formula{}
+
+func register(name string, calc func(temp, rh float64) float64) {
+	formulas[name] = &Formula{Name: name, Inputs: climateInputs, calc: calc}
+}
+
+func init() {
+	register("vpd", VapourPressureDeficit)
+	register("dew_point", DewPoint)
+	register("absolute_humidity", AbsoluteHumidity)
+	register("humidity_deficit", HumidityDeficit)
+}
+
+// LookupFormula 이름으로 계산식을 찾습니다.
+func LookupFormula(name string) (*Formula, error) {
+	f, ok := formulas[name]
+	if !ok {
+		return nil, fmt.Errorf("알 수 없는 계산식 입니다 (%q)", name)
+	}
+	return f, nil
+}
@@ -0,0 +1,156 @@
+package agro
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
+
+func TestClimateFormulas(t *testing.T) {
+	// 기준값: FAO-56 Annex 2 Table 2.3 포화 수증기압, 이슬점과 절대습도는 같은 식으로 계산한 값
+	tests := []struct {
+		name     string
+		temp, rh float64
+		svp      float64 // kPa
+		vpd      float64 // kPa
+		dewPoint float64 // °C
+		absolute float64 // g/m³
+	}{
+		{name: "0°C 100%", temp: 0, rh: 100, svp: 0.611, vpd: 0, dewPoint: 0, absolute: 4.84},
+		{name: "20°C 50%", temp: 20, rh: 50, svp: 2.338, vpd: 1.169, dewPoint: 9.27, absolute: 8.63},
+		{name: "25°C 80%", temp: 25, rh: 80, svp: 3.168, vpd: 0.634, dewPoint: 21.31, absolute: 18.41},
+		{name: "30°C 60%", temp: 30, rh: 60, svp: 4.243, vpd: 1.697, dewPoint: 21.38, absolute: 18.19},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SaturationVapourPressure(tt.temp); !almostEqual(got, tt.svp, 0.001) {
+				t.Errorf("SaturationVapourPressure() = %v, want %v", got, tt.svp)
+			}
+			if got := VapourPressureDeficit(tt.temp, tt.rh); !almostEqual(got, tt.vpd, 0.002) {
+				t.Errorf("VapourPressureDeficit() = %v, want %v", got, tt.vpd)
+			}
+			if got := DewPoint(tt.temp, tt.rh); !almostEqual(got, tt.dewPoint, 0.01) {
+				t.Errorf("DewPoint() = %v, want %v", got, tt.dewPoint)
+			}
+			if got := AbsoluteHumidity(tt.temp, tt.rh); !almostEqual(got, tt.absolute, 0.01) {
+				t.Errorf("AbsoluteHumidity() = %v, want %v", got, tt.absolute)
+			}
+			// 절대습도 + 포화 부족량 = 포화 절대습도
+			if got, want := AbsoluteHumidity(tt.temp, tt.rh)+HumidityDeficit(tt.temp, tt.rh), AbsoluteHumidity(tt.temp, 100); !almostEqual(got, want, 1e-9) {
+				t.Errorf("AbsoluteHumidity() + HumidityDeficit() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestFormulaCalc(t *testing.T) {
+	tests := []struct {
+		name    string
+		formula string
+		in      []float64
+		want    float64
+		ok      bool
+	}{
+		{name: "vpd", formula: "vpd", in: []float64{20, 50}, want: 1.169, ok: true},
+		{name: "이슬점", formula: "dew_point", in: []float64{20, 50}, want: 9.27, ok: true},
+		{name: "입력 수 부족", formula: "vpd", in: []float64{20}, ok: false},
+		{name: "상대습도 0", formula: "dew_point", in: []float64{20, 0}, ok: false},
+		{name: "상대습도 100 초과", formula: "vpd", in: []float64{20, 100.1}, ok: false},
+		{name: "기온 범위 밖", formula: "vpd", in: []float64{-41, 50}, ok: false},
+		{name: "NaN", formula: "absolute_humidity", in: []float64{math.NaN(), 50}, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := LookupFormula(tt.formula)
+			if err != nil {
+				t.Fatalf("LookupFormula() error = %v", err)
+			}
+			got, ok := f.Calc(tt.in...)
+			if ok != tt.ok {
+				t.Fatalf("Calc() ok = %v, want %v", ok, tt.ok)
+			}
+			if ok && got != tt.want {
+				t.Errorf("Calc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := LookupFormula("unknown"); err == nil {
+		t.Error("LookupFormula(unknown) error = nil")
+	}
+}
+
+func TestDegreeDayValue(t *testing.T) {
+	tests := []struct {
+		name               string
+		min, max, base, up float64
+		want               float64
+	}{
+		{name: "기준 온도 이상", min: 15, max: 25, base: 10, up: 30, want: 10},
+		{name: "최저 기온이 기준 미만", min: 5, max: 25, base: 10, up: 30, want: 7.5},
+		{name: "최고 기온이 상한 초과", min: 20, max: 40, base: 10, up: 30, want: 15},
+		{name: "모두 기준 미만", min: 0, max: 8, base: 10, up: 30, want: 0},
+		{name: "모두 상한 초과", min: 32, max: 38, base: 10, up: 30, want: 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DegreeDayValue(tt.min, tt.max, tt.base, tt.up); got != tt.want {
+				t.Errorf("DegreeDayValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrowingDegreeDays(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2026, 5, day, hour, 0, 0, 0, Seoul)
+	}
+	readings := []Reading{
+		// 한국 표준시 5/1 00시는 UTC 4/30 15시, 같은 날로 집계해야 한다.
+		{Time: at(1, 0).UTC(), Value: 12},
+		{Time: at(1, 14), Value: 24},
+		{Time: at(1, 23), Value: math.NaN()},
+		// 5/2 결측
+		{Time: at(3, 15), Value: 30},
+		{Time: at(3, 5), Value: 8},
+		// 범위 밖
+		{Time: at(4, 12), Value: 40},
+	}
+
+	days := GrowingDegreeDays(readings, at(1, 9), at(3, 20), 10, 30)
+	want := []struct {
+		date       time.Time
+		count      int
+		min, max   float64
+		value      float64
+		cumulative float64
+	}{
+		{date: at(1, 0), count: 2, min: 12, max: 24, value: 8, cumulative: 8},
+		{date: at(2, 0), count: 0, value: 0, cumulative: 8},
+		{date: at(3, 0), count: 2, min: 8, max: 30, value: 10, cumulative: 18},
+	}
+
+	if len(days) != len(want) {
+		t.Fatalf("len(days) = %d, want %d", len(days), len(want))
+	}
+	for i, w := range want {
+		d := days[i]
+		if !d.Date.Equal(w.date) || d.Count != w.count || d.Value != w.value || d.Cumulative != w.cumulative {
+			t.Errorf("days[%d] = %+v, want %+v", i, *d, w)
+		}
+		if w.count > 0 && (d.Min != w.min || d.Max != w.max) {
+			t.Errorf("days[%d] min, max = %v, %v, want %v, %v", i, d.Min, d.Max, w.min, w.max)
+		}
+	}
+
+	if days := GrowingDegreeDays(readings, at(3, 0), at(1, 0), 10, 30); len(days) != 0 {
+		t.Errorf("to < from, len(days) = %d, want 0", len(days))
+	}
+}
@@ -0,0 +1,95 @@
+package agro
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Seoul 생육 일수를 계산하는 한국 표준시, 1988년 이후 일광 절약 시간을 사용하지 않아 고정 시간대로 사용합니다.
+var Seoul = time.FixedZone("Asia/Seoul", 9*60*60)
+
+// Reading 수집 시각과 값 입니다.
+type Reading struct {
+	Time  time.Time
+	Value float64
+}
+
+// DegreeDay 하루의 생육 도일 입니다.
+type DegreeDay struct {
+	Date       time.Time // 한국 표준시 자정
+	Min, Max   float64
+	Count      int     // 하루 동안의 기온 수, 0 이면 결측
+	Value      float64 // 하루의 생육 도일
+	Cumulative float64 // 시작일부터 누적 생육 도일
+}
+
+// DegreeDayValue 하루의 최저, 최고 기온으로 생육 도일을 계산합니다.
+//
+// 최저, 최고 기온을 기준 온도와 상한 온도 사이로 제한한 평균에서 기준 온도를 뺍니다.
+func DegreeDayValue(min, max, base, upper float64) float64 {
+	clamp := func(v float64) float64 {
+		return math.Min(math.Max(v, base), upper)
+	}
+	return (clamp(min)+clamp(max))/2 - base
+}
+
+// GrowingDegreeDays
+//
+// from 이 포함된 날부터 to 가 포함된 날까지 한국 표준시 기준 하루 단위로 누적 생육 도일을 계산합니다.
+// 기온이 없는 날은 0 으로 누적하고 Count 가 0 입니다. 범위 밖의 기온은 무시합니다.
+func GrowingDegreeDays(readings []Reading, from, to time.Time, base, upper float64) []*DegreeDay {
+	start := day(from)
+	end := day(to)
+	if end.Before(start) {
+		return []*DegreeDay{}
+	}
+
+	days := make([]*DegreeDay, 0, int(end.Sub(start).Hours()/24)+1)
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		days = append(days, &DegreeDay{Date: d})
+	}
+
+	sorted := make([]Reading, len(readings))
+	copy(sorted, readings)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Time.Before(sorted[j].Time) })
+
+	for _, r := range sorted {
+		if math.IsNaN(r.Value) {
+			continue
+		}
+		d := day(r.Time)
+		if d.Before(start) || d.After(end) {
+			continue
+		}
+		dd := days[int(d.Sub(start).Hours()/24)]
+		if dd.Count == 0 || r.Value < dd.Min {
+			dd.Min = r.Value
+		}
+		if dd.Count == 0 || r.Value > dd.Max {
+			dd.Max = r.Value
+		}
+		dd.Count++
+	}
+
+	var cumulative float64
+	for _, dd := range days {
+		if dd.Count > 0 {
+			dd.Value = round(DegreeDayValue(dd.Min, dd.Max, base, upper))
+		}
+		cumulative += dd.Value
+		dd.Cumulative = round(cumulative)
+	}
+
+	return days
+}
+
+// day 한국 표준시 기준 자정
+func day(t time.Time) time.Time {
+	t = t.In(Seoul)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, Seoul)
+}
+
+func round(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
@@ -0,0 +1,124 @@
+package authcookie
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+)
+
+// 쿠키, 헤더 이름 입니다. huma 입력 struct 태그에서도 같은 값을 사용한다.
+const (
+	AccessTokenCookie  = "gdh_access_token"
+	RefreshTokenCookie = "gdh_refresh_token"
+	CSRFTokenCookie    = "gdh_csrf_token"
+	CSRFHeader         = "X-CSRF-Token"
+	OAuthStateCookie   = "gdh_oauth_state"
+
+	// RefreshTokenPath refresh token 은 인증 API 에만 전송되도록 경로를 제한한다.
+	RefreshTokenPath = "/api/v1/auth"
+)
+
+// Options
+//
+// 브라우저 쿠키 인증 설정 입니다.
+// access, refresh token 은 HttpOnly 쿠키로, CSRF 토큰은 스크립트에서 읽을 수 있는 쿠키로 설정합니다.
+type Options struct {
+	Domain        string
+	Secure        bool
+	SameSite      http.SameSite
+	AccessMaxAge  time.Duration
+	RefreshMaxAge time.Duration
+	// StateMaxAge 소셜 로그인 state 쿠키 유효 시간
+	StateMaxAge time.Duration
+}
+
+// TokenCookies 토큰과 새로운 CSRF 토큰 쿠키를 생성합니다.
+func (o *Options) TokenCookies(token *domain.Token) ([]http.Cookie, error) {
+	csrfToken, err := newCSRFToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return []http.Cookie{
+		o.cookie(AccessTokenCookie, token.AccessToken, "/", o.AccessMaxAge, true),
+		o.cookie(RefreshTokenCookie, token.RefreshToken, RefreshTokenPath, o.RefreshMaxAge, true),
+		o.cookie(CSRFTokenCookie, csrfToken, "/", o.RefreshMaxAge, false),
+	}, nil
+}
+
+// ClearCookies 토큰, CSRF 쿠키를 삭제하는 쿠키를 생성합니다.
+func (o *Options) ClearCookies() []http.Cookie {
+	return []http.Cookie{
+		o.cookie(AccessTokenCookie, "", "/", -1, true),
+		o.cookie(RefreshTokenCookie, "", RefreshTokenPath, -1, true),
+		o.cookie(CSRFTokenCookie, "", "/", -1, false),
+	}
+}
+
+// StateCookie 소셜 로그인 state 를 브라우저에 연결하는 쿠키를 생성합니다.
+//
+// 로그인 요청의 state 와 비교하여 다른 브라우저에서 시작된 인가 요청(login CSRF)으로 로그인 할 수 없게 한다.
+func (o *Options) StateCookie(state string) http.Cookie {
+	return o.cookie(OAuthStateCookie, state, RefreshTokenPath, o.StateMaxAge, true)
+}
+
+// ClearStateCookie 소셜 로그인 state 쿠키를 삭제하는 쿠키를 생성합니다.
+func (o *Options) ClearStateCookie() http.Cookie {
+	return o.cookie(OAuthStateCookie, "", RefreshTokenPath, -1, true)
+}
+
+func (o *Options) cookie(name string, value string, path string, maxAge time.Duration, httpOnly bool) http.Cookie {
+	c := http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   o.Domain,
+		Secure:   o.Secure,
+		HttpOnly: httpOnly,
+		SameSite: o.SameSite,
+	}
+	if maxAge < 0 {
+		c.MaxAge = -1
+	} else {
+		c.MaxAge = int(maxAge.Seconds())
+	}
+	return c
+}
+
+// ValidCSRF double-submit 방식으로 쿠키와 헤더의 CSRF 토큰이 같은지 확인합니다.
+func ValidCSRF(cookieToken string, headerToken string) bool {
+	return equal(cookieToken, headerToken)
+}
+
+// ValidState 쿠키와 로그인 요청의 소셜 로그인 state 가 같은지 확인합니다.
+func ValidState(cookieState string, state string) bool {
+	return equal(cookieState, state)
+}
+
+func equal(a string, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+// SafeMethod 상태를 변경하지 않는 요청인지 확인합니다. CSRF 검사를 하지 않는다.
+func SafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+func newCSRFToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// AccountDeletionMode 탈퇴한 사용자의 데이터 처리 방식 입니다.
+type AccountDeletionMode string
+
+const (
+	// AccountDeletionModePurge 사용자의 데이터를 모두 삭제합니다.
+	AccountDeletionModePurge AccountDeletionMode = "purge"
+	// AccountDeletionModeAnonymize 인증 정보는 삭제하고 이력은 사용자를 알 수 없도록 익명 ID 로 바꿔서 보관합니다.
+	AccountDeletionModeAnonymize AccountDeletionMode = "anonymize"
+)
+
+// AccountDeletionStatus 데이터 정리 작업 상태 입니다.
+type AccountDeletionStatus string
+
+const (
+	// AccountDeletionStatusPending 사용자 서버의 탈퇴 확인 전
+	AccountDeletionStatusPending AccountDeletionStatus = "pending"
+	// AccountDeletionStatusConfirmed 탈퇴가 확인되어 데이터를 정리하는 중, 실패하면 재시도 한다.
+	AccountDeletionStatusConfirmed AccountDeletionStatus = "confirmed"
+	// AccountDeletionStatusCompleted 데이터 정리 완료
+	AccountDeletionStatusCompleted AccountDeletionStatus = "completed"
+)
+
+// AccountDeletion
+//
+// 회원 탈퇴 후 사용자 데이터 정리 작업 입니다.
+// 사용자 서버의 탈퇴 전에 pending 으로 기록하여 중간에 서버가 종료되어도 이어서 정리할 수 있습니다.
+type AccountDeletion struct {
+	Email string `json:"-"` // 이메일 기준 데이터 정리에 사용하고 완료되면 비운다.
+
+	ID            string                `json:"id" doc:"작업 ID 입니다. 익명화한 데이터의 사용자 ID 는 deleted:{id} 입니다." format:"uuid"`
+	UserID        string                `json:"user_id" doc:"탈퇴한 사용자 ID 입니다."`
+	Mode          AccountDeletionMode   `json:"mode" enum:"purge,anonymize" doc:"데이터 처리 방식 입니다."`
+	Status        AccountDeletionStatus `json:"status" enum:"pending,confirmed,completed" doc:"작업 상태 입니다."`
+	Attempts      int                   `json:"attempts" doc:"실패한 정리 시도 횟수 입니다."`
+	LastError     string                `json:"last_error,omitempty" doc:"마지막 실패 사유 입니다."`
+	Result        map[string]int64      `json:"result" doc:"정리한 데이터 종류별 건수 입니다." example:"{\"session\":3,\"personal_access_token\":1}"`
+	RequestedAt   time.Time             `json:"requested_at" doc:"탈퇴 요청 시각 입니다."`
+	NextAttemptAt time.Time             `json:"next_attempt_at" doc:"다음 정리 시도 시각 입니다."`
+	CompletedAt   *time.Time            `json:"completed_at,omitempty" doc:"정리 완료 시각 입니다."`
+}
+
+// Pseudonym 익명화한 데이터에 사용하는 사용자 ID 입니다.
+func (d *AccountDeletion) Pseudonym() string {
+	return "deleted:" + d.ID
+}
+
+type AccountDeletionRepository interface {
+	// CreateAccountDeletion pending 작업 생성, 이전 pending 작업이 있으면 교체
+	CreateAccountDeletion(ctx context.Context, deletion *AccountDeletion) error
+	// ConfirmAccountDeletion pending 작업을 confirmed 로 변경
+	ConfirmAccountDeletion(ctx context.Context, userID string) error
+	// DeleteAccountDeletion pending 작업 삭제
+	DeleteAccountDeletion(ctx context.Context, userID string) error
+	// PurgeAccountData confirmed 작업의 데이터 정리 후 완료 처리, 한 트랜잭션으로 처리한다. 없으면 ErrNotFound
+	PurgeAccountData(ctx context.Context, userID string) (*AccountDeletion, error)
+	// FailAccountDeletion 실패 횟수, 사유 기록 후 retryAfter * 실패 횟수 이후 재시도
+	FailAccountDeletion(ctx context.Context, userID string, reason string, retryAfter time.Duration) error
+
+	// GetAccountDeletion 작업 조회, 없으면 ErrNotFound
+	GetAccountDeletion(ctx context.Context, userID string) (*AccountDeletion, error)
+	// GetAccountDeletionListByStatus 상태별 작업 조회 (최신순)
+	GetAccountDeletionListByStatus(ctx context.Context, status AccountDeletionStatus, limit int) ([]*AccountDeletion, error)
+	// GetDueAccountDeletionList 재시도 시각이 지난 confirmed 작업 조회
+	GetDueAccountDeletionList(ctx context.Context, limit int) ([]*AccountDeletion, error)
+	// GetStaleAccountDeletionList requestedBefore 이전에 요청된 pending 작업 조회
+	GetStaleAccountDeletionList(ctx context.Context, requestedBefore time.Time, limit int) ([]*AccountDeletion, error)
+}
+
+type AccountDeletionService interface {
+	// RequestAccountDeletion 사용자 서버 탈퇴 전 작업 기록
+	RequestAccountDeletion(ctx context.Context, userID string, email string, mode AccountDeletionMode) error
+	// ConfirmAccountDeletion 사용자 서버 탈퇴 확인
+	ConfirmAccountDeletion(ctx context.Context, userID string) error
+	// CancelAccountDeletion 사용자 서버 탈퇴 실패시 작업 취소
+	CancelAccountDeletion(ctx context.Context, userID string) error
+	// CleanupAccountData 데이터 정리, 실패하면 기록 후 재시도 한다.
+	CleanupAccountData(ctx context.Context, userID string) (*AccountDeletion, error)
+
+	// GetAccountDeletion 작업 조회
+	GetAccountDeletion(ctx context.Context, userID string) (*AccountDeletion, error)
+	// GetAccountDeletionList 상태별 작업 조회
+	GetAccountDeletionList(ctx context.Context, status AccountDeletionStatus) ([]*AccountDeletion, error)
+	// DefaultMode 사용자가 선택하지 않은 경우의 처리 방식
+	DefaultMode() AccountDeletionMode
+}
+
+type AccountDeletionUseCase interface {
+	// DeleteAccount 사용자 서버에서 탈퇴 후 데이터 정리, 정리에 실패해도 탈퇴는 완료되고 재시도 한다.
+	// mode 가 비어있으면 설정된 기본 방식을 사용합니다.
+	DeleteAccount(ctx context.Context, userID string, password string, mode AccountDeletionMode) (*AccountDeletion, error)
+
+	// GetAccountDeletion 작업 조회
+	GetAccountDeletion(ctx context.Context, userID string) (*AccountDeletion, error)
+	// GetAccountDeletionList 상태별 작업 조회
+	GetAccountDeletionList(ctx context.Context, status AccountDeletionStatus) ([]*AccountDeletion, error)
+	// RetryAccountDeletion 정리가 끝나지 않은 작업 즉시 재시도
+	RetryAccountDeletion(ctx context.Context, userID string) (*AccountDeletion, error)
+}
+
+// AccountDeletionPolicy 회원 탈퇴 데이터 정리 정책 입니다.
+type AccountDeletionPolicy struct {
+	DefaultMode AccountDeletionMode // 사용자가 선택하지 않은 경우의 처리 방식
+	RetryAfter  time.Duration       // 실패한 정리 재시도 간격, 실패할 때마다 늘어난다.
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrUserSuspended 관리자가 정지한 계정인 경우
+var ErrUserSuspended = errors.New("정지된 계정 입니다")
+
+// UserSuspension 계정 정지 정보 입니다.
+type UserSuspension struct {
+	UserID      string    `json:"user_id" doc:"정지된 사용자 ID 입니다."`
+	Reason      string    `json:"reason" doc:"정지 사유 입니다."`
+	SuspendedBy string    `json:"suspended_by" doc:"정지한 관리자 ID 입니다."`
+	SuspendedAt time.Time `json:"suspended_at" doc:"정지 시각 입니다."`
+}
+
+// AdminUser 관리자가 조회하는 사용자 정보 입니다.
+type AdminUser struct {
+	ID    string   `json:"id" doc:"사용자 ID 입니다."`
+	Name  string   `json:"name" doc:"사용자 닉네임 입니다."`
+	Email string   `json:"email" doc:"이메일 입니다."`
+	Role  UserRole `json:"role" doc:"사용자의 권한 입니다."`
+	// EmailVerified 이메일 인증이 비활성화 된 경우 nil
+	EmailVerified *bool           `json:"email_verified,omitempty" doc:"이메일 인증 여부 입니다."`
+	Suspension    *UserSuspension `json:"suspension,omitempty" doc:"정지된 계정인 경우 정지 정보 입니다."`
+}
+
+type UserSuspensionRepository interface {
+	// CreateUserSuspension 계정 정지, 이미 정지된 경우 사유를 갱신
+	CreateUserSuspension(ctx context.Context, suspension *UserSuspension) error
+	// DeleteUserSuspension 계정 정지 해제, 정지되지 않은 경우 ErrNotFound
+	DeleteUserSuspension(ctx context.Context, userID string) error
+	// GetUserSuspension 계정 정지 조회, 없으면 ErrNotFound
+	GetUserSuspension(ctx context.Context, userID string) (*UserSuspension, error)
+	// GetUserSuspensionList 정지된 계정 전체 조회
+	GetUserSuspensionList(ctx context.Context) ([]*UserSuspension, error)
+}
+
+type UserSuspensionService interface {
+	UserSuspensionRepository
+
+	// CheckUser 정지된 계정이면 ErrUserSuspended 반환
+	CheckUser(ctx context.Context, userID string) error
+}
+
+type AdminUseCase interface {
+	// GetUser 사용자 조회
+	GetUser(ctx context.Context, userID string) (*AdminUser, error)
+	// GetUserByEmail 이메일로 사용자 조회
+	GetUserByEmail(ctx context.Context, email string) (*AdminUser, error)
+
+	// SuspendUser 계정 정지 후 사용자의 모든 세션 폐기
+	SuspendUser(ctx context.Context, userID string, reason string, adminID string) error
+	// ReactivateUser 계정 정지 해제
+	ReactivateUser(ctx context.Context, userID string) error
+	// GetSuspendedUserList 정지된 계정 전체 조회
+	GetSuspendedUserList(ctx context.Context) ([]*UserSuspension, error)
+
+	// GetUserDeviceListWithPage 사용자의 장치 조회 (최신순), 존재하지 않는 사용자는 ErrNotFound
+	GetUserDeviceListWithPage(ctx context.Context, userID string, page Page) ([]*DeviceInfo, int, error)
+}
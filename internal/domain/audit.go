@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// AuditAction 감사 로그 행위 입니다.
+type AuditAction string
+
+const (
+	AuditActionSignUp             AuditAction = "auth.sign_up"
+	AuditActionSignIn             AuditAction = "auth.sign_in"
+	AuditActionSignOut            AuditAction = "auth.sign_out"
+	AuditActionTwoFactorChallenge AuditAction = "auth.two_factor_challenge"
+	AuditActionUserUpdate         AuditAction = "user.update"
+	AuditActionPasswordChange     AuditAction = "user.password_change"
+	AuditActionUserDelete         AuditAction = "user.delete"
+	AuditActionUserDataCleanup    AuditAction = "user.data_cleanup"
+	AuditActionEmailVerify        AuditAction = "user.email_verify"
+	AuditActionPasswordReset      AuditAction = "user.password_reset"
+
+	AuditActionAccessTokenCreate      AuditAction = "user.access_token_create"
+	AuditActionAccessTokenRevoke      AuditAction = "user.access_token_revoke"
+	AuditActionSessionRevoke          AuditAction = "user.session_revoke"
+	AuditActionTwoFactorEnable        AuditAction = "user.two_factor_enable"
+	AuditActionTwoFactorDisable       AuditAction = "user.two_factor_disable"
+	AuditActionRecoveryCodeRegenerate AuditAction = "user.two_factor_recovery_codes"
+
+	AuditActionDeviceCreate AuditAction = "device.create"
+	AuditActionDeviceUpdate AuditAction = "device.update"
+	AuditActionDeviceDelete AuditAction = "device.delete"
+
+	AuditActionSignInLockoutClear     AuditAction = "admin.sign_in_lockout_clear"
+	AuditActionUserSuspend            AuditAction = "admin.user_suspend"
+	AuditActionUserReactivate         AuditAction = "admin.user_reactivate"
+	AuditActionTwoFactorRequiredRoles AuditAction = "admin.two_factor_required_roles"
+	AuditActionRetentionPolicyUpdate  AuditAction = "admin.retention_policy_update"
+	AuditActionRetentionPolicyDelete  AuditAction = "admin.retention_policy_delete"
+	AuditActionDeviceRetentionTier    AuditAction = "admin.device_retention_tier"
+)
+
+// AuditResult 감사 로그 결과 입니다.
+type AuditResult string
+
+const (
+	AuditResultSuccess AuditResult = "success"
+	AuditResultFailure AuditResult = "failure"
+)
+
+// AuditEvent
+//
+// 보안 감사 로그 입니다. 기록된 이후에는 수정, 삭제할 수 없습니다.
+type AuditEvent struct {
+	ID         int64       `json:"id" doc:"고유 ID 입니다." example:"1"`
+	OccurredAt time.Time   `json:"occurred_at" doc:"발생 시각 입니다."`
+	ActorID    string      `json:"actor_id" doc:"행위자 사용자 ID 입니다. 로그인 전 요청은 비어있습니다."`
+	Action     AuditAction `json:"action" doc:"행위 입니다." example:"auth.sign_in"`
+	TargetType string      `json:"target_type" doc:"대상 구분 입니다." example:"user"`
+	TargetID   string      `json:"target_id" doc:"대상 ID 혹은 이메일 입니다." example:"example@example.com"`
+	ClientIP   string      `json:"client_ip" doc:"요청 IP 입니다." example:"127.0.0.1"`
+	UserAgent  string      `json:"user_agent" doc:"요청 User-Agent 입니다."`
+	Result     AuditResult `json:"result" enum:"success,failure" doc:"결과 입니다." example:"success"`
+	Reason     string      `json:"reason" doc:"실패 사유 입니다."`
+}
+
+// AuditFilter 감사 로그 조회 조건 입니다. 값이 없는 조건은 무시합니다.
+type AuditFilter struct {
+	ActorID  string
+	Action   AuditAction
+	TargetID string
+	Result   AuditResult
+	From     *time.Time
+	To       *time.Time
+}
+
+type AuditRepository interface {
+	// CreateAuditEvent 감사 로그 기록
+	CreateAuditEvent(ctx context.Context, event *AuditEvent) error
+	// GetAuditEventListByFilterWithPage 감사 로그 조회 (최신순), 전체 건수를 함께 반환
+	GetAuditEventListByFilterWithPage(ctx context.Context, filter *AuditFilter, page Page) ([]*AuditEvent, int, error)
+}
+
+type AuditService interface {
+	AuditRepository
+}
+
+type AuditUseCase interface {
+	AuditRepository
+}
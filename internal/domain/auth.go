@@ -22,4 +22,17 @@ type AuthService interface {
 
 type AuthUseCase interface {
 	AuthClient
+
+	// Login 로그인 실패가 반복되면 *SignInLockedError, 2단계 인증이 필요하면 *TwoFactorRequiredError 반환
+	Login(ctx context.Context, email string, password string) (*Token, error)
+	// SignOut access token 폐기, refreshToken 이 있으면 함께 폐기, allSessions 이면 사용자의 모든 세션 폐기
+	// 다른 사용자의 refreshToken 이면 ErrInvalidParam 반환
+	SignOut(ctx context.Context, userID string, accessToken string, refreshToken string, allSessions bool) error
+	// CompleteTwoFactorSignIn 로그인 challenge 의 인증 코드 확인 후 토큰 반환
+	CompleteTwoFactorSignIn(ctx context.Context, challengeToken string, code string) (*Token, error)
+
+	// GetSignInLockoutList 현재 잠긴 로그인 전체 조회
+	GetSignInLockoutList(ctx context.Context) ([]*SignInFailure, error)
+	// ClearSignInLockout 로그인 잠금 해제
+	ClearSignInLockout(ctx context.Context, kind SignInFailureKind, subject string) error
 }
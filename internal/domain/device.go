@@ -1,9 +1,16 @@
 package domain
 
 import (
+	"context"
+	"errors"
 	"time"
 )
 
+var (
+	// ErrDeviceInvalid 작물, 통신 주기, 주소, 센서가 존재하지 않는 경우
+	ErrDeviceInvalid = errors.New("장치 정보가 올바르지 않습니다")
+)
+
 type Page struct {
 	Size int
 	Page int
@@ -23,13 +30,13 @@ type DeviceData struct {
 // 장비의 요청과 센서 정보를 바인딩 하는 스키마 입니다.
 type DeviceRequestSchema struct {
 	ID       int    `json:"id" doc:"고유 ID 입니다."`
-	DeviceID string // 장치 ID
+	DeviceID string `json:"-"` // 장치 ID
 	Key      string `json:"key" doc:"장비에서 보내는 데이터의 json key 입니다." example:"degree"`
 	Target   string `json:"target" doc:"센서 데이터 리스트의 title 명칭 입니다." example:"기온"`
 }
 
 type DeviceInfo struct {
-	UserID string // 유저의 UUID 입니다.
+	UserID string `json:"-"` // 유저의 UUID 입니다.
 
 	ID          string  `json:"id" doc:"장치 고유 ID 입니다." format:"uuid"`
 	Title       string  `json:"title" doc:"검색에 노출되는 명칭입니다." example:"경기도 안양시 자동 재배 시설 토마토 데이터"`
@@ -44,3 +51,43 @@ type DeviceInfo struct {
 	CreatedAt time.Time `json:"created_at" doc:"최초 장치 등록 시간 입니다." example:"2025-10-24 22:54:52.874221 +09:00"`
 	UpdatedAt time.Time `json:"updated_at" doc:"장치 정보 업데이트 시간 입니다." example:"2025-10-24 22:54:52.874221 +09:00"`
 }
+
+type DeviceRepository interface {
+	// CreateDevice 장치 등록, ID 와 등록 시각을 채운다. 작물, 통신 주기, 주소가 없으면 ErrDeviceInvalid
+	CreateDevice(ctx context.Context, in *DeviceInfo) error
+	// GetDeviceByID 장치 조회, 없으면 ErrNotFound
+	GetDeviceByID(ctx context.Context, deviceID string) (*DeviceInfo, error)
+	// GetDeviceListByUserIDWithPage 사용자의 장치 조회 (최신순), 전체 건수를 함께 반환
+	GetDeviceListByUserIDWithPage(ctx context.Context, userID string, page Page) ([]*DeviceInfo, int, error)
+	// UpdateDevice 사용자의 장치 수정, 없으면 ErrNotFound
+	UpdateDevice(ctx context.Context, in *DeviceInfo) error
+	// DeleteDevice 사용자의 장치와 센서 데이터 삭제, 없으면 ErrNotFound
+	DeleteDevice(ctx context.Context, userID string, deviceID string) error
+
+	// GetDeviceRequestSchemaList 장치 요청 스키마 조회
+	GetDeviceRequestSchemaList(ctx context.Context, deviceID string) ([]*DeviceRequestSchema, error)
+	// PutDeviceRequestSchemaList 장치 요청 스키마 교체, 센서가 없으면 ErrDeviceInvalid
+	PutDeviceRequestSchemaList(ctx context.Context, deviceID string, schemaList []*DeviceRequestSchema) error
+}
+
+type DeviceService interface {
+	// CreateDevice 장치 등록
+	CreateDevice(ctx context.Context, in *DeviceInfo) (*DeviceInfo, error)
+	// GetDevice 사용자의 장치 조회, 다른 사용자의 장치는 ErrNotFound
+	GetDevice(ctx context.Context, userID string, deviceID string) (*DeviceInfo, error)
+	// GetDeviceListByUserIDWithPage 사용자의 장치 조회 (최신순), 전체 건수를 함께 반환
+	GetDeviceListByUserIDWithPage(ctx context.Context, userID string, page Page) ([]*DeviceInfo, int, error)
+	// UpdateDevice 사용자의 장치 수정, 다른 사용자의 장치는 ErrNotFound
+	UpdateDevice(ctx context.Context, in *DeviceInfo) (*DeviceInfo, error)
+	// DeleteDevice 사용자의 장치와 센서 데이터 삭제, 다른 사용자의 장치는 ErrNotFound
+	DeleteDevice(ctx context.Context, userID string, deviceID string) error
+
+	// GetDeviceRequestSchemaList 사용자의 장치 요청 스키마 조회
+	GetDeviceRequestSchemaList(ctx context.Context, userID string, deviceID string) ([]*DeviceRequestSchema, error)
+	// PutDeviceRequestSchemaList 사용자의 장치 요청 스키마 교체
+	PutDeviceRequestSchemaList(ctx context.Context, userID string, deviceID string, schemaList []*DeviceRequestSchema) ([]*DeviceRequestSchema, error)
+}
+
+type DeviceUseCase interface {
+	DeviceService
+}
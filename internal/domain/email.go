@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrEmailTokenInvalid 서명이 올바르지 않거나 만료, 이미 사용된 토큰인 경우
+	ErrEmailTokenInvalid = errors.New("유효하지 않거나 만료된 링크 입니다")
+	// ErrEmailNotVerified 이메일 인증이 필요한 경우
+	ErrEmailNotVerified = errors.New("이메일 인증이 필요합니다")
+	// ErrEmailAlreadyVerified 이미 인증된 이메일인 경우
+	ErrEmailAlreadyVerified = errors.New("이미 인증된 이메일 입니다")
+)
+
+// EmailTokenPurpose 이메일 토큰 용도 입니다.
+type EmailTokenPurpose string
+
+const (
+	EmailTokenPurposeVerifyEmail   EmailTokenPurpose = "verify_email"
+	EmailTokenPurposeResetPassword EmailTokenPurpose = "reset_password"
+)
+
+// EmailToken 메일로 전달되는 일회용 토큰 입니다. 토큰 원문은 저장하지 않는다.
+type EmailToken struct {
+	TokenHash string
+	Purpose   EmailTokenPurpose
+	UserID    string
+	Email     string
+	ExpiresAt time.Time
+}
+
+// Mail 발송할 메일 입니다.
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer 메일 발송 방식 입니다.
+type Mailer interface {
+	Send(ctx context.Context, mail *Mail) error
+}
+
+// EmailPolicy 이메일 인증, 비밀번호 재설정 설정 입니다.
+type EmailPolicy struct {
+	// TokenSecret 토큰 서명 키
+	TokenSecret []byte
+	// VerifyURL 이메일 인증 링크, token 쿼리가 추가된다.
+	VerifyURL string
+	// ResetPasswordURL 비밀번호 재설정 링크, token 쿼리가 추가된다.
+	ResetPasswordURL string
+	VerifyTokenTTL   time.Duration
+	ResetTokenTTL    time.Duration
+}
+
+type EmailRepository interface {
+	// CreateEmailToken 토큰 저장
+	CreateEmailToken(ctx context.Context, token *EmailToken) error
+	// GetEmailToken 만료되지 않은 미사용 토큰 조회, 없으면 ErrNotFound
+	GetEmailToken(ctx context.Context, tokenHash string, purpose EmailTokenPurpose) (*EmailToken, error)
+	// UseEmailToken 만료되지 않은 미사용 토큰을 사용 처리, 없으면 ErrNotFound
+	UseEmailToken(ctx context.Context, tokenHash string, purpose EmailTokenPurpose) (*EmailToken, error)
+	// DeleteUnusedEmailToken 사용자의 미사용 토큰 삭제
+	DeleteUnusedEmailToken(ctx context.Context, userID string, purpose EmailTokenPurpose) error
+	// DeleteExpiredEmailToken 만료된 토큰 삭제
+	DeleteExpiredEmailToken(ctx context.Context) (int64, error)
+
+	// SetEmailVerified 인증된 이메일 저장
+	SetEmailVerified(ctx context.Context, userID string, email string) error
+	// GetVerifiedEmail 인증된 이메일 조회, 없으면 ErrNotFound
+	GetVerifiedEmail(ctx context.Context, userID string) (string, error)
+}
+
+type EmailService interface {
+	// IssueToken 서명된 일회용 토큰 발급, 같은 용도의 이전 토큰은 사용할 수 없다.
+	IssueToken(ctx context.Context, purpose EmailTokenPurpose, userID string, email string) (string, error)
+	// CheckToken 사용 처리하지 않고 토큰 검증, 유효하지 않으면 ErrEmailTokenInvalid
+	CheckToken(ctx context.Context, purpose EmailTokenPurpose, token string) (*EmailToken, error)
+	// ConsumeToken 토큰 검증 후 사용 처리, 유효하지 않으면 ErrEmailTokenInvalid
+	ConsumeToken(ctx context.Context, purpose EmailTokenPurpose, token string) (*EmailToken, error)
+
+	// SendVerifyEmail 이메일 인증 메일 발송
+	SendVerifyEmail(ctx context.Context, email string, token string) error
+	// SendResetPassword 비밀번호 재설정 메일 발송
+	SendResetPassword(ctx context.Context, email string, token string) error
+
+	// SetEmailVerified 인증된 이메일 저장
+	SetEmailVerified(ctx context.Context, userID string, email string) error
+	// IsEmailVerified 사용자의 현재 이메일이 인증되었는지 확인
+	IsEmailVerified(ctx context.Context, userID string, email string) (bool, error)
+}
+
+type EmailUseCase interface {
+	// SendEmailVerification 이메일 인증 메일 발송, 이미 인증된 경우 ErrEmailAlreadyVerified
+	SendEmailVerification(ctx context.Context, userID string) error
+	// VerifyEmail 이메일 인증 후 사용자 ID 반환
+	VerifyEmail(ctx context.Context, token string) (string, error)
+	// IsEmailVerified 사용자의 현재 이메일이 인증되었는지 확인
+	IsEmailVerified(ctx context.Context, userID string) (bool, error)
+
+	// RequestPasswordReset 비밀번호 재설정 메일 발송, 가입 여부를 알 수 없도록 없는 이메일도 성공 처리
+	RequestPasswordReset(ctx context.Context, email string) error
+	// ResetPassword 비밀번호 재설정 후 사용자의 모든 세션 폐기, 사용자 ID 반환
+	ResetPassword(ctx context.Context, token string, password string) (string, error)
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "errors"
+
+// 저장소 계층에서 사용하는 공통 에러 입니다.
+//
+// repository 는 드라이버 고유의 에러(pgx.ErrNoRows 등)를 아래 에러로 변환해서 반환하고,
+// handler 는 errors.Is 로 분기하여 응답 코드를 결정합니다.
+var (
+	// ErrNotFound 조회 대상이 존재하지 않는 경우
+	ErrNotFound = errors.New("데이터가 존재하지 않습니다")
+	// ErrInvalidParam 조회 조건이 올바르지 않은 경우
+	ErrInvalidParam = errors.New("잘못된 조회 조건 입니다")
+	// ErrUnavailable 외부 서비스(gRPC 등)에 일시적으로 연결할 수 없는 경우
+	ErrUnavailable = errors.New("일시적으로 서비스를 사용할 수 없습니다")
+)
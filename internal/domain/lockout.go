@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrSignInLocked 로그인 시도가 잠겨있거나 지연중인 경우
+var ErrSignInLocked = errors.New("로그인 시도가 너무 많습니다")
+
+// SignInLockedError 다음 로그인 시도까지 남은 시간을 담은 에러 입니다.
+type SignInLockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *SignInLockedError) Error() string {
+	return fmt.Sprintf("%s: %s 후 다시 시도해주세요", ErrSignInLocked, e.RetryAfter.Round(time.Second))
+}
+
+func (e *SignInLockedError) Unwrap() error {
+	return ErrSignInLocked
+}
+
+// SignInFailureKind 로그인 실패를 집계하는 기준 입니다.
+type SignInFailureKind string
+
+const (
+	SignInFailureKindEmail SignInFailureKind = "email"
+	SignInFailureKindIP    SignInFailureKind = "ip"
+	// SignInFailureKindTwoFactor 사용자 ID 별 2단계 인증 코드 실패
+	SignInFailureKindTwoFactor SignInFailureKind = "two_factor"
+)
+
+// SignInFailure
+//
+// 이메일, IP 별 로그인 실패 혹은 사용자 ID 별 2단계 인증 실패 기록 입니다.
+type SignInFailure struct {
+	Kind          SignInFailureKind `json:"kind" enum:"email,ip,two_factor" doc:"집계 기준 입니다." example:"email"`
+	Subject       string            `json:"subject" doc:"이메일, IP 혹은 사용자 ID 입니다." example:"example@example.com"`
+	Failures      int               `json:"failures" doc:"집계 구간 안의 실패 횟수 입니다." example:"5"`
+	FirstFailedAt time.Time         `json:"first_failed_at" doc:"집계 구간의 첫 실패 시각 입니다."`
+	LastFailedAt  time.Time         `json:"last_failed_at" doc:"마지막 실패 시각 입니다."`
+	LockedUntil   *time.Time        `json:"locked_until,omitempty" doc:"잠금 해제 시각 입니다."`
+}
+
+// SignInPolicy
+//
+// 로그인 실패 잠금 정책 입니다.
+// Window 안에서 실패가 MaxFailures 에 도달하면 LockoutDuration 동안 잠그고,
+// 잠금 전까지는 실패할 때마다 Delay 부터 두배씩(MaxDelay 까지) 다음 시도를 지연합니다.
+// 2단계 인증 코드 실패는 challenge 와 관계없이 사용자 ID 별로 MaxTwoFactorFailures 까지 셉니다.
+type SignInPolicy struct {
+	MaxEmailFailures     int
+	MaxIPFailures        int
+	MaxTwoFactorFailures int
+	Window               time.Duration
+	LockoutDuration      time.Duration
+	Delay                time.Duration
+	MaxDelay             time.Duration
+}
+
+type LockoutRepository interface {
+	// GetSignInFailure 로그인 실패 기록 조회
+	GetSignInFailure(ctx context.Context, kind SignInFailureKind, subject string) (*SignInFailure, error)
+	// RecordSignInFailure 로그인 실패를 기록하고 maxFailures 에 도달하면 잠금
+	RecordSignInFailure(ctx context.Context, kind SignInFailureKind, subject string, maxFailures int, window time.Duration, lockout time.Duration) (*SignInFailure, error)
+	// DeleteSignInFailure 로그인 실패 기록 삭제
+	DeleteSignInFailure(ctx context.Context, kind SignInFailureKind, subject string) error
+	// DeleteExpiredSignInFailure 집계 구간과 잠금이 모두 지난 기록 삭제
+	DeleteExpiredSignInFailure(ctx context.Context, window time.Duration) (int64, error)
+	// GetSignInLockoutList 현재 잠긴 기록 전체 조회
+	GetSignInLockoutList(ctx context.Context) ([]*SignInFailure, error)
+}
+
+type LockoutService interface {
+	// CheckSignIn 로그인 시도 가능 여부 확인, 불가능한 경우 *SignInLockedError 반환
+	CheckSignIn(ctx context.Context, email string, clientIP string) error
+	// RecordSignInFailure 로그인 실패 기록
+	RecordSignInFailure(ctx context.Context, email string, clientIP string) error
+	// RecordSignInSuccess 로그인 성공시 이메일 실패 기록 초기화
+	RecordSignInSuccess(ctx context.Context, email string) error
+
+	// CheckTwoFactor 2단계 인증 시도 가능 여부 확인, 불가능한 경우 *SignInLockedError 반환
+	CheckTwoFactor(ctx context.Context, userID string) error
+	// RecordTwoFactorFailure 2단계 인증 코드 실패 기록
+	RecordTwoFactorFailure(ctx context.Context, userID string) error
+	// RecordTwoFactorSuccess 2단계 인증 성공시 실패 기록 초기화
+	RecordTwoFactorSuccess(ctx context.Context, userID string) error
+
+	// GetSignInLockoutList 현재 잠긴 기록 전체 조회
+	GetSignInLockoutList(ctx context.Context) ([]*SignInFailure, error)
+	// ClearSignInLockout 잠금 해제
+	ClearSignInLockout(ctx context.Context, kind SignInFailureKind, subject string) error
+}
@@ -1,6 +1,16 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrDegreeDayThreshold 생육 도일 기준 온도, 상한 온도가 없거나 상한 온도가 기준 온도보다 낮은 경우
+var ErrDegreeDayThreshold = errors.New("생육 도일 기준 온도가 올바르지 않습니다")
+
+// SensorTitleTemperature 생육 도일 계산에 사용하는 기온 센서 명칭 입니다.
+const SensorTitleTemperature = "기온"
 
 type MetaRepository interface {
 	// GetSensorList 센서 전체 리스트 조회
@@ -34,11 +44,15 @@ type MetaService interface {
 	GetSensorList(ctx context.Context) ([]*Sensor, error)
 	// GetSensorByParam 센서를 파라미터를 통해 조회
 	GetSensorByParam(ctx context.Context, in *Sensor) (*Sensor, error)
+	// DeriveSensorValues 같은 시각에 수집된 센서 값(센서명: 값)으로 파생 센서 값 계산, 입력이 없거나 범위를 벗어난 센서는 제외
+	DeriveSensorValues(ctx context.Context, values map[string]float64) (map[string]float64, error)
 
 	// GetCropList 모든 작물 정보 조회
 	GetCropList(ctx context.Context) ([]*Crop, error)
 	// GetCropByParam 작물 파라미터를 통해 조회
 	GetCropByParam(ctx context.Context, in *Crop) (*Crop, error)
+	// GetGrowingDegreeDays 장치에 저장된 기온으로 파종(정식)일부터 오늘까지 한국 표준시 하루 단위 누적 생육 도일 계산
+	GetGrowingDegreeDays(ctx context.Context, in *GrowingDegreeDaysParam) (*GrowingDegreeDays, error)
 
 	// GetUpdateCycleList 업데이트 주기 조회
 	GetUpdateCycleList(ctx context.Context) ([]*UpdateCycle, error)
@@ -54,11 +68,15 @@ type MetaUseCase interface {
 	GetSensorList(ctx context.Context) ([]*Sensor, error)
 	// GetSensorByParam 센서를 파라미터를 통해 조회
 	GetSensorByParam(ctx context.Context, in *Sensor) (*Sensor, error)
+	// DeriveSensorValues 같은 시각에 수집된 센서 값으로 파생 센서 값 계산
+	DeriveSensorValues(ctx context.Context, values map[string]float64) (map[string]float64, error)
 
 	// GetCropList 모든 작물 정보 조회
 	GetCropList(ctx context.Context) ([]*Crop, error)
 	// GetCropByParam 작물 파라미터를 통해 조회
 	GetCropByParam(ctx context.Context, in *Crop) (*Crop, error)
+	// GetGrowingDegreeDays 사용자 장치의 작물, 기온으로 파종(정식)일부터 오늘까지 한국 표준시 하루 단위 누적 생육 도일 계산, 장치가 없거나 작물이 없으면 ErrNotFound
+	GetGrowingDegreeDays(ctx context.Context, in *GrowingDegreeDaysParam) (*GrowingDegreeDays, error)
 
 	// GetUpdateCycleList 업데이트 주기 조회
 	GetUpdateCycleList(ctx context.Context) ([]*UpdateCycle, error)
@@ -73,12 +91,19 @@ type MetaUseCase interface {
 //
 // 수집되는 센서 데이터 정보 입니다.
 type Sensor struct {
-	ID       int     `json:"id" doc:"센서의 고유 ID 입니다." example:"1"`
-	Title    string  `json:"title" doc:"센서의 한글 명칭 입니다." example:"기온"`
-	EngTitle string  `json:"eng_title" doc:"센서의 영어 명칭 입니다." example:"Air Temperature"`
-	Desc     string  `json:"desc" doc:"센서 설명 입니다." example:"작물의 광합성, 호흡, 증산 작용에 직접적인 영향을 미치는 대기의 온도"`
-	Unit     *string `json:"unit,omitempty" doc:"센서 단위 입니다." example:"°C"`
-	UnitDesc *string `json:"unit_desc,omitempty" doc:"센서 단위 설명 입니다." example:"섭씨"`
+	ID       int      `json:"id" doc:"센서의 고유 ID 입니다." example:"1"`
+	Title    string   `json:"title" doc:"센서의 한글 명칭 입니다." example:"기온"`
+	EngTitle string   `json:"eng_title" doc:"센서의 영어 명칭 입니다." example:"Air Temperature"`
+	Desc     string   `json:"desc" doc:"센서 설명 입니다." example:"작물의 광합성, 호흡, 증산 작용에 직접적인 영향을 미치는 대기의 온도"`
+	Unit     *string  `json:"unit,omitempty" doc:"센서 단위 입니다." example:"°C"`
+	UnitDesc *string  `json:"unit_desc,omitempty" doc:"센서 단위 설명 입니다." example:"섭씨"`
+	Formula  *string  `json:"formula,omitempty" doc:"파생 센서의 계산식 입니다. 파생 센서는 수집하지 않고 inputs 센서 값으로 계산합니다." example:"vpd"`
+	Inputs   []string `json:"inputs,omitempty" doc:"파생 센서 계산에 사용하는 센서 명칭 입니다." example:"[\"기온\",\"상대습도\"]"`
+}
+
+// Derived 파생 센서 여부
+func (s *Sensor) Derived() bool {
+	return s.Formula != nil
 }
 
 // Crop
@@ -88,6 +113,41 @@ type Crop struct {
 	ID    int     `json:"-"`
 	Title string  `json:"title" doc:"작물명" example:"토마토"`
 	Desc  *string `json:"desc,omitempty" doc:"작물의 설명" example:"토마토에 대한 설명입니다."`
+
+	GDDBase  *float64 `json:"gdd_base,omitempty" doc:"생육 도일 계산의 기준 온도(°C) 입니다." example:"10"`
+	GDDUpper *float64 `json:"gdd_upper,omitempty" doc:"생육 도일 계산의 상한 온도(°C) 입니다." example:"30"`
+}
+
+// GrowingDegreeDaysParam 생육 도일 계산 조건 입니다.
+type GrowingDegreeDaysParam struct {
+	Crop      string // 장치의 작물, 유스케이스에서 채운다.
+	UserID    string
+	DeviceID  string    // 기온을 수집한 장치, UserID 의 장치여야 한다.
+	PlantedOn time.Time // 파종(정식)일, 한국 표준시 자정
+	Base      *float64  // 없으면 작물의 기준 온도
+	Upper     *float64  // 없으면 작물의 상한 온도
+}
+
+// GrowingDegreeDays
+//
+// 누적 생육 도일 입니다.
+type GrowingDegreeDays struct {
+	Crop  string       `json:"crop" doc:"작물명" example:"토마토"`
+	Base  float64      `json:"base" doc:"계산에 사용한 기준 온도(°C) 입니다." example:"10"`
+	Upper float64      `json:"upper" doc:"계산에 사용한 상한 온도(°C) 입니다." example:"30"`
+	Total float64      `json:"total" doc:"오늘까지 누적 생육 도일 입니다." example:"412.5"`
+	Days  []*DegreeDay `json:"days" doc:"파종(정식)일부터 오늘까지 하루 단위 생육 도일 입니다."`
+}
+
+// DegreeDay
+//
+// 한국 표준시 하루의 생육 도일 입니다.
+type DegreeDay struct {
+	Date       string   `json:"date" format:"date" doc:"날짜 (Asia/Seoul) 입니다." example:"2026-03-01"`
+	Min        *float64 `json:"min,omitempty" doc:"최저 기온 입니다. 기온이 없는 날은 포함되지 않습니다." example:"12.1"`
+	Max        *float64 `json:"max,omitempty" doc:"최고 기온 입니다. 기온이 없는 날은 포함되지 않습니다." example:"27.4"`
+	Value      float64  `json:"gdd" doc:"하루의 생육 도일 입니다. 기온이 없는 날은 0 입니다." example:"9.75"`
+	Cumulative float64  `json:"cumulative" doc:"파종(정식)일부터 누적 생육 도일 입니다." example:"102.3"`
 }
 
 // UpdateCycle
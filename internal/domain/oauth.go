@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrOAuthProviderNotFound 설정되지 않은 소셜 로그인 제공자인 경우
+	ErrOAuthProviderNotFound = errors.New("지원하지 않는 소셜 로그인 입니다")
+	// ErrOAuthInvalidRequest state 가 만료되었거나 제공자 응답을 검증하지 못한 경우
+	ErrOAuthInvalidRequest = errors.New("소셜 로그인 요청이 유효하지 않습니다")
+	// ErrOAuthEmailConflict 같은 이메일의 비밀번호 계정이 이미 존재하는 경우
+	ErrOAuthEmailConflict = errors.New("이미 비밀번호로 가입된 이메일 입니다")
+)
+
+// OAuthState
+//
+// 인가 요청 마다 생성되는 일회용 state 입니다. PKCE code_verifier 와 nonce 를 보관합니다.
+type OAuthState struct {
+	State        string
+	Provider     string
+	CodeVerifier string
+	Nonce        string
+	RedirectURL  string
+	ExpiresAt    time.Time
+}
+
+// OAuthUserInfo 제공자에서 확인한 사용자 정보 입니다.
+type OAuthUserInfo struct {
+	Subject string
+	Email   string
+	// EmailVerified 제공자가 이메일 인증 여부를 알려주지 않으면 false 로 본다.
+	EmailVerified bool
+	Name          string
+}
+
+// OAuthIdentity 소셜 계정과 사용자의 연결 정보 입니다.
+type OAuthIdentity struct {
+	Provider       string
+	Subject        string
+	UserID         string
+	Email          string
+	CreatedAt      time.Time
+	LastSignedInAt time.Time
+}
+
+// OAuthPolicy 소셜 로그인 설정 입니다.
+type OAuthPolicy struct {
+	// RedirectURLs 허용하는 redirect_uri 목록
+	RedirectURLs []string
+	// StateTTL 인가 요청 유효 시간
+	StateTTL time.Duration
+	// CredentialKey 소셜 전용 계정 비밀번호 암호화 키 (AES-256)
+	CredentialKey []byte
+}
+
+// OAuthProvider 소셜 로그인 제공자 입니다.
+type OAuthProvider interface {
+	// AuthCodeURL PKCE(S256) 를 사용하는 인가 URL 생성
+	AuthCodeURL(ctx context.Context, state *OAuthState) (string, error)
+	// Exchange 인가 코드를 교환하고 사용자 정보를 확인
+	Exchange(ctx context.Context, state *OAuthState, code string) (*OAuthUserInfo, error)
+}
+
+type OAuthRepository interface {
+	// CreateOAuthState state 저장
+	CreateOAuthState(ctx context.Context, state *OAuthState) error
+	// TakeOAuthState 만료되지 않은 state 를 조회 후 삭제, 없으면 ErrNotFound
+	TakeOAuthState(ctx context.Context, state string) (*OAuthState, error)
+	// DeleteExpiredOAuthState 만료된 state 삭제
+	DeleteExpiredOAuthState(ctx context.Context) (int64, error)
+
+	// GetOAuthIdentity 소셜 계정 연결 조회, 없으면 ErrNotFound
+	GetOAuthIdentity(ctx context.Context, provider string, subject string) (*OAuthIdentity, error)
+	// CreateOAuthIdentity 소셜 계정 연결
+	CreateOAuthIdentity(ctx context.Context, identity *OAuthIdentity) error
+	// UpdateOAuthIdentitySignedIn 마지막 로그인 시각 갱신
+	UpdateOAuthIdentitySignedIn(ctx context.Context, provider string, subject string) error
+
+	// GetOAuthCredential 소셜 전용 계정의 암호화된 비밀번호 조회, 없으면 ErrNotFound
+	GetOAuthCredential(ctx context.Context, userID string) ([]byte, error)
+	// CreateOAuthCredential 소셜 전용 계정의 암호화된 비밀번호 저장
+	CreateOAuthCredential(ctx context.Context, userID string, encryptedPassword []byte) error
+	// UpdateOAuthCredential 소셜 전용 계정의 암호화된 비밀번호 변경, 없으면 ErrNotFound
+	UpdateOAuthCredential(ctx context.Context, userID string, encryptedPassword []byte) error
+}
+
+type OAuthService interface {
+	// ProviderList 설정된 제공자 목록
+	ProviderList() []string
+	// AuthCodeURL state 를 저장하고 인가 URL 과 state 반환
+	AuthCodeURL(ctx context.Context, provider string, redirectURL string) (url string, state string, err error)
+	// Exchange state 를 소비하고 인가 코드를 교환
+	Exchange(ctx context.Context, provider string, code string, state string) (*OAuthUserInfo, error)
+
+	// GetOAuthIdentity 소셜 계정 연결 조회, 없으면 ErrNotFound
+	GetOAuthIdentity(ctx context.Context, provider string, subject string) (*OAuthIdentity, error)
+	// LinkOAuthIdentity 소셜 계정 연결
+	LinkOAuthIdentity(ctx context.Context, identity *OAuthIdentity) error
+	// TouchOAuthIdentity 마지막 로그인 시각 갱신
+	TouchOAuthIdentity(ctx context.Context, provider string, subject string) error
+
+	// NewCredential 소셜 전용 계정의 비밀번호 생성
+	NewCredential() (string, error)
+	// GetCredential 소셜 전용 계정의 비밀번호 조회, 없으면 ErrNotFound
+	GetCredential(ctx context.Context, userID string) (string, error)
+	// SaveCredential 소셜 전용 계정의 비밀번호를 암호화하여 저장
+	SaveCredential(ctx context.Context, userID string, password string) error
+	// UpdateCredential 소셜 전용 계정이면 변경된 비밀번호로 갱신, 소셜 전용 계정이 아니면 무시
+	UpdateCredential(ctx context.Context, userID string, password string) error
+}
+
+type OAuthUseCase interface {
+	// GetOAuthProviderList 설정된 제공자 목록
+	GetOAuthProviderList() []string
+	// GetOAuthAuthorizationURL 인가 URL 과 state 반환, state 는 브라우저 쿠키에 저장하여 로그인 요청에서 확인한다.
+	GetOAuthAuthorizationURL(ctx context.Context, provider string, redirectURL string) (url string, state string, err error)
+	// OAuthLogin 인가 코드로 로그인, 연결된 계정이 없으면 연결하거나 생성, 2단계 인증이 필요하면 *TwoFactorRequiredError 반환
+	OAuthLogin(ctx context.Context, provider string, code string, state string) (*Token, error)
+}
@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrPersonalAccessTokenInvalid 존재하지 않거나 만료된 개인 액세스 토큰인 경우
+	ErrPersonalAccessTokenInvalid = errors.New("유효하지 않은 개인 액세스 토큰 입니다")
+	// ErrPersonalAccessTokenExpiry 만료 시각이 지났거나 최대 유효 기간을 넘는 경우
+	ErrPersonalAccessTokenExpiry = errors.New("만료 시각이 유효하지 않습니다")
+	// ErrPersonalAccessTokenLimit 사용자별 최대 개수를 초과한 경우
+	ErrPersonalAccessTokenLimit = errors.New("더 이상 개인 액세스 토큰을 만들 수 없습니다")
+)
+
+// PersonalAccessTokenPrefix 개인 액세스 토큰의 접두사 입니다. JWT 와 구분하는데 사용합니다.
+const PersonalAccessTokenPrefix = "gdhp_"
+
+// TokenScope 개인 액세스 토큰으로 접근할 수 있는 범위 입니다.
+type TokenScope string
+
+const (
+	TokenScopeReadData    TokenScope = "read:data"
+	TokenScopeWriteData   TokenScope = "write:data"
+	TokenScopeReadDevices TokenScope = "read:devices"
+)
+
+// TokenScopes 사용할 수 있는 전체 범위 입니다.
+var TokenScopes = []TokenScope{TokenScopeReadData, TokenScopeWriteData, TokenScopeReadDevices}
+
+// PersonalAccessToken 개인 액세스 토큰 정보 입니다.
+type PersonalAccessToken struct {
+	UserID string `json:"-"` // 토큰을 만든 사용자 ID 입니다.
+
+	ID         string       `json:"id" doc:"토큰 ID 입니다." format:"uuid"`
+	Name       string       `json:"name" doc:"토큰 이름 입니다." example:"nightly-export"`
+	Scopes     []TokenScope `json:"scopes" doc:"토큰으로 접근할 수 있는 범위 입니다." enum:"read:data,write:data,read:devices"`
+	CreatedAt  time.Time    `json:"created_at" doc:"생성 시각 입니다."`
+	ExpiresAt  time.Time    `json:"expires_at" doc:"만료 시각 입니다."`
+	LastUsedAt *time.Time   `json:"last_used_at,omitempty" doc:"마지막 사용 시각 입니다. (1분 단위로 기록)"`
+}
+
+// HasScopes 필요한 범위를 모두 가지고 있는지 확인합니다.
+func (t *PersonalAccessToken) HasScopes(scopes ...TokenScope) bool {
+	for _, required := range scopes {
+		var ok bool
+		for _, s := range t.Scopes {
+			if s == required {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PersonalAccessTokenPolicy 개인 액세스 토큰 정책 입니다.
+type PersonalAccessTokenPolicy struct {
+	MaxTTL     time.Duration // 최대 유효 기간
+	MaxPerUser int           // 사용자별 최대 개수
+}
+
+type PersonalAccessTokenRepository interface {
+	// CreatePersonalAccessToken 토큰 해시 저장, ID 와 생성 시각을 채운다.
+	CreatePersonalAccessToken(ctx context.Context, token *PersonalAccessToken, tokenHash string) error
+	// GetPersonalAccessTokenByHash 토큰 해시로 조회, 없으면 ErrNotFound
+	GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*PersonalAccessToken, error)
+	// GetPersonalAccessTokenList 사용자의 토큰 전체 조회
+	GetPersonalAccessTokenList(ctx context.Context, userID string) ([]*PersonalAccessToken, error)
+	// DeletePersonalAccessToken 사용자의 토큰 삭제, 없으면 ErrNotFound
+	DeletePersonalAccessToken(ctx context.Context, userID string, tokenID string) error
+	// UpdatePersonalAccessTokenLastUsed 마지막 사용 시각 갱신, 1분 이내에 갱신된 경우 무시
+	UpdatePersonalAccessTokenLastUsed(ctx context.Context, tokenID string) error
+}
+
+type PersonalAccessTokenService interface {
+	// CreatePersonalAccessToken 토큰 생성, 원문은 이때만 반환한다.
+	CreatePersonalAccessToken(ctx context.Context, userID string, name string, scopes []TokenScope, expiresAt time.Time) (*PersonalAccessToken, string, error)
+	// ValidatePersonalAccessToken 토큰 확인 후 마지막 사용 시각 갱신, 유효하지 않으면 ErrPersonalAccessTokenInvalid
+	ValidatePersonalAccessToken(ctx context.Context, token string) (*PersonalAccessToken, error)
+	// GetPersonalAccessTokenList 사용자의 토큰 전체 조회
+	GetPersonalAccessTokenList(ctx context.Context, userID string) ([]*PersonalAccessToken, error)
+	// DeletePersonalAccessToken 사용자의 토큰 삭제, 없으면 ErrNotFound
+	DeletePersonalAccessToken(ctx context.Context, userID string, tokenID string) error
+}
+
+type PersonalAccessTokenUseCase interface {
+	// CreatePersonalAccessToken 토큰 생성, 원문은 이때만 반환한다.
+	CreatePersonalAccessToken(ctx context.Context, userID string, name string, scopes []TokenScope, expiresAt time.Time) (*PersonalAccessToken, string, error)
+	// ValidatePersonalAccessToken 토큰과 계정 정지 여부 확인
+	ValidatePersonalAccessToken(ctx context.Context, token string) (*PersonalAccessToken, error)
+	// GetPersonalAccessTokenList 사용자의 토큰 전체 조회
+	GetPersonalAccessTokenList(ctx context.Context, userID string) ([]*PersonalAccessToken, error)
+	// RevokePersonalAccessToken 사용자의 토큰 폐기, 없으면 ErrNotFound
+	RevokePersonalAccessToken(ctx context.Context, userID string, tokenID string) error
+}
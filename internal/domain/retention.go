@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrRetentionPolicyInvalid 보관 일수가 올바르지 않은 경우
+	ErrRetentionPolicyInvalid = errors.New("보관 정책이 올바르지 않습니다")
+	// ErrRetentionPolicyInUse 장치에 적용된 보관 정책을 삭제하는 경우
+	ErrRetentionPolicyInUse = errors.New("장치에 적용된 보관 정책 입니다")
+)
+
+// RetentionTierDefault 보관 정책이 지정되지 않은 장치에 적용하는 전체 정책 입니다.
+const RetentionTierDefault = "default"
+
+// RetentionPolicy
+//
+// 장치 등급별 센서 데이터 보관 정책 입니다. 보관 일수가 없으면 삭제하지 않습니다.
+type RetentionPolicy struct {
+	Tier       string    `json:"tier" doc:"장치 등급 입니다. default 는 등급이 없는 장치에 적용합니다." example:"default"`
+	RawDays    *int      `json:"raw_days,omitempty" doc:"원본 데이터 보관 일수 입니다." example:"90"`
+	HourlyDays *int      `json:"hourly_days,omitempty" doc:"시간 단위 집계 보관 일수 입니다." example:"730"`
+	DailyDays  *int      `json:"daily_days,omitempty" doc:"일 단위 집계 보관 일수 입니다. 없으면 삭제하지 않습니다."`
+	UpdatedAt  time.Time `json:"updated_at" doc:"변경 시각 입니다."`
+}
+
+// Days 집계 단위의 보관 일수
+func (p *RetentionPolicy) Days(tier RollupTier) *int {
+	switch tier {
+	case RollupTierRaw:
+		return p.RawDays
+	case RollupTierHourly:
+		return p.HourlyDays
+	case RollupTierDaily:
+		return p.DailyDays
+	}
+	return nil
+}
+
+// Validate 보관 일수는 1일 이상이며 집계 단위가 클수록 오래 보관해야 합니다.
+func (p *RetentionPolicy) Validate() error {
+	tiers := []RollupTier{RollupTierRaw, RollupTierHourly, RollupTierDaily}
+	for i, t := range tiers {
+		days := p.Days(t)
+		if days != nil && *days < 1 {
+			return fmt.Errorf("%w: %s 보관 일수는 1 이상이어야 합니다", ErrRetentionPolicyInvalid, t)
+		}
+		if i == 0 || days == nil {
+			continue
+		}
+		prev := p.Days(tiers[i-1])
+		if prev == nil || *prev > *days {
+			return fmt.Errorf("%w: %s 는 %s 보다 오래 보관해야 합니다", ErrRetentionPolicyInvalid, t, tiers[i-1])
+		}
+	}
+	return nil
+}
+
+// RetentionItem
+//
+// 보관 정책으로 삭제한(미리보기는 삭제할) 데이터 건수 입니다.
+type RetentionItem struct {
+	Tier   string     `json:"tier" doc:"장치 등급 입니다." example:"default"`
+	Data   RollupTier `json:"data" enum:"raw,hourly,daily" doc:"데이터 종류 입니다."`
+	Cutoff time.Time  `json:"cutoff" doc:"이 시각 이전의 데이터를 삭제합니다."`
+	Rows   int64      `json:"rows" doc:"데이터 건수 입니다." example:"43200"`
+}
+
+// RetentionRun
+//
+// 보관 기간 정리 실행 결과 입니다.
+type RetentionRun struct {
+	ID         int64            `json:"id" doc:"고유 ID 입니다." example:"1"`
+	StartedAt  time.Time        `json:"started_at" doc:"시작 시각 입니다."`
+	FinishedAt time.Time        `json:"finished_at" doc:"종료 시각 입니다."`
+	Items      []*RetentionItem `json:"items" doc:"삭제한 데이터 건수 입니다."`
+	Error      string           `json:"error,omitempty" doc:"중단된 경우 사유 입니다."`
+}
+
+type RetentionRepository interface {
+	// GetRetentionPolicyList 보관 정책 전체 조회
+	GetRetentionPolicyList(ctx context.Context) ([]*RetentionPolicy, error)
+	// UpsertRetentionPolicy 보관 정책 생성 혹은 변경
+	UpsertRetentionPolicy(ctx context.Context, policy *RetentionPolicy) error
+	// DeleteRetentionPolicy 보관 정책 삭제, 없으면 ErrNotFound, 장치에 적용되어 있으면 ErrRetentionPolicyInUse
+	DeleteRetentionPolicy(ctx context.Context, tier string) error
+	// SetDeviceRetentionTier 장치 등급 지정, 등급이 default 이면 지정 해제, 없는 등급이면 ErrNotFound
+	SetDeviceRetentionTier(ctx context.Context, deviceID string, tier string) error
+
+	// CountExpiredReadings 등급의 장치에서 cutoff 이전 데이터 건수
+	CountExpiredReadings(ctx context.Context, tier string, data RollupTier, cutoff time.Time) (int64, error)
+	// DeleteExpiredReadings 등급의 장치에서 cutoff 이전 데이터를 최대 limit 건 삭제, 삭제한 건수 반환 (집계 대기 중인 장치 센서는 제외)
+	DeleteExpiredReadings(ctx context.Context, tier string, data RollupTier, cutoff time.Time, limit int) (int64, error)
+
+	// TryRetentionLock 여러 인스턴스가 동시에 정리하지 않도록 잠금 획득, 다른 인스턴스가 정리 중이면 ok 가 false
+	// 획득하면 정리가 끝난 뒤 unlock 을 호출해야 한다.
+	TryRetentionLock(ctx context.Context) (unlock func(), ok bool, err error)
+
+	// CreateRetentionRun 정리 결과 기록
+	CreateRetentionRun(ctx context.Context, run *RetentionRun) error
+	// GetRetentionRunList 최근 정리 결과 조회 (최신순)
+	GetRetentionRunList(ctx context.Context, limit int) ([]*RetentionRun, error)
+}
+
+type RetentionService interface {
+	// GetRetentionPolicyList 보관 정책 전체 조회
+	GetRetentionPolicyList(ctx context.Context) ([]*RetentionPolicy, error)
+	// PutRetentionPolicy 보관 정책 생성 혹은 변경
+	PutRetentionPolicy(ctx context.Context, policy *RetentionPolicy) error
+	// DeleteRetentionPolicy 보관 정책 삭제, default 는 삭제할 수 없다.
+	DeleteRetentionPolicy(ctx context.Context, tier string) error
+	// SetDeviceRetentionTier 장치 등급 지정
+	SetDeviceRetentionTier(ctx context.Context, deviceID string, tier string) error
+	// PreviewRetentionPolicy 보관 정책을 적용하면 삭제될 데이터 건수
+	PreviewRetentionPolicy(ctx context.Context, policy *RetentionPolicy) ([]*RetentionItem, error)
+	// GetRetentionRunList 최근 정리 결과 조회
+	GetRetentionRunList(ctx context.Context) ([]*RetentionRun, error)
+}
+
+type RetentionUseCase interface {
+	// GetRetentionPolicyList 보관 정책 전체 조회
+	GetRetentionPolicyList(ctx context.Context) ([]*RetentionPolicy, error)
+	// PutRetentionPolicy 보관 정책 생성 혹은 변경
+	PutRetentionPolicy(ctx context.Context, policy *RetentionPolicy) error
+	// DeleteRetentionPolicy 보관 정책 삭제
+	DeleteRetentionPolicy(ctx context.Context, tier string) error
+	// SetDeviceRetentionTier 장치 등급 지정, default 이면 지정 해제
+	SetDeviceRetentionTier(ctx context.Context, deviceID string, tier string) error
+	// PreviewRetentionPolicy 보관 정책을 적용하면 삭제될 데이터 건수
+	PreviewRetentionPolicy(ctx context.Context, policy *RetentionPolicy) ([]*RetentionItem, error)
+	// GetRetentionRunList 최근 정리 결과 조회
+	GetRetentionRunList(ctx context.Context) ([]*RetentionRun, error)
+}
+
+// RetentionSchedule 보관 기간 정리 작업 설정 입니다.
+type RetentionSchedule struct {
+	Interval   time.Duration // 정리 주기, 0 이면 정리 작업을 실행하지 않는다.
+	BatchSize  int           // 한번에 삭제하는 건수
+	BatchPause time.Duration // 삭제 사이 대기 시간, 대기 중 종료되면 바로 중단한다.
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrRollupRange 조회 구간, 해상도가 올바르지 않은 경우
+var ErrRollupRange = errors.New("조회 구간이 올바르지 않습니다")
+
+// RollupTier 센서 데이터 집계 단위 입니다.
+type RollupTier string
+
+const (
+	// RollupTierRaw 원본 데이터
+	RollupTierRaw RollupTier = "raw"
+	// RollupTierHourly 시간 단위 집계
+	RollupTierHourly RollupTier = "hourly"
+	// RollupTierDaily 일 단위 집계, 하루는 한국 표준시 기준
+	RollupTierDaily RollupTier = "daily"
+)
+
+// Duration 집계 단위의 구간 길이, 원본 데이터는 0
+func (t RollupTier) Duration() time.Duration {
+	switch t {
+	case RollupTierHourly:
+		return time.Hour
+	case RollupTierDaily:
+		return 24 * time.Hour
+	}
+	return 0
+}
+
+// SelectRollupTier 해상도를 만족하는 가장 큰 집계 단위를 반환합니다.
+//
+// 해상도가 집계 단위의 배수인 경우에만 사용하며 그 외에는 원본 데이터를 사용합니다.
+func SelectRollupTier(resolution time.Duration) RollupTier {
+	for _, t := range []RollupTier{RollupTierDaily, RollupTierHourly} {
+		if resolution >= t.Duration() && resolution%t.Duration() == 0 {
+			return t
+		}
+	}
+	return RollupTierRaw
+}
+
+// ReadingQuery 장치 센서 데이터 조회 조건 입니다.
+type ReadingQuery struct {
+	DeviceID   string
+	SensorID   int
+	From       time.Time // 포함
+	To         time.Time // 미포함
+	Resolution time.Duration
+}
+
+// ReadingPoint
+//
+// 해상도 구간의 센서 데이터 요약 입니다.
+type ReadingPoint struct {
+	Time  time.Time `json:"time" doc:"구간 시작 시각 입니다." example:"2026-03-01T13:00:00+09:00"`
+	Min   float64   `json:"min" doc:"최솟값 입니다." example:"21.3"`
+	Max   float64   `json:"max" doc:"최댓값 입니다." example:"26.8"`
+	Avg   float64   `json:"avg" doc:"평균 입니다." example:"24.1"`
+	Count int64     `json:"count" doc:"원본 데이터 수 입니다." example:"60"`
+}
+
+// ReadingSeries
+//
+// 장치 센서 데이터 조회 결과 입니다.
+type ReadingSeries struct {
+	DeviceID   string          `json:"device_id" doc:"장치 ID 입니다." format:"uuid"`
+	SensorID   int             `json:"sensor_id" doc:"센서 ID 입니다." example:"1"`
+	Resolution string          `json:"resolution" doc:"구간 길이 입니다." example:"1h0m0s"`
+	Tier       RollupTier      `json:"tier" enum:"raw,hourly,daily" doc:"조회에 사용한 집계 단위 입니다."`
+	Derived    bool            `json:"derived" doc:"파생 센서 여부 입니다. 파생 센서는 구간별 입력 센서 평균으로 계산하며 min, max 는 avg 와 같습니다."`
+	Points     []*ReadingPoint `json:"points" doc:"구간별 요약 입니다. 데이터가 없는 구간은 포함되지 않습니다."`
+}
+
+// RollupStatus
+//
+// 집계 대기 현황 입니다.
+type RollupStatus struct {
+	Pending       int64      `json:"pending" doc:"다시 집계할 시간 단위 구간 수 입니다." example:"12"`
+	OldestPending *time.Time `json:"oldest_pending,omitempty" doc:"가장 오래된 대기 구간 입니다."`
+	OldestQueued  *time.Time `json:"oldest_queued,omitempty" doc:"가장 오래 대기한 구간의 등록 시각 입니다."`
+}
+
+type RollupRepository interface {
+	// RefreshRollup 대기 중인 시간 단위 구간을 최대 limit 개 가져와 시간, 일 단위 집계를 다시 계산, 처리한 구간 수 반환
+	// 다른 인스턴스가 처리 중이면 0 을 반환한다.
+	RefreshRollup(ctx context.Context, limit int) (int64, error)
+	// QueueRollup 구간의 원본 데이터가 있는 시간 단위 구간을 집계 대기에 추가, 추가한 구간 수 반환 (deviceID 가 비어있으면 전체 장치)
+	QueueRollup(ctx context.Context, deviceID string, from, to time.Time) (int64, error)
+	// GetRollupStatus 집계 대기 현황 조회
+	GetRollupStatus(ctx context.Context) (*RollupStatus, error)
+
+	// GetReadingPointList 집계 단위에서 해상도 구간별 요약 조회
+	GetReadingPointList(ctx context.Context, tier RollupTier, q *ReadingQuery) ([]*ReadingPoint, error)
+}
+
+type RollupService interface {
+	// BackfillRollup 구간의 집계를 다시 계산하도록 등록
+	BackfillRollup(ctx context.Context, deviceID string, from, to time.Time) (int64, error)
+	// GetRollupStatus 집계 대기 현황 조회
+	GetRollupStatus(ctx context.Context) (*RollupStatus, error)
+	// GetReadingSeries 해상도를 만족하는 가장 큰 집계 단위로 센서 데이터 조회, 파생 센서는 입력 센서 데이터로 계산
+	GetReadingSeries(ctx context.Context, q *ReadingQuery) (*ReadingSeries, error)
+}
+
+type RollupUseCase interface {
+	// BackfillRollup 구간의 집계를 다시 계산하도록 등록
+	BackfillRollup(ctx context.Context, deviceID string, from, to time.Time) (int64, error)
+	// GetRollupStatus 집계 대기 현황 조회
+	GetRollupStatus(ctx context.Context) (*RollupStatus, error)
+	// GetReadingSeries 해상도를 만족하는 가장 큰 집계 단위로 센서 데이터 조회
+	GetReadingSeries(ctx context.Context, q *ReadingQuery) (*ReadingSeries, error)
+	// GetDeviceReadingSeries 사용자 장치의 센서 데이터 조회, 다른 사용자의 장치는 ErrNotFound
+	GetDeviceReadingSeries(ctx context.Context, userID string, q *ReadingQuery) (*ReadingSeries, error)
+}
+
+// RollupPolicy 집계 작업 정책 입니다.
+type RollupPolicy struct {
+	Interval  time.Duration // 집계 주기, 0 이면 집계 작업을 실행하지 않는다.
+	BatchSize int           // 한번에 처리하는 시간 단위 구간 수
+	MaxPoints int           // 조회 결과의 최대 구간 수
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSelectRollupTier(t *testing.T) {
+	tests := []struct {
+		resolution time.Duration
+		want       RollupTier
+	}{
+		{resolution: time.Minute, want: RollupTierRaw},
+		{resolution: 30 * time.Minute, want: RollupTierRaw},
+		{resolution: 90 * time.Minute, want: RollupTierRaw},
+		{resolution: time.Hour, want: RollupTierHourly},
+		{resolution: 6 * time.Hour, want: RollupTierHourly},
+		{resolution: 36 * time.Hour, want: RollupTierHourly},
+		{resolution: 24 * time.Hour, want: RollupTierDaily},
+		{resolution: 7 * 24 * time.Hour, want: RollupTierDaily},
+		{resolution: 0, want: RollupTierRaw},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resolution.String(), func(t *testing.T) {
+			if got := SelectRollupTier(tt.resolution); got != tt.want {
+				t.Errorf("SelectRollupTier(%s) = %s, want %s", tt.resolution, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Session 로그인 세션 입니다. 토큰을 재발급해도 같은 세션이 유지됩니다.
+type Session struct {
+	UserID    string     `json:"-"` // 세션의 사용자 ID 입니다.
+	RevokedAt *time.Time `json:"-"` // 폐기된 세션인 경우 폐기 시각 입니다.
+
+	ID           string    `json:"id" doc:"세션 ID 입니다." format:"uuid"`
+	Device       string    `json:"device" doc:"User-Agent 로 추정한 브라우저, 기기 입니다." example:"Chrome (Windows)"`
+	UserAgent    string    `json:"user_agent" doc:"마지막 요청의 User-Agent 입니다."`
+	ClientIP     string    `json:"client_ip" doc:"마지막 요청의 IP 입니다." example:"203.0.113.10"`
+	Location     string    `json:"location,omitempty" doc:"IP 대역으로 추정한 위치 입니다." example:"대한민국 서울"`
+	Current      bool      `json:"current" doc:"현재 요청의 세션인지 여부 입니다."`
+	CreatedAt    time.Time `json:"created_at" doc:"로그인 시각 입니다."`
+	LastActiveAt time.Time `json:"last_active_at" doc:"마지막 활동 시각 입니다. (1분 단위로 기록)"`
+	ExpiresAt    time.Time `json:"expires_at" doc:"토큰을 재발급하지 않으면 만료되는 시각 입니다."`
+}
+
+// SessionToken 세션에서 발급된 토큰 입니다.
+type SessionToken struct {
+	TokenHash string
+	ExpiresAt time.Time
+}
+
+type SessionRepository interface {
+	// CreateSession 세션과 발급된 토큰 저장, ID 를 채운다.
+	CreateSession(ctx context.Context, session *Session, tokens []SessionToken) error
+	// AddSessionTokens 재발급된 토큰 추가, 세션의 발급 시각과 만료 시각을 갱신
+	AddSessionTokens(ctx context.Context, sessionID string, tokens []SessionToken, expiresAt time.Time) error
+	// GetSessionByTokenHash 토큰이 발급된 세션 조회, 없으면 ErrNotFound
+	GetSessionByTokenHash(ctx context.Context, tokenHash string) (*Session, error)
+	// GetSessionList 사용자의 폐기, 만료되지 않은 세션 전체 조회
+	GetSessionList(ctx context.Context, userID string) ([]*Session, error)
+	// RevokeSession 세션 폐기, 사용중인 세션이 없으면 ErrNotFound
+	RevokeSession(ctx context.Context, userID string, sessionID string) error
+	// RevokeUserSessions exceptSessionID 를 제외한 사용자의 세션 전체 폐기
+	RevokeUserSessions(ctx context.Context, userID string, exceptSessionID string) (int64, error)
+	// UpdateSessionActivity 마지막 활동 시각, IP, User-Agent 갱신, 1분 이내에 갱신된 경우 무시
+	UpdateSessionActivity(ctx context.Context, sessionID string, clientIP string, userAgent string) error
+	// DeleteExpiredSession 만료된 세션, 토큰 삭제
+	DeleteExpiredSession(ctx context.Context) (int64, error)
+}
+
+type SessionService interface {
+	// CreateSession 로그인으로 발급된 토큰의 세션 생성
+	CreateSession(ctx context.Context, userID string, token *Token) error
+	// RefreshSession refreshToken 의 세션에 재발급된 토큰 추가, 세션이 없는 토큰이면 새로 생성
+	RefreshSession(ctx context.Context, userID string, refreshToken string, token *Token) error
+	// CheckSession 폐기된 세션의 토큰이면 ErrTokenRevoked 반환, 사용중인 세션이면 마지막 활동 시각 갱신
+	CheckSession(ctx context.Context, token string) error
+	// GetSessionList 사용자의 세션 전체 조회, currentToken 의 세션은 Current 로 표시
+	GetSessionList(ctx context.Context, userID string, currentToken string) ([]*Session, error)
+	// RevokeSession 세션 폐기, 없으면 ErrNotFound
+	RevokeSession(ctx context.Context, userID string, sessionID string) error
+	// CheckTokenOwner 다른 사용자의 세션에서 발급된 토큰이면 ErrInvalidParam 반환, 세션이 없는 토큰은 허용
+	CheckTokenOwner(ctx context.Context, userID string, token string) error
+	// RevokeSessionByToken 토큰이 발급된 세션 폐기, 세션이 없는 토큰이면 무시
+	RevokeSessionByToken(ctx context.Context, userID string, token string) error
+	// RevokeOtherSessions currentToken 의 세션을 제외한 사용자의 세션 전체 폐기
+	RevokeOtherSessions(ctx context.Context, userID string, currentToken string) (int64, error)
+}
+
+type SessionUseCase interface {
+	// GetSessionList 사용자의 세션 전체 조회, currentToken 의 세션은 Current 로 표시
+	GetSessionList(ctx context.Context, userID string, currentToken string) ([]*Session, error)
+	// RevokeSession 세션 폐기, 없으면 ErrNotFound
+	RevokeSession(ctx context.Context, userID string, sessionID string) error
+	// RevokeOtherSessions currentToken 의 세션을 제외한 사용자의 세션 전체 폐기
+	RevokeOtherSessions(ctx context.Context, userID string, currentToken string) (int64, error)
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrTokenRevoked 로그아웃 등으로 폐기된 토큰인 경우
+var ErrTokenRevoked = errors.New("폐기된 토큰 입니다")
+
+// TokenKind 토큰 구분 입니다.
+type TokenKind string
+
+const (
+	TokenKindAccess  TokenKind = "access"
+	TokenKindRefresh TokenKind = "refresh"
+)
+
+type TokenRevocationRepository interface {
+	// RevokeToken 토큰 해시를 expiresAt 까지 폐기 목록에 추가
+	RevokeToken(ctx context.Context, tokenHash string, kind TokenKind, userID string, expiresAt time.Time) error
+	// IsTokenRevoked 토큰 해시가 폐기 목록에 있는지 확인
+	IsTokenRevoked(ctx context.Context, tokenHash string) (bool, error)
+	// RevokeUserTokens revokedBefore 이전에 발급된 사용자의 토큰 전체 폐기
+	RevokeUserTokens(ctx context.Context, userID string, revokedBefore time.Time) error
+	// GetUserTokensRevokedBefore 사용자의 전체 세션 폐기 시각 조회, 없으면 ErrNotFound
+	GetUserTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error)
+	// DeleteExpiredRevokedToken 만료된 폐기 토큰 삭제
+	DeleteExpiredRevokedToken(ctx context.Context) (int64, error)
+}
+
+type TokenRevocationService interface {
+	// RevokeToken 토큰 폐기
+	RevokeToken(ctx context.Context, kind TokenKind, token string, userID string) error
+	// RevokeUserTokens 현재 시각 이전에 발급된 사용자의 토큰 전체 폐기
+	RevokeUserTokens(ctx context.Context, userID string) error
+	// CheckToken 폐기된 토큰이면 ErrTokenRevoked 반환, userID 가 있으면 전체 세션 폐기 여부도 확인
+	CheckToken(ctx context.Context, token string, userID string) error
+}
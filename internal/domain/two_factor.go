@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrTwoFactorChallenge 로그인에 2단계 인증이 필요한 경우
+	ErrTwoFactorChallenge = errors.New("2단계 인증이 필요합니다")
+	// ErrTwoFactorChallengeInvalid 존재하지 않거나 만료, 시도 횟수를 초과한 2단계 인증 요청인 경우
+	ErrTwoFactorChallengeInvalid = errors.New("유효하지 않은 2단계 인증 요청 입니다. 다시 로그인 해주세요")
+	// ErrTwoFactorInvalidCode 인증 코드, 복구 코드가 올바르지 않은 경우
+	ErrTwoFactorInvalidCode = errors.New("인증 코드가 올바르지 않습니다")
+	// ErrTwoFactorAlreadyEnabled 이미 2단계 인증을 사용중인 경우
+	ErrTwoFactorAlreadyEnabled = errors.New("이미 2단계 인증을 사용중 입니다")
+	// ErrTwoFactorNotEnabled 2단계 인증을 사용하지 않는 경우
+	ErrTwoFactorNotEnabled = errors.New("2단계 인증을 사용하지 않습니다")
+	// ErrTwoFactorRequired 권한에 2단계 인증이 필요하지만 설정하지 않은 경우
+	ErrTwoFactorRequired = errors.New("2단계 인증을 설정해야 사용할 수 있습니다")
+)
+
+// TwoFactorRequiredError 로그인 2단계 인증에 사용할 challenge 를 담은 에러 입니다.
+type TwoFactorRequiredError struct {
+	Challenge *TwoFactorChallenge
+}
+
+func (e *TwoFactorRequiredError) Error() string {
+	return ErrTwoFactorChallenge.Error()
+}
+
+func (e *TwoFactorRequiredError) Unwrap() error {
+	return ErrTwoFactorChallenge
+}
+
+// TwoFactorChallenge 2단계 인증 대기중인 로그인 입니다.
+type TwoFactorChallenge struct {
+	UserID string `json:"-"` // 로그인한 사용자 ID 입니다.
+	Token  *Token `json:"-"` // 인증 후 전달할 토큰 입니다.
+
+	ChallengeToken string    `json:"challenge_token" doc:"2단계 인증 API 에 인증 코드와 함께 전달하는 토큰 입니다."`
+	ExpiresAt      time.Time `json:"expires_at" doc:"challenge_token 만료 시각 입니다."`
+}
+
+// TwoFactor 사용자의 2단계 인증 정보 입니다.
+type TwoFactor struct {
+	UserID          string
+	EncryptedSecret []byte
+	LastUsedStep    int64
+	CreatedAt       time.Time
+	EnabledAt       *time.Time
+}
+
+// TwoFactorEnrollment 2단계 인증 등록 정보 입니다. 등록 확인 전까지 사용되지 않습니다.
+type TwoFactorEnrollment struct {
+	Secret          string `json:"secret" doc:"OTP 앱에 직접 입력하는 base32 secret 입니다."`
+	ProvisioningURI string `json:"provisioning_uri" doc:"QR 코드로 변환해서 OTP 앱으로 등록하는 otpauth URI 입니다." example:"otpauth://totp/GDH:example@example.com?algorithm=SHA1&digits=6&issuer=GDH&period=30&secret=JBSWY3DPEHPK3PXP"`
+}
+
+// TwoFactorStatus 사용자의 2단계 인증 상태 입니다.
+type TwoFactorStatus struct {
+	Enabled                bool       `json:"enabled" doc:"2단계 인증 사용 여부 입니다."`
+	EnabledAt              *time.Time `json:"enabled_at,omitempty" doc:"2단계 인증을 설정한 시각 입니다."`
+	RecoveryCodesRemaining int        `json:"recovery_codes_remaining" doc:"사용하지 않은 복구 코드 수 입니다."`
+	Required               bool       `json:"required" doc:"사용자의 권한에 2단계 인증이 필요한지 여부 입니다."`
+}
+
+// TwoFactorPolicy 2단계 인증 정책 입니다.
+type TwoFactorPolicy struct {
+	Issuer        string        // OTP 앱에 표시되는 서비스 이름
+	EncryptionKey []byte        // secret, 대기중인 토큰 암호화 키 (AES-256)
+	ChallengeTTL  time.Duration // challenge 유효 기간
+	MaxAttempts   int           // challenge 당 최대 시도 횟수
+}
+
+type TwoFactorRepository interface {
+	// SaveTwoFactorSecret 등록 확인 전 secret 저장, 이미 사용중이면 ErrTwoFactorAlreadyEnabled
+	SaveTwoFactorSecret(ctx context.Context, userID string, encryptedSecret []byte) error
+	// GetTwoFactor 2단계 인증 정보 조회, 없으면 ErrNotFound
+	GetTwoFactor(ctx context.Context, userID string) (*TwoFactor, error)
+	// EnableTwoFactor 2단계 인증 사용, 복구 코드를 교체
+	EnableTwoFactor(ctx context.Context, userID string, step int64, recoveryCodeHashes []string) error
+	// DeleteTwoFactor 2단계 인증, 복구 코드 삭제
+	DeleteTwoFactor(ctx context.Context, userID string) error
+	// UseTwoFactorStep 마지막 사용 구간 이후의 구간이면 저장하고 true 반환
+	UseTwoFactorStep(ctx context.Context, userID string, step int64) (bool, error)
+
+	// ReplaceRecoveryCodes 복구 코드 교체
+	ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error
+	// UseRecoveryCode 사용하지 않은 복구 코드면 사용 처리 후 true 반환
+	UseRecoveryCode(ctx context.Context, userID string, codeHash string) (bool, error)
+	// CountRecoveryCodes 사용하지 않은 복구 코드 수 조회
+	CountRecoveryCodes(ctx context.Context, userID string) (int, error)
+
+	// CreateTwoFactorChallenge challenge 저장
+	CreateTwoFactorChallenge(ctx context.Context, challengeHash string, userID string, encryptedToken []byte, expiresAt time.Time) error
+	// GetTwoFactorChallenge 만료되지 않은 challenge 조회, 없으면 ErrNotFound
+	GetTwoFactorChallenge(ctx context.Context, challengeHash string) (userID string, encryptedToken []byte, err error)
+	// TakeTwoFactorChallenge challenge 삭제, 없으면 ErrNotFound
+	TakeTwoFactorChallenge(ctx context.Context, challengeHash string) error
+	// IncrementTwoFactorChallengeAttempts 시도 횟수 증가 후 반환, 없으면 ErrNotFound
+	IncrementTwoFactorChallengeAttempts(ctx context.Context, challengeHash string) (int, error)
+	// DeleteExpiredTwoFactorChallenge 만료된 challenge 삭제
+	DeleteExpiredTwoFactorChallenge(ctx context.Context) (int64, error)
+
+	// GetTwoFactorRequiredRoleList 2단계 인증이 필요한 권한 조회
+	GetTwoFactorRequiredRoleList(ctx context.Context) ([]UserRole, error)
+	// SetTwoFactorRequiredRoleList 2단계 인증이 필요한 권한 교체
+	SetTwoFactorRequiredRoleList(ctx context.Context, roles []UserRole, updatedBy string) error
+}
+
+type TwoFactorService interface {
+	// BeginEnrollment 새로운 secret 생성, 등록 확인 전 secret 은 교체된다.
+	BeginEnrollment(ctx context.Context, userID string, account string) (*TwoFactorEnrollment, error)
+	// ConfirmEnrollment 인증 코드 확인 후 2단계 인증 사용, 복구 코드 반환
+	ConfirmEnrollment(ctx context.Context, userID string, code string) ([]string, error)
+	// Disable 인증 코드 혹은 복구 코드 확인 후 2단계 인증 해제
+	Disable(ctx context.Context, userID string, code string) error
+	// RegenerateRecoveryCodes 인증 코드 확인 후 복구 코드 재생성
+	RegenerateRecoveryCodes(ctx context.Context, userID string, code string) ([]string, error)
+	// GetStatus 2단계 인증 상태 조회
+	GetStatus(ctx context.Context, userID string, role UserRole) (*TwoFactorStatus, error)
+	// IsEnabled 2단계 인증 사용 여부
+	IsEnabled(ctx context.Context, userID string) (bool, error)
+
+	// IssueChallenge 토큰을 보관하고 challenge 발급
+	IssueChallenge(ctx context.Context, userID string, token *Token) (*TwoFactorChallenge, error)
+	// GetChallengeUserID 만료되지 않은 challenge 의 사용자 ID 조회, 없으면 ErrTwoFactorChallengeInvalid
+	GetChallengeUserID(ctx context.Context, challengeToken string) (string, error)
+	// VerifyChallenge 인증 코드 혹은 복구 코드 확인 후 보관된 토큰 반환
+	// 시도 횟수를 초과하면 ErrTwoFactorChallengeInvalid, ErrTwoFactorInvalidCode 를 감싼 에러와 함께 폐기할 토큰이 담긴 challenge 를 반환한다.
+	VerifyChallenge(ctx context.Context, challengeToken string, code string) (*TwoFactorChallenge, error)
+
+	// GetRequiredRoleList 2단계 인증이 필요한 권한 조회
+	GetRequiredRoleList(ctx context.Context) ([]UserRole, error)
+	// SetRequiredRoleList 2단계 인증이 필요한 권한 교체
+	SetRequiredRoleList(ctx context.Context, roles []UserRole, adminID string) error
+	// CheckRole 권한에 2단계 인증이 필요하지만 사용하지 않으면 ErrTwoFactorRequired 반환
+	CheckRole(ctx context.Context, userID string, role UserRole) error
+}
+
+type TwoFactorUseCase interface {
+	// GetTwoFactorStatus 2단계 인증 상태 조회
+	GetTwoFactorStatus(ctx context.Context, userID string, role UserRole) (*TwoFactorStatus, error)
+	// BeginTwoFactorEnrollment OTP 앱 등록 정보 생성
+	BeginTwoFactorEnrollment(ctx context.Context, userID string) (*TwoFactorEnrollment, error)
+	// ConfirmTwoFactorEnrollment 인증 코드 확인 후 2단계 인증 사용, 복구 코드 반환
+	ConfirmTwoFactorEnrollment(ctx context.Context, userID string, code string) ([]string, error)
+	// DisableTwoFactor 인증 코드 혹은 복구 코드 확인 후 2단계 인증 해제
+	DisableTwoFactor(ctx context.Context, userID string, code string) error
+	// RegenerateRecoveryCodes 인증 코드 확인 후 복구 코드 재생성
+	RegenerateRecoveryCodes(ctx context.Context, userID string, code string) ([]string, error)
+
+	// GetTwoFactorRequiredRoleList 2단계 인증이 필요한 권한 조회
+	GetTwoFactorRequiredRoleList(ctx context.Context) ([]UserRole, error)
+	// SetTwoFactorRequiredRoleList 2단계 인증이 필요한 권한 교체
+	SetTwoFactorRequiredRoleList(ctx context.Context, roles []UserRole, adminID string) error
+	// CheckTwoFactorRequirement 권한에 2단계 인증이 필요하지만 사용하지 않으면 ErrTwoFactorRequired 반환
+	CheckTwoFactorRequirement(ctx context.Context, userID string, role UserRole) error
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/GDH-Project/api/internal/domain"
 	"github.com/GDH-Project/api/internal/grpc/authpb"
+	"github.com/GDH-Project/api/internal/requestid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
@@ -21,7 +22,7 @@ func (ac *authClient) Login(ctx context.Context, email string, password string)
 	})
 	if err != nil {
 		err = errorFromGrpcError(err)
-		ac.log.Debug("ac.Login() 실패", zap.Error(err),
+		requestid.Logger(ctx, ac.log).Debug("ac.Login() 실패", zap.Error(err),
 			zap.String("email", email),
 		)
 		return nil, err
@@ -40,7 +41,7 @@ func (ac *authClient) RefreshToken(ctx context.Context, refreshToken string) (*d
 	})
 	if err != nil {
 		err = errorFromGrpcError(err)
-		ac.log.Debug("ac.RefreshToken() 실패", zap.Error(err),
+		requestid.Logger(ctx, ac.log).Debug("ac.RefreshToken() 실패", zap.Error(err),
 			zap.String("refreshToken", refreshToken),
 		)
 		return nil, err
@@ -58,7 +59,7 @@ func (ac *authClient) Logout(ctx context.Context, accessToken string) error {
 	})
 	if err != nil {
 		err = errorFromGrpcError(err)
-		ac.log.Debug("ac.Logout() 실패", zap.Error(err),
+		requestid.Logger(ctx, ac.log).Debug("ac.Logout() 실패", zap.Error(err),
 			zap.String("accessToken", accessToken),
 		)
 
@@ -74,7 +75,7 @@ func (ac *authClient) Validate(ctx context.Context, accessToken string) (*domain
 	})
 	if err != nil {
 		err = errorFromGrpcError(err)
-		ac.log.Debug("ac.Validate() 실패", zap.Error(err),
+		requestid.Logger(ctx, ac.log).Debug("ac.Validate() 실패", zap.Error(err),
 			zap.String("accessToken", accessToken),
 		)
 		return nil, err
@@ -2,7 +2,10 @@ package grpc
 
 import (
 	"errors"
+	"fmt"
 
+	"github.com/GDH-Project/api/internal/domain"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
@@ -11,5 +14,14 @@ func errorFromGrpcError(err error) error {
 	if !ok {
 		return errors.New("gRPC 에러 메시지 파일 오류")
 	}
+
+	// 사용자 입력과 무관한 연결 오류는 구분할 수 있도록 감싼다.
+	switch s.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return fmt.Errorf("%w: %s", domain.ErrUnavailable, s.Message())
+	case codes.NotFound:
+		return fmt.Errorf("%w: %s", domain.ErrNotFound, s.Message())
+	}
+
 	return errors.New(s.Message())
 }
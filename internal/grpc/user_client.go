@@ -5,6 +5,7 @@ import (
 
 	"github.com/GDH-Project/api/internal/domain"
 	"github.com/GDH-Project/api/internal/grpc/userpb"
+	"github.com/GDH-Project/api/internal/requestid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
@@ -22,7 +23,7 @@ func (c *userClient) CheckCreateUser(ctx context.Context, email string, name str
 
 	if err != nil || !state.Ok {
 		err = errorFromGrpcError(err)
-		c.log.Info("uc.CheckCreateUser() 실패", zap.Error(err))
+		requestid.Logger(ctx, c.log).Info("uc.CheckCreateUser() 실패", zap.Error(err))
 		return err
 	}
 
@@ -36,7 +37,7 @@ func (c *userClient) GetUserInfoByEmail(ctx context.Context, email string) (*dom
 
 	if err != nil {
 		err = errorFromGrpcError(err)
-		c.log.Info("uc.GetUserInfoByEmail() 오류", zap.Error(err),
+		requestid.Logger(ctx, c.log).Info("uc.GetUserInfoByEmail() 오류", zap.Error(err),
 			zap.String("email", email),
 		)
 		return nil, err
@@ -58,7 +59,7 @@ func (c *userClient) GetUserInfoByUserID(ctx context.Context, id string) (*domai
 	})
 	if err != nil {
 		err = errorFromGrpcError(err)
-		c.log.Info("uc.GetUserInfoByUserID() 오류", zap.Error(err),
+		requestid.Logger(ctx, c.log).Info("uc.GetUserInfoByUserID() 오류", zap.Error(err),
 			zap.String("id", id),
 		)
 		return nil, err
@@ -83,7 +84,7 @@ func (c *userClient) CreateUser(ctx context.Context, user *domain.User) error {
 	})
 	if err != nil {
 		err = errorFromGrpcError(err)
-		c.log.Info("uc.CreateUser() 오류", zap.Error(err),
+		requestid.Logger(ctx, c.log).Info("uc.CreateUser() 오류", zap.Error(err),
 			zap.String("email", user.Email),
 			zap.String("name", user.Name),
 			zap.String("role", string(user.Role)),
@@ -102,7 +103,7 @@ func (c *userClient) UpdateUser(ctx context.Context, user *domain.User) (*domain
 	})
 	if err != nil {
 		err = errorFromGrpcError(err)
-		c.log.Info("uc.UpdateUser() 오류", zap.Error(err),
+		requestid.Logger(ctx, c.log).Info("uc.UpdateUser() 오류", zap.Error(err),
 			zap.String("id", user.ID),
 			zap.String("email", user.Email),
 			zap.String("name", user.Name),
@@ -126,7 +127,7 @@ func (c *userClient) DeleteUser(ctx context.Context, id string, password string)
 
 	if err != nil {
 		err = errorFromGrpcError(err)
-		c.log.Warn("uc.DeleteUser() 오류", zap.Error(err),
+		requestid.Logger(ctx, c.log).Warn("uc.DeleteUser() 오류", zap.Error(err),
 			zap.String("id", id))
 		return err
 	}
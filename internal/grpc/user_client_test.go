@@ -0,0 +1,178 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/grpc/userpb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeUserServer 사용자를 메모리에 저장하는 사용자 gRPC 서버 입니다.
+type fakeUserServer struct {
+	userpb.UnimplementedUserServiceServer
+
+	mu    sync.Mutex
+	users map[string]*userpb.GetUserInfoResponse
+	// passwords 사용자 ID 별 비밀번호 입니다.
+	passwords map[string]string
+}
+
+func (s *fakeUserServer) GetUserInfoByEmail(_ context.Context, req *userpb.GetUserInfoByEmailRequest) (*userpb.GetUserInfoResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, u := range s.users {
+		if u.GetEmail() == req.GetEmail() {
+			return u, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "존재하지 않는 사용자 입니다")
+}
+
+func (s *fakeUserServer) GetUserInfoByUserID(_ context.Context, req *userpb.GetUserInfoByUserIDRequest) (*userpb.GetUserInfoResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[req.GetUserId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "존재하지 않는 사용자 입니다")
+	}
+	return u, nil
+}
+
+func (s *fakeUserServer) UpdateUser(_ context.Context, req *userpb.UpdateUserRequest) (*userpb.UpdateUserResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[req.GetUserId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "존재하지 않는 사용자 입니다")
+	}
+	if req.GetName() != "" {
+		u.Name = req.GetName()
+	}
+	if req.GetPassword() != "" {
+		s.passwords[u.GetUserId()] = req.GetPassword()
+	}
+	return &userpb.UpdateUserResponse{UserId: u.GetUserId(), Name: u.GetName(), Email: u.GetEmail(), Role: u.GetRole()}, nil
+}
+
+func (s *fakeUserServer) DeleteUser(_ context.Context, req *userpb.DeleteUserRequest) (*userpb.DeleteUserResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.passwords[req.GetUserId()] != req.GetPassword() {
+		return nil, status.Error(codes.InvalidArgument, "비밀번호가 일치하지 않습니다")
+	}
+	delete(s.users, req.GetUserId())
+	return &userpb.DeleteUserResponse{}, nil
+}
+
+// newFakeUserClient bufconn 으로 fakeUserServer 에 연결한 클라이언트를 만듭니다.
+func newFakeUserClient(t *testing.T, srv *fakeUserServer) domain.UserClient {
+	t.Helper()
+
+	ln := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	userpb.RegisterUserServiceServer(s, srv)
+	go func() { _ = s.Serve(ln) }()
+	t.Cleanup(s.Stop)
+
+	conn := NewBaseClient(zap.NewNop(), "passthrough:///bufnet", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return ln.DialContext(ctx)
+	}))
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return NewUserClient(zap.NewNop(), conn)
+}
+
+func TestUserClient(t *testing.T) {
+	ctx := context.Background()
+	srv := &fakeUserServer{
+		users: map[string]*userpb.GetUserInfoResponse{
+			"user":   {UserId: "user", Name: "사용자", Email: "a@example.com", Role: userpb.UserRole_BASIC_USER},
+			"device": {UserId: "device", Name: "장치", Email: "b@example.com", Role: userpb.UserRole_DATA_USER},
+			"admin":  {UserId: "admin", Name: "관리자", Email: "c@example.com", Role: userpb.UserRole_ADMIN},
+		},
+		passwords: map[string]string{"user": "password"},
+	}
+	c := newFakeUserClient(t, srv)
+
+	t.Run("권한 변환", func(t *testing.T) {
+		for id, want := range map[string]domain.UserRole{
+			"user":   domain.UserRoleUser,
+			"device": domain.UserRoleDevice,
+			"admin":  domain.UserRoleAdmin,
+		} {
+			u, err := c.GetUserInfoByUserID(ctx, id)
+			if err != nil {
+				t.Fatalf("GetUserInfoByUserID(%q) error = %v", id, err)
+			}
+			if u.Role != want {
+				t.Errorf("GetUserInfoByUserID(%q).Role = %q, want %q", id, u.Role, want)
+			}
+		}
+	})
+
+	t.Run("이메일 조회", func(t *testing.T) {
+		u, err := c.GetUserInfoByEmail(ctx, "a@example.com")
+		if err != nil || u.ID != "user" || u.Name != "사용자" {
+			t.Errorf("GetUserInfoByEmail() = %+v, %v", u, err)
+		}
+	})
+
+	t.Run("존재하지 않는 사용자", func(t *testing.T) {
+		if _, err := c.GetUserInfoByUserID(ctx, "none"); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("GetUserInfoByUserID() error = %v, want %v", err, domain.ErrNotFound)
+		}
+		if _, err := c.GetUserInfoByEmail(ctx, "none@example.com"); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("GetUserInfoByEmail() error = %v, want %v", err, domain.ErrNotFound)
+		}
+	})
+
+	t.Run("정보 수정", func(t *testing.T) {
+		u, err := c.UpdateUser(ctx, &domain.User{ID: "user", Name: "새이름", Password: "new-password"})
+		if err != nil || u.Name != "새이름" || u.Role != domain.UserRoleUser {
+			t.Fatalf("UpdateUser() = %+v, %v", u, err)
+		}
+		if srv.passwords["user"] != "new-password" {
+			t.Errorf("비밀번호 = %q, want new-password", srv.passwords["user"])
+		}
+	})
+
+	t.Run("탈퇴", func(t *testing.T) {
+		if err := c.DeleteUser(ctx, "user", "password"); err == nil || errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrUnavailable) {
+			t.Errorf("틀린 비밀번호 DeleteUser() error = %v", err)
+		}
+		if err := c.DeleteUser(ctx, "user", "new-password"); err != nil {
+			t.Fatalf("DeleteUser() error = %v", err)
+		}
+		if _, err := c.GetUserInfoByUserID(ctx, "user"); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("탈퇴 후 GetUserInfoByUserID() error = %v, want %v", err, domain.ErrNotFound)
+		}
+	})
+}
+
+func TestUserClientUnavailable(t *testing.T) {
+	ln := bufconn.Listen(1 << 20)
+	_ = ln.Close()
+
+	conn := NewBaseClient(zap.NewNop(), "passthrough:///bufnet", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return ln.DialContext(ctx)
+	}))
+	t.Cleanup(func() { _ = conn.Close() })
+
+	c := NewUserClient(zap.NewNop(), conn)
+	if _, err := c.GetUserInfoByUserID(context.Background(), "user"); !errors.Is(err, domain.ErrUnavailable) {
+		t.Errorf("GetUserInfoByUserID() error = %v, want %v", err, domain.ErrUnavailable)
+	}
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/middleware"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/danielgtaylor/huma/v2"
+	"go.uber.org/zap"
+)
+
+type accountDeletionListResponse struct {
+	Status int
+	Body   []*domain.AccountDeletion
+}
+
+type adminAccountDeletionResponse struct {
+	Status int
+	Body   *domain.AccountDeletion
+}
+
+// RegisterAccountDeletionHandler 회원 탈퇴 데이터 정리 관리자 Handler
+//
+// accountDeletionUseCase 가 nil 이면 모든 API 가 404 를 응답합니다.
+func RegisterAccountDeletionHandler(api huma.API, log *zap.Logger, accountDeletionUseCase domain.AccountDeletionUseCase, m middleware.Middleware) {
+	v1 := huma.NewGroup(api, "/api/v1/admin")
+	disabled := func() error {
+		return huma.Error404NotFound("회원 탈퇴 데이터 정리를 사용할 수 없습니다.")
+	}
+
+	// 데이터 정리 작업 목록
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminGetAccountDeletionList",
+		Method:        http.MethodGet,
+		Path:          "/account-deletions",
+		Summary:       "회원 탈퇴 데이터 정리 목록 조회",
+		Description:   "회원 탈퇴 후 데이터 정리 작업을 상태별로 최근 100건 조회하는 API 입니다. confirmed 는 정리에 실패하여 재시도를 기다리는 작업 입니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct {
+		Status string `query:"status" enum:"pending,confirmed,completed" default:"confirmed" doc:"작업 상태 입니다."`
+	}) (*accountDeletionListResponse, error) {
+		if accountDeletionUseCase == nil {
+			return nil, disabled()
+		}
+		var resp accountDeletionListResponse
+
+		list, err := accountDeletionUseCase.GetAccountDeletionList(ctx, domain.AccountDeletionStatus(i.Status))
+		if err != nil {
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminGetAccountDeletionList 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("회원 탈퇴 데이터 정리 목록 조회에 실패했습니다.")
+		}
+
+		resp.Body = list
+		return &resp, nil
+	})
+
+	// 데이터 정리 작업 조회
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminGetAccountDeletion",
+		Method:        http.MethodGet,
+		Path:          "/account-deletions/{user_id}",
+		Summary:       "회원 탈퇴 데이터 정리 조회",
+		Description:   "탈퇴한 사용자의 데이터 정리 상태와 종류별 정리 건수를 조회하는 API 입니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct {
+		UserID string `path:"user_id" doc:"탈퇴한 사용자 ID 입니다."`
+	}) (*adminAccountDeletionResponse, error) {
+		if accountDeletionUseCase == nil {
+			return nil, disabled()
+		}
+		var resp adminAccountDeletionResponse
+
+		deletion, err := accountDeletionUseCase.GetAccountDeletion(ctx, i.UserID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, huma.Error404NotFound("존재하지 않는 작업 입니다.")
+			}
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminGetAccountDeletion 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("회원 탈퇴 데이터 정리 조회에 실패했습니다.")
+		}
+
+		resp.Body = deletion
+		return &resp, nil
+	})
+
+	// 데이터 정리 재시도
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminRetryAccountDeletion",
+		Method:        http.MethodPost,
+		Path:          "/account-deletions/{user_id}/retry",
+		Summary:       "회원 탈퇴 데이터 정리 재시도",
+		Description:   "재시도를 기다리는 데이터 정리 작업(confirmed)을 즉시 실행하는 API 입니다. 결과는 감사 로그(user.data_cleanup)로 기록됩니다. 다른 상태의 작업은 실행하지 않고 그대로 응답합니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct {
+		UserID string `path:"user_id" doc:"탈퇴한 사용자 ID 입니다."`
+	}) (*adminAccountDeletionResponse, error) {
+		if accountDeletionUseCase == nil {
+			return nil, disabled()
+		}
+		var resp adminAccountDeletionResponse
+
+		deletion, err := accountDeletionUseCase.RetryAccountDeletion(ctx, i.UserID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, huma.Error404NotFound("존재하지 않는 작업 입니다.")
+			}
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminRetryAccountDeletion 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("회원 탈퇴 데이터 정리에 실패했습니다.")
+		}
+
+		resp.Body = deletion
+		return &resp, nil
+	})
+
+	log.Info("account deletion Handler 등록")
+}
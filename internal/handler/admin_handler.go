@@ -0,0 +1,335 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/middleware"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/danielgtaylor/huma/v2"
+	"go.uber.org/zap"
+)
+
+type signInLockoutListResponse struct {
+	Status int
+	Body   []*domain.SignInFailure
+}
+
+type auditEventListResponse struct {
+	Status int
+	Body   struct {
+		Items []*domain.AuditEvent `json:"items" doc:"감사 로그 목록 입니다."`
+		Page  int                  `json:"page" doc:"페이지 번호 입니다." example:"1"`
+		Size  int                  `json:"size" doc:"페이지 크기 입니다." example:"50"`
+		Total int                  `json:"total" doc:"조건에 맞는 전체 건수 입니다." example:"120"`
+	}
+}
+
+type adminUserResponse struct {
+	Status int
+	Body   *domain.AdminUser
+}
+
+type userSuspensionListResponse struct {
+	Status int
+	Body   []*domain.UserSuspension
+}
+
+// RegisterAdminHandler 관리자 전용 Handler
+func RegisterAdminHandler(api huma.API, log *zap.Logger, authUseCase domain.AuthUseCase, adminUseCase domain.AdminUseCase, auditUseCase domain.AuditUseCase, m middleware.Middleware) {
+	v1 := huma.NewGroup(api, "/api/v1/admin")
+
+	// 로그인 잠금 목록 조회
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminGetSignInLockoutList",
+		Method:        http.MethodGet,
+		Path:          "/sign-in/lockouts",
+		Summary:       "로그인 잠금 목록 조회",
+		Description:   "로그인 실패로 현재 잠겨있는 이메일, IP 와 2단계 인증 실패로 잠긴 사용자 ID 목록 조회 API 입니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct{}) (*signInLockoutListResponse, error) {
+		var resp signInLockoutListResponse
+
+		lockoutList, err := authUseCase.GetSignInLockoutList(ctx)
+		if err != nil {
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminGetSignInLockoutList 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("로그인 잠금 목록 조회에 실패했습니다.")
+		}
+
+		resp.Body = lockoutList
+		return &resp, nil
+	})
+
+	// 로그인 잠금 해제
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminClearSignInLockout",
+		Method:        http.MethodDelete,
+		Path:          "/sign-in/lockouts/{kind}/{subject}",
+		Summary:       "로그인 잠금 해제",
+		Description:   "이메일, IP 의 로그인 실패 기록 혹은 사용자 ID 의 2단계 인증 실패 기록을 삭제하여 잠금을 해제하는 API 입니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusNoContent,
+	}), func(ctx context.Context, i *struct {
+		Kind    string `path:"kind" enum:"email,ip,two_factor" doc:"잠금 기준 입니다."`
+		Subject string `path:"subject" doc:"이메일, IP 혹은 사용자 ID 입니다." example:"example@example.com"`
+	}) (*struct{}, error) {
+		adminID, _ := ctx.Value("user_id").(string)
+
+		err := authUseCase.ClearSignInLockout(ctx, domain.SignInFailureKind(i.Kind), i.Subject)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionSignInLockoutClear,
+			TargetType: i.Kind,
+			TargetID:   i.Subject,
+		}, err)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, huma.Error404NotFound("잠금 기록이 존재하지 않습니다.")
+			}
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminClearSignInLockout 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("로그인 잠금 해제에 실패했습니다.")
+		}
+
+		requestid.Logger(ctx, log).Info("관리자 로그인 잠금 해제",
+			zap.String("admin_id", adminID),
+			zap.String("kind", i.Kind),
+			zap.String("subject", i.Subject),
+		)
+
+		return nil, nil
+	})
+
+	// 감사 로그 조회
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminGetAuditEventList",
+		Method:        http.MethodGet,
+		Path:          "/audit-events",
+		Summary:       "감사 로그 조회",
+		Description:   "보안 감사 로그를 최신순으로 조회하는 API 입니다. 값이 없는 조건은 무시합니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct {
+		ActorID  string    `query:"actor_id" doc:"행위자 사용자 ID 입니다."`
+		Action   string    `query:"action" doc:"행위 입니다." example:"auth.sign_in"`
+		TargetID string    `query:"target_id" doc:"대상 ID 혹은 이메일 입니다."`
+		Result   string    `query:"result" enum:"success,failure" doc:"결과 입니다."`
+		From     time.Time `query:"from" doc:"조회 시작 시각 (포함) 입니다." example:"2025-10-24T00:00:00+09:00"`
+		To       time.Time `query:"to" doc:"조회 종료 시각 (미포함) 입니다." example:"2025-10-25T00:00:00+09:00"`
+		Page     int       `query:"page" minimum:"1" default:"1" doc:"페이지 번호 입니다."`
+		Size     int       `query:"size" minimum:"1" maximum:"200" default:"50" doc:"페이지 크기 입니다."`
+	}) (*auditEventListResponse, error) {
+		var resp auditEventListResponse
+
+		filter := &domain.AuditFilter{
+			ActorID:  i.ActorID,
+			Action:   domain.AuditAction(i.Action),
+			TargetID: i.TargetID,
+			Result:   domain.AuditResult(i.Result),
+		}
+		if !i.From.IsZero() {
+			filter.From = &i.From
+		}
+		if !i.To.IsZero() {
+			filter.To = &i.To
+		}
+
+		eventList, total, err := auditUseCase.GetAuditEventListByFilterWithPage(ctx, filter, domain.Page{
+			Page: i.Page,
+			Size: i.Size,
+		})
+		if err != nil {
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminGetAuditEventList 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("감사 로그 조회에 실패했습니다.")
+		}
+
+		resp.Body.Items = eventList
+		resp.Body.Page = i.Page
+		resp.Body.Size = i.Size
+		resp.Body.Total = total
+		return &resp, nil
+	})
+
+	// 사용자 조회 (이메일)
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminGetUserByEmail",
+		Method:        http.MethodGet,
+		Path:          "/users",
+		Summary:       "사용자 조회 (이메일)",
+		Description:   "이메일로 사용자를 조회하는 API 입니다. 사용자 서버가 목록 조회를 지원하지 않아 정확히 일치하는 이메일만 조회할 수 있습니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct {
+		Email string `query:"email,required" format:"email" doc:"사용자 이메일 입니다." example:"example@example.com"`
+	}) (*adminUserResponse, error) {
+		var resp adminUserResponse
+
+		user, err := adminUseCase.GetUserByEmail(ctx, i.Email)
+		if err != nil {
+			return nil, adminUserError(ctx, log, "v1AdminGetUserByEmail", err)
+		}
+
+		resp.Body = user
+		return &resp, nil
+	})
+
+	// 사용자 조회
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminGetUser",
+		Method:        http.MethodGet,
+		Path:          "/users/{user_id}",
+		Summary:       "사용자 조회",
+		Description:   "사용자 정보와 이메일 인증, 계정 정지 여부를 조회하는 API 입니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct {
+		UserID string `path:"user_id" doc:"사용자 ID 입니다."`
+	}) (*adminUserResponse, error) {
+		var resp adminUserResponse
+
+		user, err := adminUseCase.GetUser(ctx, i.UserID)
+		if err != nil {
+			return nil, adminUserError(ctx, log, "v1AdminGetUser", err)
+		}
+
+		resp.Body = user
+		return &resp, nil
+	})
+
+	// 사용자 장치 목록
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminGetUserDeviceList",
+		Method:        http.MethodGet,
+		Path:          "/users/{user_id}/devices",
+		Summary:       "사용자 장치 목록 조회",
+		Description:   "사용자가 등록한 장치를 최신순으로 조회하는 API 입니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct {
+		UserID string `path:"user_id" doc:"사용자 ID 입니다."`
+		Page   int    `query:"page" minimum:"1" default:"1" doc:"페이지 번호 입니다."`
+		Size   int    `query:"size" minimum:"1" maximum:"100" default:"20" doc:"페이지 크기 입니다."`
+	}) (*deviceListResponse, error) {
+		var resp deviceListResponse
+
+		deviceList, total, err := adminUseCase.GetUserDeviceListWithPage(ctx, i.UserID, domain.Page{
+			Page: i.Page,
+			Size: i.Size,
+		})
+		if err != nil {
+			return nil, adminUserError(ctx, log, "v1AdminGetUserDeviceList", err)
+		}
+
+		resp.Body.Items = deviceList
+		resp.Body.Page = i.Page
+		resp.Body.Size = i.Size
+		resp.Body.Total = total
+		return &resp, nil
+	})
+
+	// 계정 정지
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminSuspendUser",
+		Method:        http.MethodPut,
+		Path:          "/users/{user_id}/suspension",
+		Summary:       "계정 정지",
+		Description:   "계정을 정지하는 API 입니다. 정지되면 모든 세션이 즉시 로그아웃 되고 해제 전까지 로그인 할 수 없습니다. 이미 정지된 경우 사유를 변경합니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusNoContent,
+	}), func(ctx context.Context, i *struct {
+		UserID string `path:"user_id" doc:"사용자 ID 입니다."`
+		Body   *struct {
+			Reason string `json:"reason,omitempty" maxLength:"500" doc:"정지 사유 입니다."`
+		}
+	}) (*struct{}, error) {
+		adminID, _ := ctx.Value("user_id").(string)
+		if i.UserID == adminID {
+			return nil, huma.Error400BadRequest("자신의 계정은 정지할 수 없습니다.")
+		}
+
+		var reason string
+		if i.Body != nil {
+			reason = i.Body.Reason
+		}
+
+		err := adminUseCase.SuspendUser(ctx, i.UserID, reason, adminID)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionUserSuspend,
+			TargetType: "user",
+			TargetID:   i.UserID,
+		}, err)
+		if err != nil {
+			return nil, adminUserError(ctx, log, "v1AdminSuspendUser", err)
+		}
+
+		return nil, nil
+	})
+
+	// 계정 정지 해제
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminReactivateUser",
+		Method:        http.MethodDelete,
+		Path:          "/users/{user_id}/suspension",
+		Summary:       "계정 정지 해제",
+		Description:   "계정 정지를 해제하는 API 입니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusNoContent,
+	}), func(ctx context.Context, i *struct {
+		UserID string `path:"user_id" doc:"사용자 ID 입니다."`
+	}) (*struct{}, error) {
+		err := adminUseCase.ReactivateUser(ctx, i.UserID)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionUserReactivate,
+			TargetType: "user",
+			TargetID:   i.UserID,
+		}, err)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, huma.Error404NotFound("정지된 계정이 아닙니다.")
+			}
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminReactivateUser 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("계정 정지 해제에 실패했습니다.")
+		}
+
+		return nil, nil
+	})
+
+	// 정지된 계정 목록
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminGetUserSuspensionList",
+		Method:        http.MethodGet,
+		Path:          "/user-suspensions",
+		Summary:       "정지된 계정 목록 조회",
+		Description:   "정지된 계정 목록을 최신순으로 조회하는 API 입니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct{}) (*userSuspensionListResponse, error) {
+		var resp userSuspensionListResponse
+
+		suspensionList, err := adminUseCase.GetSuspendedUserList(ctx)
+		if err != nil {
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminGetUserSuspensionList 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("정지된 계정 목록 조회에 실패했습니다.")
+		}
+
+		resp.Body = suspensionList
+		return &resp, nil
+	})
+
+	log.Info("admin Handler 등록")
+}
+
+// adminUserError 사용자 조회, 정지 오류를 응답으로 변환합니다.
+func adminUserError(ctx context.Context, log *zap.Logger, operationID string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return huma.Error404NotFound("존재하지 않는 사용자 입니다.")
+	case errors.Is(err, domain.ErrUnavailable):
+		return huma.Error503ServiceUnavailable("잠시 후 다시 시도해주세요.")
+	}
+
+	requestid.Logger(ctx, log).Error("admin.h."+operationID+" 오류", zap.Error(err))
+	return huma.Error500InternalServerError("사용자 처리에 실패했습니다.")
+}
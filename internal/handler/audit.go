@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+// recordAudit
+//
+// 요청 context 의 사용자 ID, IP, User-Agent 를 채워서 감사 로그를 기록합니다.
+// err 가 nil 이 아니면 실패로 기록하며, 감사 로그 기록 실패는 응답에 영향을 주지 않습니다.
+func recordAudit(ctx context.Context, log *zap.Logger, auditUseCase domain.AuditUseCase, event *domain.AuditEvent, err error) {
+	if event.ActorID == "" {
+		event.ActorID, _ = ctx.Value("user_id").(string)
+	}
+	event.ClientIP, _ = ctx.Value("client_ip").(string)
+	event.UserAgent, _ = ctx.Value("user_agent").(string)
+
+	event.Result = domain.AuditResultSuccess
+	if err != nil {
+		event.Result = domain.AuditResultFailure
+		event.Reason = err.Error()
+	}
+
+	// 요청이 취소되어도 기록은 남긴다.
+	if recordErr := auditUseCase.CreateAuditEvent(context.WithoutCancel(ctx), event); recordErr != nil {
+		requestid.Logger(ctx, log).Error("감사 로그 기록 실패", zap.Error(recordErr),
+			zap.String("action", string(event.Action)),
+			zap.String("actor_id", event.ActorID),
+			zap.String("target_id", event.TargetID),
+		)
+	}
+}
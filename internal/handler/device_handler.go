@@ -0,0 +1,307 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/middleware"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/danielgtaylor/huma/v2"
+	"go.uber.org/zap"
+)
+
+// deviceBody 장치 등록, 수정 요청 입니다. 작물, 통신 주기, 주소는 참조 데이터 API 의 값을 사용합니다.
+type deviceBody struct {
+	Title       string  `json:"title" minLength:"1" maxLength:"200" doc:"검색에 노출되는 명칭입니다." example:"경기도 안양시 자동 재배 시설 토마토 데이터"`
+	Name        *string `json:"name,omitempty" maxLength:"200" doc:"장치관리자에게 보이는 고유 명칭 입니다." example:"안양시 스마트 펙토리 토마토 A-B1 섹터"`
+	Crop        string  `json:"crop" doc:"작물 정보 입니다." example:"토마토"`
+	UpdateCycle int     `json:"update_cycle" doc:"데이터 업데이트 주기(분) 입니다." example:"60"`
+	Address     struct {
+		State string `json:"state" doc:"도/특별시 명칭 입니다." example:"경기도"`
+		City  string `json:"city" doc:"시/군/구 명칭 입니다." example:"안양시"`
+	} `json:"address" doc:"주소지"`
+}
+
+func (b *deviceBody) deviceInfo(userID string, deviceID string) *domain.DeviceInfo {
+	d := &domain.DeviceInfo{
+		UserID:      userID,
+		ID:          deviceID,
+		Title:       b.Title,
+		Name:        b.Name,
+		Crop:        b.Crop,
+		UpdateCycle: b.UpdateCycle,
+	}
+	d.Address.State = b.Address.State
+	d.Address.City = b.Address.City
+	return d
+}
+
+type deviceResponse struct {
+	Status int
+	Body   *domain.DeviceInfo
+}
+
+type deviceListResponse struct {
+	Status int
+	Body   struct {
+		Items []*domain.DeviceInfo `json:"items" doc:"장치 목록 입니다."`
+		Page  int                  `json:"page" doc:"페이지 번호 입니다." example:"1"`
+		Size  int                  `json:"size" doc:"페이지 크기 입니다." example:"20"`
+		Total int                  `json:"total" doc:"전체 장치 수 입니다." example:"3"`
+	}
+}
+
+type deviceRequestSchemaListResponse struct {
+	Status int
+	Body   []*domain.DeviceRequestSchema
+}
+
+// RegisterDeviceHandler 사용자 장치 Handler
+//
+// 다른 사용자의 장치는 존재하지 않는 장치와 같이 404 를 반환합니다.
+func RegisterDeviceHandler(api huma.API, log *zap.Logger, deviceUseCase domain.DeviceUseCase, auditUseCase domain.AuditUseCase, m middleware.Middleware) {
+	v1 := huma.NewGroup(api, "/api/v1/devices")
+
+	// 장치 등록
+	huma.Register(v1, m.WithVerifiedEmail(huma.Operation{
+		OperationID:   "v1DeviceCreate",
+		Method:        http.MethodPost,
+		Path:          "",
+		Summary:       "장치 등록",
+		Description:   "장치를 등록하는 API 입니다. 작물, 통신 주기, 주소는 참조 데이터 API 에서 조회한 값을 사용해야 합니다. 이메일 인증이 활성화 된 경우 이메일을 인증한 사용자만 등록할 수 있습니다.",
+		Tags:          []string{"Device"},
+		DefaultStatus: http.StatusCreated,
+	}), func(ctx context.Context, i *struct {
+		Body deviceBody
+	}) (*deviceResponse, error) {
+		var resp deviceResponse
+		userID, _ := ctx.Value("user_id").(string)
+
+		d, err := deviceUseCase.CreateDevice(ctx, i.Body.deviceInfo(userID, ""))
+		event := &domain.AuditEvent{
+			Action:     domain.AuditActionDeviceCreate,
+			TargetType: "device",
+		}
+		if d != nil {
+			event.TargetID = d.ID
+		}
+		recordAudit(ctx, log, auditUseCase, event, err)
+		if err != nil {
+			if errors.Is(err, domain.ErrDeviceInvalid) {
+				return nil, huma.Error400BadRequest("존재하지 않는 작물, 통신 주기 혹은 주소 입니다.")
+			}
+			requestid.Logger(ctx, log).Error("device.h.v1DeviceCreate 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("장치 등록에 실패했습니다.")
+		}
+
+		resp.Status = http.StatusCreated
+		resp.Body = d
+		return &resp, nil
+	})
+
+	// 장치 목록
+	huma.Register(v1, m.WithScopes(huma.Operation{
+		OperationID:   "v1DeviceGetList",
+		Method:        http.MethodGet,
+		Path:          "",
+		Summary:       "장치 목록 조회",
+		Description:   "로그인한 사용자의 장치를 최신순으로 조회하는 API 입니다.",
+		Tags:          []string{"Device"},
+		DefaultStatus: http.StatusOK,
+	}, domain.TokenScopeReadDevices), func(ctx context.Context, i *struct {
+		Page int `query:"page" minimum:"1" default:"1" doc:"페이지 번호 입니다."`
+		Size int `query:"size" minimum:"1" maximum:"100" default:"20" doc:"페이지 크기 입니다."`
+	}) (*deviceListResponse, error) {
+		var resp deviceListResponse
+		userID, _ := ctx.Value("user_id").(string)
+
+		deviceList, total, err := deviceUseCase.GetDeviceListByUserIDWithPage(ctx, userID, domain.Page{
+			Page: i.Page,
+			Size: i.Size,
+		})
+		if err != nil {
+			requestid.Logger(ctx, log).Error("device.h.v1DeviceGetList 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("장치 목록 조회에 실패했습니다.")
+		}
+
+		resp.Body.Items = deviceList
+		resp.Body.Page = i.Page
+		resp.Body.Size = i.Size
+		resp.Body.Total = total
+		return &resp, nil
+	})
+
+	// 장치 조회
+	huma.Register(v1, m.WithScopes(huma.Operation{
+		OperationID:   "v1DeviceGet",
+		Method:        http.MethodGet,
+		Path:          "/{device_id}",
+		Summary:       "장치 조회",
+		Description:   "로그인한 사용자의 장치를 조회하는 API 입니다.",
+		Tags:          []string{"Device"},
+		DefaultStatus: http.StatusOK,
+	}, domain.TokenScopeReadDevices), func(ctx context.Context, i *struct {
+		DeviceID string `path:"device_id" doc:"장치 ID 입니다."`
+	}) (*deviceResponse, error) {
+		var resp deviceResponse
+		userID, _ := ctx.Value("user_id").(string)
+
+		d, err := deviceUseCase.GetDevice(ctx, userID, i.DeviceID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, huma.Error404NotFound("존재하지 않는 장치 입니다.")
+			}
+			requestid.Logger(ctx, log).Error("device.h.v1DeviceGet 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("장치 조회에 실패했습니다.")
+		}
+
+		resp.Body = d
+		return &resp, nil
+	})
+
+	// 장치 수정
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1DeviceUpdate",
+		Method:        http.MethodPut,
+		Path:          "/{device_id}",
+		Summary:       "장치 수정",
+		Description:   "로그인한 사용자의 장치 정보를 수정하는 API 입니다.",
+		Tags:          []string{"Device"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct {
+		DeviceID string `path:"device_id" doc:"장치 ID 입니다."`
+		Body     deviceBody
+	}) (*deviceResponse, error) {
+		var resp deviceResponse
+		userID, _ := ctx.Value("user_id").(string)
+
+		d, err := deviceUseCase.UpdateDevice(ctx, i.Body.deviceInfo(userID, i.DeviceID))
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionDeviceUpdate,
+			TargetType: "device",
+			TargetID:   i.DeviceID,
+		}, err)
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrNotFound):
+				return nil, huma.Error404NotFound("존재하지 않는 장치 입니다.")
+			case errors.Is(err, domain.ErrDeviceInvalid):
+				return nil, huma.Error400BadRequest("존재하지 않는 작물, 통신 주기 혹은 주소 입니다.")
+			}
+			requestid.Logger(ctx, log).Error("device.h.v1DeviceUpdate 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("장치 수정에 실패했습니다.")
+		}
+
+		resp.Body = d
+		return &resp, nil
+	})
+
+	// 장치 삭제
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1DeviceDelete",
+		Method:        http.MethodDelete,
+		Path:          "/{device_id}",
+		Summary:       "장치 삭제",
+		Description:   "로그인한 사용자의 장치를 삭제하는 API 입니다. 장치의 센서 데이터와 집계도 함께 삭제되며 되돌릴 수 없습니다.",
+		Tags:          []string{"Device"},
+		DefaultStatus: http.StatusNoContent,
+	}), func(ctx context.Context, i *struct {
+		DeviceID string `path:"device_id" doc:"장치 ID 입니다."`
+	}) (*struct{}, error) {
+		userID, _ := ctx.Value("user_id").(string)
+
+		err := deviceUseCase.DeleteDevice(ctx, userID, i.DeviceID)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionDeviceDelete,
+			TargetType: "device",
+			TargetID:   i.DeviceID,
+		}, err)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, huma.Error404NotFound("존재하지 않는 장치 입니다.")
+			}
+			requestid.Logger(ctx, log).Error("device.h.v1DeviceDelete 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("장치 삭제에 실패했습니다.")
+		}
+
+		return nil, nil
+	})
+
+	// 장치 요청 스키마 조회
+	huma.Register(v1, m.WithScopes(huma.Operation{
+		OperationID:   "v1DeviceGetRequestSchemaList",
+		Method:        http.MethodGet,
+		Path:          "/{device_id}/schema",
+		Summary:       "장치 요청 스키마 조회",
+		Description:   "장치가 보내는 데이터의 json key 와 센서 연결을 조회하는 API 입니다.",
+		Tags:          []string{"Device"},
+		DefaultStatus: http.StatusOK,
+	}, domain.TokenScopeReadDevices), func(ctx context.Context, i *struct {
+		DeviceID string `path:"device_id" doc:"장치 ID 입니다."`
+	}) (*deviceRequestSchemaListResponse, error) {
+		var resp deviceRequestSchemaListResponse
+		userID, _ := ctx.Value("user_id").(string)
+
+		schemaList, err := deviceUseCase.GetDeviceRequestSchemaList(ctx, userID, i.DeviceID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, huma.Error404NotFound("존재하지 않는 장치 입니다.")
+			}
+			requestid.Logger(ctx, log).Error("device.h.v1DeviceGetRequestSchemaList 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("장치 요청 스키마 조회에 실패했습니다.")
+		}
+
+		resp.Body = schemaList
+		return &resp, nil
+	})
+
+	// 장치 요청 스키마 교체
+	// 장치 설정 변경이므로 장치 수정, 삭제와 같이 개인 액세스 토큰으로는 사용할 수 없다.
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1DevicePutRequestSchemaList",
+		Method:        http.MethodPut,
+		Path:          "/{device_id}/schema",
+		Summary:       "장치 요청 스키마 교체",
+		Description:   "장치가 보내는 데이터의 json key 와 센서 연결을 교체하는 API 입니다. 파생 센서는 연결할 수 없습니다.",
+		Tags:          []string{"Device"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct {
+		DeviceID string `path:"device_id" doc:"장치 ID 입니다."`
+		Body     []struct {
+			Key    string `json:"key" minLength:"1" maxLength:"100" doc:"장비에서 보내는 데이터의 json key 입니다." example:"degree"`
+			Target string `json:"target" doc:"센서 데이터 리스트의 title 명칭 입니다." example:"기온"`
+		} `maxItems:"100"`
+	}) (*deviceRequestSchemaListResponse, error) {
+		var resp deviceRequestSchemaListResponse
+		userID, _ := ctx.Value("user_id").(string)
+
+		in := make([]*domain.DeviceRequestSchema, 0, len(i.Body))
+		for _, rs := range i.Body {
+			in = append(in, &domain.DeviceRequestSchema{Key: rs.Key, Target: rs.Target})
+		}
+
+		schemaList, err := deviceUseCase.PutDeviceRequestSchemaList(ctx, userID, i.DeviceID, in)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionDeviceUpdate,
+			TargetType: "device",
+			TargetID:   i.DeviceID,
+		}, err)
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrNotFound):
+				return nil, huma.Error404NotFound("존재하지 않는 장치 입니다.")
+			case errors.Is(err, domain.ErrDeviceInvalid):
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			requestid.Logger(ctx, log).Error("device.h.v1DevicePutRequestSchemaList 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("장치 요청 스키마 교체에 실패했습니다.")
+		}
+
+		resp.Body = schemaList
+		return &resp, nil
+	})
+
+	log.Info("Device Handler 등록")
+}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/middleware"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/danielgtaylor/huma/v2"
+	"go.uber.org/zap"
+)
+
+// RegisterEmailHandler 이메일 인증, 비밀번호 재설정 Handler
+//
+// emailUseCase 가 nil 이면 모든 API 가 404 를 응답합니다.
+func RegisterEmailHandler(api huma.API, log *zap.Logger, emailUseCase domain.EmailUseCase, auditUseCase domain.AuditUseCase, m middleware.Middleware) {
+	v1 := huma.NewGroup(api, "/api/v1/auth")
+	disabled := func() error {
+		return huma.Error404NotFound("이메일 인증을 사용할 수 없습니다.")
+	}
+
+	// 인증 메일 발송
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1AuthSendEmailVerification",
+		Method:        http.MethodPost,
+		Path:          "/email/verification",
+		Summary:       "인증 메일 발송",
+		Description:   "현재 이메일로 인증 메일을 다시 발송하는 API 입니다. 이전에 발송된 인증 링크는 사용할 수 없게 됩니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusAccepted,
+	}), func(ctx context.Context, i *struct{}) (*struct{}, error) {
+		if emailUseCase == nil {
+			return nil, disabled()
+		}
+		userID, _ := ctx.Value("user_id").(string)
+
+		if err := emailUseCase.SendEmailVerification(ctx, userID); err != nil {
+			switch {
+			case errors.Is(err, domain.ErrEmailAlreadyVerified):
+				return nil, huma.Error409Conflict(domain.ErrEmailAlreadyVerified.Error())
+			case errors.Is(err, domain.ErrUnavailable):
+				return nil, huma.Error503ServiceUnavailable("잠시 후 다시 시도해주세요.")
+			}
+			requestid.Logger(ctx, log).Error("auth.h.v1AuthSendEmailVerification 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("인증 메일 발송에 실패했습니다.")
+		}
+
+		return nil, nil
+	})
+
+	// 이메일 인증
+	huma.Register(v1, huma.Operation{
+		OperationID:   "v1AuthVerifyEmail",
+		Method:        http.MethodPost,
+		Path:          "/email/verify",
+		Summary:       "이메일 인증",
+		Description:   "인증 메일의 링크에 포함된 token 으로 이메일을 인증하는 API 입니다. token 은 한번만 사용할 수 있습니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusNoContent,
+	}, func(ctx context.Context, i *struct {
+		Body struct {
+			Token string `json:"token,required" minLength:"1" doc:"인증 메일의 token 입니다."`
+		}
+	}) (*struct{}, error) {
+		if emailUseCase == nil {
+			return nil, disabled()
+		}
+
+		userID, err := emailUseCase.VerifyEmail(ctx, i.Body.Token)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionEmailVerify,
+			TargetType: "user",
+			TargetID:   userID,
+		}, err)
+		if err != nil {
+			if errors.Is(err, domain.ErrEmailTokenInvalid) {
+				return nil, huma.Error400BadRequest(domain.ErrEmailTokenInvalid.Error())
+			}
+			requestid.Logger(ctx, log).Error("auth.h.v1AuthVerifyEmail 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("이메일 인증에 실패했습니다.")
+		}
+
+		return nil, nil
+	})
+
+	// 비밀번호 재설정 요청
+	huma.Register(v1, huma.Operation{
+		OperationID:   "v1AuthRequestPasswordReset",
+		Method:        http.MethodPost,
+		Path:          "/password/reset-request",
+		Summary:       "비밀번호 재설정 요청",
+		Description:   "비밀번호 재설정 메일 발송 API 입니다. 가입 여부를 알 수 없도록 항상 202 를 응답합니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusAccepted,
+	}, func(ctx context.Context, i *struct {
+		Body struct {
+			Email string `json:"email,required" format:"email" doc:"가입한 이메일 입니다."`
+		}
+	}) (*struct{}, error) {
+		if emailUseCase == nil {
+			return nil, disabled()
+		}
+
+		if err := emailUseCase.RequestPasswordReset(ctx, i.Body.Email); err != nil {
+			requestid.Logger(ctx, log).Error("auth.h.v1AuthRequestPasswordReset 오류", zap.Error(err))
+		}
+
+		return nil, nil
+	})
+
+	// 비밀번호 재설정
+	huma.Register(v1, huma.Operation{
+		OperationID:   "v1AuthResetPassword",
+		Method:        http.MethodPost,
+		Path:          "/password/reset",
+		Summary:       "비밀번호 재설정",
+		Description:   "비밀번호 재설정 메일의 token 으로 비밀번호를 변경하는 API 입니다. 변경되면 모든 기기의 세션이 로그아웃 됩니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusNoContent,
+	}, func(ctx context.Context, i *struct {
+		Body struct {
+			Token    string `json:"token,required" minLength:"1" doc:"비밀번호 재설정 메일의 token 입니다."`
+			Password string `json:"password,required" minLength:"8" format:"password" doc:"변경할 비밀번호 입니다."`
+		}
+	}) (*struct{}, error) {
+		if emailUseCase == nil {
+			return nil, disabled()
+		}
+
+		userID, err := emailUseCase.ResetPassword(ctx, i.Body.Token, i.Body.Password)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionPasswordReset,
+			TargetType: "user",
+			TargetID:   userID,
+		}, err)
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrEmailTokenInvalid):
+				return nil, huma.Error400BadRequest(domain.ErrEmailTokenInvalid.Error())
+			case errors.Is(err, domain.ErrUnavailable):
+				return nil, huma.Error503ServiceUnavailable("잠시 후 다시 시도해주세요.")
+			}
+			requestid.Logger(ctx, log).Error("auth.h.v1AuthResetPassword 오류", zap.Error(err))
+			return nil, huma.Error400BadRequest("비밀번호 재설정에 실패했습니다.")
+		}
+
+		return nil, nil
+	})
+
+	log.Info("email Handler 등록")
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// ErrorModel
+//
+// huma 기본 에러 응답에 요청 ID 를 추가한 에러 응답 입니다.
+type ErrorModel struct {
+	huma.ErrorModel
+	RequestID string `json:"request_id,omitempty" doc:"요청 ID 입니다. 문의시 함께 전달해주세요." example:"0b8f3c1e-6a7d-4a55-9a55-3f3f1f6c2d11"`
+}
+
+// NewError huma.NewError 를 대체하여 ErrorModel 을 생성합니다.
+func NewError(status int, msg string, errs ...error) huma.StatusError {
+	details := make([]*huma.ErrorDetail, 0, len(errs))
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		if converted, ok := err.(huma.ErrorDetailer); ok {
+			details = append(details, converted.ErrorDetail())
+			continue
+		}
+		details = append(details, &huma.ErrorDetail{Message: err.Error()})
+	}
+
+	return &ErrorModel{
+		ErrorModel: huma.ErrorModel{
+			Status: status,
+			Title:  http.StatusText(status),
+			Detail: msg,
+			Errors: details,
+		},
+	}
+}
+
+// ErrorTransformer 에러 응답에 요청 ID 를 설정하는 huma Transformer 입니다.
+func ErrorTransformer(ctx huma.Context, status string, v any) (any, error) {
+	if e, ok := v.(*ErrorModel); ok && e.RequestID == "" {
+		e.RequestID = requestid.FromContext(ctx.Context())
+	}
+	return v, nil
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/GDH-Project/api/internal/health"
+	"github.com/danielgtaylor/huma/v2"
+	"go.uber.org/zap"
+)
+
+type livenessResponse struct {
+	Body struct {
+		Status health.Status `json:"status" enum:"ok,fail" doc:"프로세스 상태 입니다." example:"ok"`
+	}
+}
+
+type readinessResponse struct {
+	Status int
+	Body   *health.Report
+}
+
+// RegisterHealthHandler liveness, readiness 확인 Handler
+func RegisterHealthHandler(api huma.API, log *zap.Logger, checker *health.Checker) {
+	// liveness
+	huma.Register(api, huma.Operation{
+		OperationID:   "healthz",
+		Method:        http.MethodGet,
+		Path:          "/healthz",
+		Summary:       "liveness 확인",
+		Description:   "프로세스가 동작중인지 확인하는 API 입니다. 의존성은 검사하지 않습니다.",
+		Tags:          []string{"Health"},
+		DefaultStatus: http.StatusOK,
+	}, func(ctx context.Context, i *struct{}) (*livenessResponse, error) {
+		var resp livenessResponse
+		resp.Body.Status = health.StatusOK
+
+		return &resp, nil
+	})
+
+	// readiness
+	huma.Register(api, huma.Operation{
+		OperationID:   "readyz",
+		Method:        http.MethodGet,
+		Path:          "/readyz",
+		Summary:       "readiness 확인",
+		Description:   "Postgres, gRPC 등 의존성을 검사하여 트래픽 수신이 가능한지 확인하는 API 입니다. 서버 종료 중에는 503을 반환합니다.",
+		Tags:          []string{"Health"},
+		DefaultStatus: http.StatusOK,
+		Responses: map[string]*huma.Response{
+			"503": {Description: "Service Unavailable"},
+		},
+	}, func(ctx context.Context, i *struct{}) (*readinessResponse, error) {
+		var resp readinessResponse
+
+		resp.Body = checker.Run(ctx)
+		resp.Status = http.StatusOK
+		if resp.Body.Status != health.StatusOK {
+			resp.Status = http.StatusServiceUnavailable
+		}
+
+		return &resp, nil
+	})
+
+	log.Info("Health Handler 등록")
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 
+	"github.com/GDH-Project/api/internal/agro"
 	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/middleware"
+	"github.com/GDH-Project/api/internal/requestid"
 	"github.com/GDH-Project/api/internal/util"
 	"github.com/danielgtaylor/huma/v2"
-	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 )
 
@@ -25,6 +28,12 @@ type sensorResponse struct {
 	}
 }
 
+type sensorValueResponse struct {
+	Body struct {
+		Data map[string]float64 `json:"data" doc:"파생 센서 명칭과 계산한 값 입니다. 입력 센서 값이 없거나 범위를 벗어난 센서는 포함되지 않습니다." example:"{\"수증기압차\":0.95,\"이슬점\":19.1}"`
+	}
+}
+
 // addressStateList 도/특별시 리스트 응답 구조체
 type addressStateListResponse struct {
 	util.CacheHeader
@@ -55,6 +64,12 @@ type cropResponse struct {
 	}
 }
 
+type growingDegreeDaysResponse struct {
+	Body struct {
+		Data *domain.GrowingDegreeDays `json:"data" doc:"누적 생육 도일 JSON 입니다."`
+	}
+}
+
 type updateCycleListResponse struct {
 	util.CacheHeader
 	Body struct {
@@ -62,7 +77,15 @@ type updateCycleListResponse struct {
 	}
 }
 
-func RegisterMetaHandler(api huma.API, log *zap.Logger, metaUseCase domain.MetaUseCase) {
+// MetaCacheTTL 메타 정보 응답의 Cache-Control max-age 입니다.
+type MetaCacheTTL struct {
+	Sensor      time.Duration
+	Crop        time.Duration
+	Address     time.Duration
+	UpdateCycle time.Duration
+}
+
+func RegisterMetaHandler(api huma.API, log *zap.Logger, metaUseCase domain.MetaUseCase, cacheTTL MetaCacheTTL, m middleware.Middleware) {
 	v1 := huma.NewGroup(api, "/api/v1")
 
 	// 센서 정보 전체 조회 API
@@ -77,8 +100,8 @@ func RegisterMetaHandler(api huma.API, log *zap.Logger, metaUseCase domain.MetaU
 	}, func(ctx context.Context, i *struct{}) (*sensorListResponse, error) {
 		var resp sensorListResponse
 		sensorList, err := metaUseCase.GetSensorList(ctx)
-		if err != nil || len(sensorList) == 0 {
-			log.Error("meta.h.v1MetaGetSensorList 오류", zap.Error(err))
+		if err != nil {
+			requestid.Logger(ctx, log).Error("meta.h.v1MetaGetSensorList 오류", zap.Error(err))
 			return nil, huma.Error500InternalServerError("전체 센서 데이터를 불러오는 도중 오류가 발생했습니다.")
 		}
 
@@ -86,7 +109,7 @@ func RegisterMetaHandler(api huma.API, log *zap.Logger, metaUseCase domain.MetaU
 
 		cacheHeader := util.CacheHeaderBuilder{
 			CacheType: util.CacheTypePublic,
-			TTL:       60,
+			TTL:       int(cacheTTL.Sensor.Seconds()),
 		}
 		resp.CacheControl = cacheHeader.String()
 
@@ -108,13 +131,16 @@ func RegisterMetaHandler(api huma.API, log *zap.Logger, metaUseCase domain.MetaU
 		var resp sensorResponse
 		sensor, err := metaUseCase.GetSensorByParam(ctx, &domain.Sensor{ID: i.ID})
 		if err != nil {
-			if errors.Is(err, pgx.ErrNoRows) {
-				log.Info("meta.h.v1MetaGetSensorByID 잘못된 ID 검색 발생",
+			if errors.Is(err, domain.ErrNotFound) {
+				requestid.Logger(ctx, log).Info("meta.h.v1MetaGetSensorByID 잘못된 ID 검색 발생",
 					zap.Int("id", i.ID),
 					zap.Error(err))
-				return nil, huma.Error400BadRequest("존재하지 않는 센서 ID 입니다.")
+				return nil, huma.Error404NotFound("존재하지 않는 센서 ID 입니다.")
+			}
+			if errors.Is(err, domain.ErrInvalidParam) {
+				return nil, huma.Error400BadRequest("잘못된 센서 ID 입니다.")
 			}
-			log.Error("meta.h.v1MetaGetSensorByID 오류", zap.Error(err))
+			requestid.Logger(ctx, log).Error("meta.h.v1MetaGetSensorByID 오류", zap.Error(err))
 			return nil, huma.Error500InternalServerError("센서 데이터 불러오는 도중 오류가 발생했습니다.")
 		}
 
@@ -122,13 +148,38 @@ func RegisterMetaHandler(api huma.API, log *zap.Logger, metaUseCase domain.MetaU
 
 		cacheHeader := util.CacheHeaderBuilder{
 			CacheType: util.CacheTypePublic,
-			TTL:       60,
+			TTL:       int(cacheTTL.Sensor.Seconds()),
 		}
 		resp.CacheControl = cacheHeader.String()
 
 		return &resp, nil
 	})
 
+	// 파생 센서 계산 API
+	huma.Register(v1, huma.Operation{
+		OperationID:   "v1MetaDeriveSensorValues",
+		Method:        http.MethodPost,
+		Path:          "/meta/sensors/derive",
+		Summary:       "파생 센서 계산",
+		Description:   "같은 시각에 수집된 센서 값으로 파생 센서(수증기압차, 이슬점, 절대습도, 수분부족분) 값을 계산하는 API 입니다. 파생 센서와 입력 센서는 센서 정보의 formula, inputs 로 확인할 수 있습니다. 저장된 데이터의 파생 센서 값은 센서 데이터 조회 API 로 조회합니다.",
+		Tags:          []string{"Meta"},
+		DefaultStatus: http.StatusOK,
+	}, func(ctx context.Context, i *struct {
+		Body struct {
+			Values map[string]float64 `json:"values" required:"true" doc:"센서 명칭과 수집된 값 입니다." example:"{\"기온\":25,\"상대습도\":70}"`
+		}
+	}) (*sensorValueResponse, error) {
+		var resp sensorValueResponse
+		derived, err := metaUseCase.DeriveSensorValues(ctx, i.Body.Values)
+		if err != nil {
+			requestid.Logger(ctx, log).Error("meta.h.v1MetaDeriveSensorValues 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("파생 센서 값을 계산하는 도중 오류가 발생했습니다.")
+		}
+
+		resp.Body.Data = derived
+		return &resp, nil
+	})
+
 	// 주소 도/특별시 조회
 	huma.Register(v1, huma.Operation{
 		OperationID:   "v1MetaGetAddressState",
@@ -141,8 +192,8 @@ func RegisterMetaHandler(api huma.API, log *zap.Logger, metaUseCase domain.MetaU
 	}, func(ctx context.Context, i *struct{}) (*addressStateListResponse, error) {
 		var resp addressStateListResponse
 		addressStateList, err := metaUseCase.GetAddressStateList(ctx)
-		if err != nil || len(addressStateList) == 0 {
-			log.Error("meta.h.v1MetaGetAddressState 오류", zap.Error(err))
+		if err != nil {
+			requestid.Logger(ctx, log).Error("meta.h.v1MetaGetAddressState 오류", zap.Error(err))
 			return nil, huma.Error500InternalServerError("주소 데이터를 불러오는 도중 오류가 발생했습니다.")
 		}
 
@@ -150,7 +201,7 @@ func RegisterMetaHandler(api huma.API, log *zap.Logger, metaUseCase domain.MetaU
 
 		cacheHeader := util.CacheHeaderBuilder{
 			CacheType: util.CacheTypePublic,
-			TTL:       1800,
+			TTL:       int(cacheTTL.Address.Seconds()),
 		}
 		resp.CacheControl = cacheHeader.String()
 
@@ -172,24 +223,23 @@ func RegisterMetaHandler(api huma.API, log *zap.Logger, metaUseCase domain.MetaU
 		var resp addressCityListResponse
 		addressCityList, err := metaUseCase.GetAddressCityListByState(ctx, i.State)
 		if err != nil {
-			log.Error("meta.h.v1MetaGetAddressCityByStateID 오류", zap.Error(err))
+			// 도/특별시가 존재하지 않는 경우
+			if errors.Is(err, domain.ErrNotFound) {
+				requestid.Logger(ctx, log).Info("meta.h.v1MetaGetAddressCityByStateID 데이터 조회 실패",
+					zap.String("state", i.State),
+					zap.Error(err),
+				)
+				return nil, huma.Error404NotFound("state에 해당하는 데이터가 존재하지 않습니다.")
+			}
+			requestid.Logger(ctx, log).Error("meta.h.v1MetaGetAddressCityByStateID 오류", zap.Error(err))
 			return nil, huma.Error500InternalServerError("주소 데이터를 불러오는 도중 오류가 발생했습니다.")
 		}
 
-		// 값이 존재하지 않는 경우
-		if len(addressCityList) == 0 {
-			log.Info("meta.h.v1MetaGetAddressCityByStateID 데이터 조회 실패",
-				zap.String("state", i.State),
-				zap.Error(err),
-			)
-			return nil, huma.Error400BadRequest("state에 해당하는 데이터가 존재하지 않습니다.")
-		}
-
 		resp.Body.Data = addressCityList
 
 		cacheHeader := util.CacheHeaderBuilder{
 			CacheType: util.CacheTypePublic,
-			TTL:       1800,
+			TTL:       int(cacheTTL.Address.Seconds()),
 		}
 		resp.CacheControl = cacheHeader.String()
 
@@ -208,8 +258,8 @@ func RegisterMetaHandler(api huma.API, log *zap.Logger, metaUseCase domain.MetaU
 	}, func(ctx context.Context, i *struct{}) (*cropListResponse, error) {
 		var resp cropListResponse
 		cropList, err := metaUseCase.GetCropList(ctx)
-		if err != nil || len(cropList) == 0 {
-			log.Error("meta.h.v1MetaGetCropList 오류", zap.Error(err))
+		if err != nil {
+			requestid.Logger(ctx, log).Error("meta.h.v1MetaGetCropList 오류", zap.Error(err))
 			return nil, huma.Error500InternalServerError("전체 작물 데이터를 불러오는 도중 오류가 발생했습니다.")
 		}
 
@@ -217,7 +267,7 @@ func RegisterMetaHandler(api huma.API, log *zap.Logger, metaUseCase domain.MetaU
 
 		cacheHeader := util.CacheHeaderBuilder{
 			CacheType: util.CacheTypePublic,
-			TTL:       60,
+			TTL:       int(cacheTTL.Crop.Seconds()),
 		}
 		resp.CacheControl = cacheHeader.String()
 
@@ -239,21 +289,78 @@ func RegisterMetaHandler(api huma.API, log *zap.Logger, metaUseCase domain.MetaU
 		var resp cropResponse
 		crop, err := metaUseCase.GetCropByParam(ctx, &domain.Crop{Title: i.Title})
 		if err != nil {
-			log.Error("meta.h.v1MetaGetCropList 오류", zap.Error(err))
-			return nil, huma.Error500InternalServerError("전체 작물 데이터를 불러오는 도중 오류가 발생했습니다.")
+			if errors.Is(err, domain.ErrNotFound) {
+				requestid.Logger(ctx, log).Info("meta.h.v1MetaGetCropByTitle 잘못된 작물명 검색 발생",
+					zap.String("title", i.Title),
+					zap.Error(err))
+				return nil, huma.Error404NotFound("존재하지 않는 작물 입니다.")
+			}
+			requestid.Logger(ctx, log).Error("meta.h.v1MetaGetCropByTitle 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("작물 데이터를 불러오는 도중 오류가 발생했습니다.")
 		}
 
 		resp.Body.Data = crop
 
 		cacheHeader := util.CacheHeaderBuilder{
 			CacheType: util.CacheTypePublic,
-			TTL:       60,
+			TTL:       int(cacheTTL.Crop.Seconds()),
 		}
 		resp.CacheControl = cacheHeader.String()
 
 		return &resp, nil
 	})
 
+	// 누적 생육 도일 계산
+	huma.Register(v1, m.WithScopes(huma.Operation{
+		OperationID:   "v1MetaGetGrowingDegreeDays",
+		Method:        http.MethodPost,
+		Path:          "/meta/growing-degree-days",
+		Summary:       "누적 생육 도일 계산",
+		Description:   "로그인한 사용자 장치의 일 단위 기온 집계(device.reading_daily)로 파종(정식)일부터 오늘까지 하루 단위 누적 생육 도일(GDD)을 계산하는 API 입니다. 하루는 한국 표준시(Asia/Seoul) 기준이며, 하루의 최저, 최고 기온을 기준 온도와 상한 온도 사이로 제한한 평균에서 기준 온도를 뺍니다. 기준 온도, 상한 온도는 장치에 등록된 작물의 값을 사용하며 base, upper 로 바꿀 수 있습니다.",
+		Tags:          []string{"Meta"},
+		DefaultStatus: http.StatusOK,
+		MaxBodyBytes:  1 << 10,
+	}, domain.TokenScopeReadData), func(ctx context.Context, i *struct {
+		Body struct {
+			DeviceID  string   `json:"device_id" required:"true" format:"uuid" doc:"기온을 수집한 장치 ID 입니다. 장치에 등록된 작물로 계산합니다."`
+			PlantedOn string   `json:"planted_on" required:"true" format:"date" doc:"파종(정식)일 입니다. 최대 1년 전까지 계산할 수 있습니다." example:"2026-03-01"`
+			Base      *float64 `json:"base,omitempty" doc:"기준 온도(°C) 입니다. 없으면 작물의 기준 온도를 사용합니다." example:"10"`
+			Upper     *float64 `json:"upper,omitempty" doc:"상한 온도(°C) 입니다. 없으면 작물의 상한 온도를 사용합니다." example:"30"`
+		}
+	}) (*growingDegreeDaysResponse, error) {
+		var resp growingDegreeDaysResponse
+		userID, _ := ctx.Value("user_id").(string)
+
+		plantedOn, err := time.ParseInLocation(time.DateOnly, i.Body.PlantedOn, agro.Seoul)
+		if err != nil {
+			return nil, huma.Error400BadRequest("잘못된 파종(정식)일 입니다.")
+		}
+		if now := time.Now(); plantedOn.After(now) || plantedOn.Before(now.AddDate(-1, 0, -1)) {
+			return nil, huma.Error400BadRequest("파종(정식)일은 오늘부터 1년 전까지 입력할 수 있습니다.")
+		}
+
+		gdd, err := metaUseCase.GetGrowingDegreeDays(ctx, &domain.GrowingDegreeDaysParam{
+			UserID:    userID,
+			DeviceID:  i.Body.DeviceID,
+			PlantedOn: plantedOn,
+			Base:      i.Body.Base,
+			Upper:     i.Body.Upper,
+		})
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, huma.Error404NotFound("존재하지 않는 장치 이거나 장치에 작물이 없습니다.")
+			}
+			if errors.Is(err, domain.ErrDegreeDayThreshold) {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			requestid.Logger(ctx, log).Error("meta.h.v1MetaGetGrowingDegreeDays 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("생육 도일을 계산하는 도중 오류가 발생했습니다.")
+		}
+
+		resp.Body.Data = gdd
+		return &resp, nil
+	})
+
 	// 갱신 주기 조회
 	huma.Register(v1, huma.Operation{
 		OperationID:   "v1MetaGetUpdateCycleList",
@@ -266,8 +373,8 @@ func RegisterMetaHandler(api huma.API, log *zap.Logger, metaUseCase domain.MetaU
 	}, func(ctx context.Context, i *struct{}) (*updateCycleListResponse, error) {
 		var resp updateCycleListResponse
 		updateCycleList, err := metaUseCase.GetUpdateCycleList(ctx)
-		if err != nil || len(updateCycleList) == 0 {
-			log.Error("meta.h.v1MetaGetUpdateCycleList 오류", zap.Error(err))
+		if err != nil {
+			requestid.Logger(ctx, log).Error("meta.h.v1MetaGetUpdateCycleList 오류", zap.Error(err))
 			return nil, huma.Error500InternalServerError("업데이트 주기 정보를 불러오는 중 오류가 발생했습니다.")
 		}
 
@@ -275,7 +382,7 @@ func RegisterMetaHandler(api huma.API, log *zap.Logger, metaUseCase domain.MetaU
 
 		cacheHeader := util.CacheHeaderBuilder{
 			CacheType: util.CacheTypePublic,
-			TTL:       1800,
+			TTL:       int(cacheTTL.UpdateCycle.Seconds()),
 		}
 		resp.CacheControl = cacheHeader.String()
 
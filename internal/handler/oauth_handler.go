@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/GDH-Project/api/internal/authcookie"
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/danielgtaylor/huma/v2"
+	"go.uber.org/zap"
+)
+
+type oauthProviderListResponse struct {
+	Status int
+	Body   struct {
+		Providers []string `json:"providers" doc:"사용할 수 있는 소셜 로그인 제공자 입니다. 로그인 API 의 type 으로 사용합니다." example:"[\"kakao\",\"naver\"]"`
+	}
+}
+
+type oauthAuthorizationResponse struct {
+	Status    int
+	SetCookie http.Cookie `header:"Set-Cookie" doc:"로그인 요청에서 state 를 확인하는 쿠키 입니다."`
+	Body      struct {
+		AuthorizationURL string `json:"authorization_url" doc:"사용자를 이동시킬 제공자의 인가 URL 입니다." example:"https://kauth.kakao.com/oauth/authorize?..."`
+	}
+}
+
+// RegisterOAuthHandler 소셜 로그인 Handler
+//
+// oauthUseCase 가 nil 이면 사용할 수 있는 제공자가 없습니다.
+// state 는 stateCookie 설정으로 브라우저 쿠키에 저장하며 로그인 API 에서 확인합니다.
+func RegisterOAuthHandler(api huma.API, log *zap.Logger, oauthUseCase domain.OAuthUseCase, stateCookie *authcookie.Options) {
+	v1 := huma.NewGroup(api, "/api/v1/auth/oauth")
+
+	// 소셜 로그인 제공자 목록
+	huma.Register(v1, huma.Operation{
+		OperationID:   "v1AuthGetOAuthProviderList",
+		Method:        http.MethodGet,
+		Path:          "/providers",
+		Summary:       "소셜 로그인 제공자 목록",
+		Description:   "서버에 설정된 소셜 로그인 제공자 목록 조회 API 입니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusOK,
+	}, func(ctx context.Context, i *struct{}) (*oauthProviderListResponse, error) {
+		var resp oauthProviderListResponse
+
+		resp.Body.Providers = []string{}
+		if oauthUseCase != nil {
+			resp.Body.Providers = oauthUseCase.GetOAuthProviderList()
+		}
+
+		return &resp, nil
+	})
+
+	// 소셜 로그인 인가 URL
+	huma.Register(v1, huma.Operation{
+		OperationID:   "v1AuthGetOAuthAuthorizationURL",
+		Method:        http.MethodGet,
+		Path:          "/{provider}/authorize",
+		Summary:       "소셜 로그인 인가 URL 조회",
+		Description:   "소셜 로그인 인가 URL 조회 API 입니다. PKCE(S256)와 일회용 state 를 사용하며, 사용자가 동의하면 redirect_uri 로 code, state 가 전달됩니다. 전달받은 값으로 로그인 API 를 호출합니다. state 는 HttpOnly 쿠키로도 전달되며 같은 브라우저의 로그인 요청에서만 사용할 수 있습니다. redirect_uri 는 서버에 등록된 값만 사용할 수 있습니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusOK,
+	}, func(ctx context.Context, i *struct {
+		Provider    string `path:"provider" enum:"google,kakao,naver" doc:"소셜 로그인 제공자 입니다."`
+		RedirectURI string `query:"redirect_uri,required" format:"uri" doc:"인가 후 이동할 주소 입니다." example:"https://example.com/oauth/callback"`
+	}) (*oauthAuthorizationResponse, error) {
+		var resp oauthAuthorizationResponse
+
+		if oauthUseCase == nil {
+			return nil, huma.Error404NotFound(domain.ErrOAuthProviderNotFound.Error())
+		}
+
+		authorizationURL, state, err := oauthUseCase.GetOAuthAuthorizationURL(ctx, i.Provider, i.RedirectURI)
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrOAuthProviderNotFound):
+				return nil, huma.Error404NotFound(domain.ErrOAuthProviderNotFound.Error())
+			case errors.Is(err, domain.ErrOAuthInvalidRequest):
+				return nil, huma.Error400BadRequest("허용되지 않은 redirect_uri 입니다.")
+			case errors.Is(err, domain.ErrUnavailable):
+				return nil, huma.Error503ServiceUnavailable("잠시 후 다시 시도해주세요.")
+			}
+			requestid.Logger(ctx, log).Error("auth.h.v1AuthGetOAuthAuthorizationURL 오류", zap.Error(err), zap.String("provider", i.Provider))
+			return nil, huma.Error500InternalServerError("인가 URL 생성에 실패했습니다.")
+		}
+
+		resp.SetCookie = stateCookie.StateCookie(state)
+		resp.Body.AuthorizationURL = authorizationURL
+		return &resp, nil
+	})
+
+	log.Info("oauth Handler 등록")
+}
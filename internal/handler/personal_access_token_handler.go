@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/middleware"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/danielgtaylor/huma/v2"
+	"go.uber.org/zap"
+)
+
+type personalAccessTokenResponse struct {
+	Status int
+	Body   struct {
+		domain.PersonalAccessToken
+		Token string `json:"token" doc:"토큰 원문 입니다. 생성할 때만 확인할 수 있습니다." example:"gdhp_4ZQ2JX7K3M5N6P7Q2R3S4T5U6V"`
+	}
+}
+
+type personalAccessTokenListResponse struct {
+	Status int
+	Body   []*domain.PersonalAccessToken
+}
+
+// RegisterPersonalAccessTokenHandler 개인 액세스 토큰 Handler
+//
+// 토큰 관리는 로그인한 사용자만 할 수 있으며 개인 액세스 토큰으로는 호출할 수 없습니다.
+func RegisterPersonalAccessTokenHandler(api huma.API, log *zap.Logger, personalAccessTokenUseCase domain.PersonalAccessTokenUseCase, auditUseCase domain.AuditUseCase, m middleware.Middleware) {
+	v1 := huma.NewGroup(api, "/api/v1/auth")
+
+	// 토큰 생성
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1AuthCreatePersonalAccessToken",
+		Method:        http.MethodPost,
+		Path:          "/personal-access-tokens",
+		Summary:       "개인 액세스 토큰 생성",
+		Description:   "스크립트 등에서 사용할 개인 액세스 토큰을 생성하는 API 입니다. 토큰 원문은 응답으로 한번만 확인할 수 있습니다.\n\n`Authorization: Bearer {token}` 헤더로 범위(scope)가 허용된 API 를 호출할 수 있습니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusCreated,
+	}), func(ctx context.Context, i *struct {
+		Body struct {
+			Name      string              `json:"name,required" minLength:"1" maxLength:"100" doc:"토큰 이름 입니다." example:"nightly-export"`
+			Scopes    []domain.TokenScope `json:"scopes,required" minItems:"1" doc:"토큰으로 접근할 수 있는 범위 입니다." enum:"read:data,write:data,read:devices"`
+			ExpiresAt time.Time           `json:"expires_at,required" doc:"만료 시각 입니다. 최대 유효 기간은 서버 설정을 따릅니다." example:"2026-12-31T00:00:00+09:00"`
+		}
+	}) (*personalAccessTokenResponse, error) {
+		var resp personalAccessTokenResponse
+		userID, _ := ctx.Value("user_id").(string)
+
+		t, token, err := personalAccessTokenUseCase.CreatePersonalAccessToken(ctx, userID, i.Body.Name, i.Body.Scopes, i.Body.ExpiresAt)
+		event := &domain.AuditEvent{
+			Action:     domain.AuditActionAccessTokenCreate,
+			TargetType: "personal_access_token",
+		}
+		if t != nil {
+			event.TargetID = t.ID
+		}
+		recordAudit(ctx, log, auditUseCase, event, err)
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrPersonalAccessTokenExpiry):
+				return nil, huma.Error400BadRequest(domain.ErrPersonalAccessTokenExpiry.Error())
+			case errors.Is(err, domain.ErrPersonalAccessTokenLimit):
+				return nil, huma.Error409Conflict(domain.ErrPersonalAccessTokenLimit.Error())
+			}
+			requestid.Logger(ctx, log).Error("auth.h.v1AuthCreatePersonalAccessToken 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("개인 액세스 토큰 생성에 실패했습니다.")
+		}
+
+		resp.Body.PersonalAccessToken = *t
+		resp.Body.Token = token
+		return &resp, nil
+	})
+
+	// 토큰 목록
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1AuthGetPersonalAccessTokenList",
+		Method:        http.MethodGet,
+		Path:          "/personal-access-tokens",
+		Summary:       "개인 액세스 토큰 목록 조회",
+		Description:   "만료된 토큰을 포함한 개인 액세스 토큰 목록을 최신순으로 조회하는 API 입니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct{}) (*personalAccessTokenListResponse, error) {
+		var resp personalAccessTokenListResponse
+		userID, _ := ctx.Value("user_id").(string)
+
+		tokenList, err := personalAccessTokenUseCase.GetPersonalAccessTokenList(ctx, userID)
+		if err != nil {
+			requestid.Logger(ctx, log).Error("auth.h.v1AuthGetPersonalAccessTokenList 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("개인 액세스 토큰 목록 조회에 실패했습니다.")
+		}
+
+		resp.Body = tokenList
+		return &resp, nil
+	})
+
+	// 토큰 폐기
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1AuthRevokePersonalAccessToken",
+		Method:        http.MethodDelete,
+		Path:          "/personal-access-tokens/{token_id}",
+		Summary:       "개인 액세스 토큰 폐기",
+		Description:   "개인 액세스 토큰을 폐기하는 API 입니다. 폐기 즉시 사용할 수 없습니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusNoContent,
+	}), func(ctx context.Context, i *struct {
+		TokenID string `path:"token_id" doc:"토큰 ID 입니다."`
+	}) (*struct{}, error) {
+		userID, _ := ctx.Value("user_id").(string)
+
+		err := personalAccessTokenUseCase.RevokePersonalAccessToken(ctx, userID, i.TokenID)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionAccessTokenRevoke,
+			TargetType: "personal_access_token",
+			TargetID:   i.TokenID,
+		}, err)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, huma.Error404NotFound("존재하지 않는 토큰 입니다.")
+			}
+			requestid.Logger(ctx, log).Error("auth.h.v1AuthRevokePersonalAccessToken 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("개인 액세스 토큰 폐기에 실패했습니다.")
+		}
+
+		return nil, nil
+	})
+
+	log.Info("personal access token Handler 등록")
+}
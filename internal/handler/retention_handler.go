@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/middleware"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/danielgtaylor/huma/v2"
+	"go.uber.org/zap"
+)
+
+type retentionPolicyListResponse struct {
+	Status int
+	Body   []*domain.RetentionPolicy
+}
+
+type retentionPolicyResponse struct {
+	Status int
+	Body   *domain.RetentionPolicy
+}
+
+type retentionPreviewResponse struct {
+	Status int
+	Body   []*domain.RetentionItem
+}
+
+type retentionRunListResponse struct {
+	Status int
+	Body   []*domain.RetentionRun
+}
+
+// retentionPolicyBody 보관 일수 입력, 값이 없으면 삭제하지 않습니다.
+type retentionPolicyBody struct {
+	RawDays    *int `json:"raw_days,omitempty" minimum:"1" doc:"원본 데이터 보관 일수 입니다." example:"90"`
+	HourlyDays *int `json:"hourly_days,omitempty" minimum:"1" doc:"시간 단위 집계 보관 일수 입니다. 원본 데이터보다 길어야 합니다." example:"730"`
+	DailyDays  *int `json:"daily_days,omitempty" minimum:"1" doc:"일 단위 집계 보관 일수 입니다. 시간 단위 집계보다 길어야 합니다."`
+}
+
+// RegisterRetentionHandler 센서 데이터 보관 정책 관리자 Handler
+func RegisterRetentionHandler(api huma.API, log *zap.Logger, retentionUseCase domain.RetentionUseCase, auditUseCase domain.AuditUseCase, m middleware.Middleware) {
+	v1 := huma.NewGroup(api, "/api/v1/admin")
+
+	// 보관 정책 목록
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminGetRetentionPolicyList",
+		Method:        http.MethodGet,
+		Path:          "/retention-policies",
+		Summary:       "센서 데이터 보관 정책 목록 조회",
+		Description:   "장치 등급별 센서 데이터 보관 정책 조회 API 입니다. default 는 등급이 지정되지 않은 장치에 적용합니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct{}) (*retentionPolicyListResponse, error) {
+		var resp retentionPolicyListResponse
+
+		policyList, err := retentionUseCase.GetRetentionPolicyList(ctx)
+		if err != nil {
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminGetRetentionPolicyList 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("보관 정책 목록 조회에 실패했습니다.")
+		}
+
+		resp.Body = policyList
+		return &resp, nil
+	})
+
+	// 보관 정책 생성, 변경
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminPutRetentionPolicy",
+		Method:        http.MethodPut,
+		Path:          "/retention-policies/{tier}",
+		Summary:       "센서 데이터 보관 정책 변경",
+		Description:   "장치 등급의 보관 정책을 생성하거나 변경하는 API 입니다. 보관 일수가 없는 데이터는 삭제하지 않으며, 집계 데이터는 원본 데이터보다 오래 보관해야 합니다. 변경 전 미리보기 API 로 삭제될 데이터를 확인할 수 있습니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct {
+		Tier string `path:"tier" doc:"장치 등급 입니다." example:"default"`
+		Body retentionPolicyBody
+	}) (*retentionPolicyResponse, error) {
+		var resp retentionPolicyResponse
+
+		policy := &domain.RetentionPolicy{
+			Tier:       i.Tier,
+			RawDays:    i.Body.RawDays,
+			HourlyDays: i.Body.HourlyDays,
+			DailyDays:  i.Body.DailyDays,
+		}
+		err := retentionUseCase.PutRetentionPolicy(ctx, policy)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionRetentionPolicyUpdate,
+			TargetType: "retention_policy",
+			TargetID:   i.Tier,
+		}, err)
+		if err != nil {
+			if errors.Is(err, domain.ErrRetentionPolicyInvalid) {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminPutRetentionPolicy 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("보관 정책 변경에 실패했습니다.")
+		}
+
+		resp.Body = policy
+		return &resp, nil
+	})
+
+	// 보관 정책 삭제
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminDeleteRetentionPolicy",
+		Method:        http.MethodDelete,
+		Path:          "/retention-policies/{tier}",
+		Summary:       "센서 데이터 보관 정책 삭제",
+		Description:   "장치 등급의 보관 정책을 삭제하는 API 입니다. default 정책과 장치에 지정된 등급은 삭제할 수 없습니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusNoContent,
+	}), func(ctx context.Context, i *struct {
+		Tier string `path:"tier" doc:"장치 등급 입니다." example:"premium"`
+	}) (*struct{}, error) {
+		err := retentionUseCase.DeleteRetentionPolicy(ctx, i.Tier)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionRetentionPolicyDelete,
+			TargetType: "retention_policy",
+			TargetID:   i.Tier,
+		}, err)
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrNotFound):
+				return nil, huma.Error404NotFound("존재하지 않는 보관 정책 입니다.")
+			case errors.Is(err, domain.ErrRetentionPolicyInUse):
+				return nil, huma.Error409Conflict("장치에 지정된 등급은 삭제할 수 없습니다.")
+			case errors.Is(err, domain.ErrRetentionPolicyInvalid):
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminDeleteRetentionPolicy 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("보관 정책 삭제에 실패했습니다.")
+		}
+
+		return nil, nil
+	})
+
+	// 보관 정책 미리보기
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminPreviewRetentionPolicy",
+		Method:        http.MethodPost,
+		Path:          "/retention-policies/{tier}/preview",
+		Summary:       "센서 데이터 보관 정책 미리보기",
+		Description:   "장치 등급에 보관 정책을 적용하면 삭제될 데이터 건수를 조회하는 API 입니다. 정책은 저장하지 않습니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct {
+		Tier string `path:"tier" doc:"장치 등급 입니다." example:"default"`
+		Body retentionPolicyBody
+	}) (*retentionPreviewResponse, error) {
+		var resp retentionPreviewResponse
+
+		items, err := retentionUseCase.PreviewRetentionPolicy(ctx, &domain.RetentionPolicy{
+			Tier:       i.Tier,
+			RawDays:    i.Body.RawDays,
+			HourlyDays: i.Body.HourlyDays,
+			DailyDays:  i.Body.DailyDays,
+		})
+		if err != nil {
+			if errors.Is(err, domain.ErrRetentionPolicyInvalid) {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminPreviewRetentionPolicy 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("보관 정책 미리보기에 실패했습니다.")
+		}
+
+		resp.Body = items
+		return &resp, nil
+	})
+
+	// 장치 등급 지정
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminPutDeviceRetentionTier",
+		Method:        http.MethodPut,
+		Path:          "/devices/{device_id}/retention-tier",
+		Summary:       "장치 보관 등급 지정",
+		Description:   "장치에 보관 정책 등급을 지정하는 API 입니다. default 를 지정하면 전체 정책을 사용합니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusNoContent,
+	}), func(ctx context.Context, i *struct {
+		DeviceID string `path:"device_id" format:"uuid" doc:"장치 ID 입니다."`
+		Body     struct {
+			Tier string `json:"tier" required:"true" doc:"장치 등급 입니다." example:"premium"`
+		}
+	}) (*struct{}, error) {
+		err := retentionUseCase.SetDeviceRetentionTier(ctx, i.DeviceID, i.Body.Tier)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionDeviceRetentionTier,
+			TargetType: "device",
+			TargetID:   i.DeviceID,
+		}, err)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, huma.Error404NotFound("존재하지 않는 보관 정책 입니다.")
+			}
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminPutDeviceRetentionTier 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("장치 보관 등급 지정에 실패했습니다.")
+		}
+
+		return nil, nil
+	})
+
+	// 정리 결과
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminGetRetentionRunList",
+		Method:        http.MethodGet,
+		Path:          "/retention-runs",
+		Summary:       "센서 데이터 보관 기간 정리 결과 조회",
+		Description:   "최근 50번의 보관 기간 정리에서 등급, 데이터 종류별로 삭제한 건수를 조회하는 API 입니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct{}) (*retentionRunListResponse, error) {
+		var resp retentionRunListResponse
+
+		runList, err := retentionUseCase.GetRetentionRunList(ctx)
+		if err != nil {
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminGetRetentionRunList 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("보관 기간 정리 결과 조회에 실패했습니다.")
+		}
+
+		resp.Body = runList
+		return &resp, nil
+	})
+
+	log.Info("Retention Handler 등록")
+}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/middleware"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/danielgtaylor/huma/v2"
+	"go.uber.org/zap"
+)
+
+type rollupStatusResponse struct {
+	Status int
+	Body   *domain.RollupStatus
+}
+
+type rollupBackfillResponse struct {
+	Status int
+	Body   struct {
+		Queued int64 `json:"queued" doc:"집계 대기에 추가된 시간 단위 구간 수 입니다." example:"24"`
+	}
+}
+
+type readingSeriesResponse struct {
+	Status int
+	Body   *domain.ReadingSeries
+}
+
+// RegisterRollupHandler 센서 데이터 조회, 집계 관리자 Handler
+func RegisterRollupHandler(api huma.API, log *zap.Logger, rollupUseCase domain.RollupUseCase, m middleware.Middleware) {
+	devices := huma.NewGroup(api, "/api/v1/devices")
+
+	// 사용자 장치 센서 데이터 조회
+	huma.Register(devices, m.WithScopes(huma.Operation{
+		OperationID:   "v1DeviceGetReadingSeries",
+		Method:        http.MethodGet,
+		Path:          "/{device_id}/readings",
+		Summary:       "장치 센서 데이터 조회",
+		Description:   "로그인한 사용자 장치의 센서 데이터를 해상도 구간별 최솟값, 최댓값, 평균, 개수로 조회하는 API 입니다. 해상도가 1시간, 1일의 배수이면 시간, 일 단위 집계를 사용하며 구간은 한국 표준시 자정을 기준으로 나눕니다. 파생 센서는 저장된 입력 센서 데이터로 계산합니다.",
+		Tags:          []string{"Device"},
+		DefaultStatus: http.StatusOK,
+	}, domain.TokenScopeReadData), func(ctx context.Context, i *struct {
+		DeviceID   string    `path:"device_id" format:"uuid" doc:"장치 ID 입니다."`
+		SensorID   int       `query:"sensor_id" required:"true" minimum:"1" doc:"센서 ID 입니다. 파생 센서도 조회할 수 있습니다." example:"1"`
+		From       time.Time `query:"from" required:"true" doc:"조회 시작 시각 (포함) 입니다." example:"2026-03-01T00:00:00+09:00"`
+		To         time.Time `query:"to" required:"true" doc:"조회 종료 시각 (미포함) 입니다." example:"2026-03-08T00:00:00+09:00"`
+		Resolution string    `query:"resolution" default:"1h" doc:"구간 길이 입니다. 분 단위로 입력합니다. (15m, 1h, 24h 등)" example:"1h"`
+	}) (*readingSeriesResponse, error) {
+		var resp readingSeriesResponse
+		userID, _ := ctx.Value("user_id").(string)
+
+		resolution, err := time.ParseDuration(i.Resolution)
+		if err != nil {
+			return nil, huma.Error400BadRequest("잘못된 해상도 입니다.")
+		}
+
+		series, err := rollupUseCase.GetDeviceReadingSeries(ctx, userID, &domain.ReadingQuery{
+			DeviceID:   i.DeviceID,
+			SensorID:   i.SensorID,
+			From:       i.From,
+			To:         i.To,
+			Resolution: resolution,
+		})
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, huma.Error404NotFound("존재하지 않는 장치 입니다.")
+			}
+			if errors.Is(err, domain.ErrRollupRange) {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			requestid.Logger(ctx, log).Error("device.h.v1DeviceGetReadingSeries 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("센서 데이터 조회에 실패했습니다.")
+		}
+
+		resp.Body = series
+		return &resp, nil
+	})
+
+	v1 := huma.NewGroup(api, "/api/v1/admin")
+
+	// 집계 대기 현황
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminGetRollupStatus",
+		Method:        http.MethodGet,
+		Path:          "/rollups",
+		Summary:       "센서 데이터 집계 현황 조회",
+		Description:   "시간, 일 단위 집계를 기다리는 구간 수를 조회하는 API 입니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct{}) (*rollupStatusResponse, error) {
+		var resp rollupStatusResponse
+
+		status, err := rollupUseCase.GetRollupStatus(ctx)
+		if err != nil {
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminGetRollupStatus 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("센서 데이터 집계 현황 조회에 실패했습니다.")
+		}
+
+		resp.Body = status
+		return &resp, nil
+	})
+
+	// 집계 다시 계산
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminBackfillRollup",
+		Method:        http.MethodPost,
+		Path:          "/rollups/backfill",
+		Summary:       "센서 데이터 집계 다시 계산",
+		Description:   "구간의 원본 데이터로 시간, 일 단위 집계를 다시 계산하도록 등록하는 API 입니다. 등록된 구간은 집계 작업이 순서대로 처리합니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusAccepted,
+	}), func(ctx context.Context, i *struct {
+		Body struct {
+			DeviceID string    `json:"device_id,omitempty" format:"uuid" doc:"장치 ID 입니다. 없으면 전체 장치를 다시 계산합니다."`
+			From     time.Time `json:"from" required:"true" doc:"시작 시각 (포함) 입니다." example:"2026-03-01T00:00:00+09:00"`
+			To       time.Time `json:"to" required:"true" doc:"종료 시각 (미포함) 입니다." example:"2026-03-02T00:00:00+09:00"`
+		}
+	}) (*rollupBackfillResponse, error) {
+		var resp rollupBackfillResponse
+
+		queued, err := rollupUseCase.BackfillRollup(ctx, i.Body.DeviceID, i.Body.From, i.Body.To)
+		if err != nil {
+			if errors.Is(err, domain.ErrRollupRange) {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminBackfillRollup 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("센서 데이터 집계 등록에 실패했습니다.")
+		}
+
+		resp.Status = http.StatusAccepted
+		resp.Body.Queued = queued
+		return &resp, nil
+	})
+
+	// 장치 센서 데이터 조회
+	huma.Register(v1, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminGetReadingSeries",
+		Method:        http.MethodGet,
+		Path:          "/devices/{device_id}/readings",
+		Summary:       "장치 센서 데이터 조회 (관리자)",
+		Description:   "지원 용도로 사용자와 관계없이 장치의 데이터를 해상도 구간별 최솟값, 최댓값, 평균, 개수로 조회하는 API 입니다. 해상도가 1시간, 1일의 배수이면 시간, 일 단위 집계를 사용하며 구간은 한국 표준시 자정을 기준으로 나눕니다. 파생 센서는 저장된 입력 센서 데이터로 계산합니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct {
+		DeviceID   string    `path:"device_id" format:"uuid" doc:"장치 ID 입니다."`
+		SensorID   int       `query:"sensor_id" required:"true" minimum:"1" doc:"센서 ID 입니다. 파생 센서도 조회할 수 있습니다." example:"1"`
+		From       time.Time `query:"from" required:"true" doc:"조회 시작 시각 (포함) 입니다." example:"2026-03-01T00:00:00+09:00"`
+		To         time.Time `query:"to" required:"true" doc:"조회 종료 시각 (미포함) 입니다." example:"2026-03-08T00:00:00+09:00"`
+		Resolution string    `query:"resolution" default:"1h" doc:"구간 길이 입니다. 분 단위로 입력합니다. (15m, 1h, 24h 등)" example:"1h"`
+	}) (*readingSeriesResponse, error) {
+		var resp readingSeriesResponse
+
+		resolution, err := time.ParseDuration(i.Resolution)
+		if err != nil {
+			return nil, huma.Error400BadRequest("잘못된 해상도 입니다.")
+		}
+
+		series, err := rollupUseCase.GetReadingSeries(ctx, &domain.ReadingQuery{
+			DeviceID:   i.DeviceID,
+			SensorID:   i.SensorID,
+			From:       i.From,
+			To:         i.To,
+			Resolution: resolution,
+		})
+		if err != nil {
+			if errors.Is(err, domain.ErrRollupRange) {
+				return nil, huma.Error400BadRequest(err.Error())
+			}
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminGetReadingSeries 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("센서 데이터 조회에 실패했습니다.")
+		}
+
+		resp.Body = series
+		return &resp, nil
+	})
+
+	log.Info("Rollup Handler 등록")
+}
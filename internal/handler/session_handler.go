@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/middleware"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/danielgtaylor/huma/v2"
+	"go.uber.org/zap"
+)
+
+type sessionListResponse struct {
+	Status int
+	Body   []*domain.Session
+}
+
+type revokeOtherSessionsResponse struct {
+	Status int
+	Body   struct {
+		Revoked int64 `json:"revoked" doc:"폐기된 세션 수 입니다." example:"2"`
+	}
+}
+
+// RegisterSessionHandler 로그인 세션 Handler
+func RegisterSessionHandler(api huma.API, log *zap.Logger, sessionUseCase domain.SessionUseCase, auditUseCase domain.AuditUseCase, m middleware.Middleware) {
+	v1 := huma.NewGroup(api, "/api/v1/auth")
+
+	// 세션 목록
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1AuthGetSessionList",
+		Method:        http.MethodGet,
+		Path:          "/sessions",
+		Summary:       "로그인 세션 목록 조회",
+		Description:   "로그인 되어 있는 세션 목록을 마지막 활동 순으로 조회하는 API 입니다. 기기, 위치는 User-Agent, IP 대역으로 추정한 값 입니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct{}) (*sessionListResponse, error) {
+		var resp sessionListResponse
+		userID, _ := ctx.Value("user_id").(string)
+		accessToken, _ := ctx.Value("access_token").(string)
+
+		sessionList, err := sessionUseCase.GetSessionList(ctx, userID, accessToken)
+		if err != nil {
+			requestid.Logger(ctx, log).Error("auth.h.v1AuthGetSessionList 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("세션 목록 조회에 실패했습니다.")
+		}
+
+		resp.Body = sessionList
+		return &resp, nil
+	})
+
+	// 세션 폐기
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1AuthRevokeSession",
+		Method:        http.MethodDelete,
+		Path:          "/sessions/{session_id}",
+		Summary:       "로그인 세션 폐기",
+		Description:   "세션을 로그아웃 하는 API 입니다. 폐기 즉시 해당 세션의 토큰을 사용할 수 없습니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusNoContent,
+	}), func(ctx context.Context, i *struct {
+		SessionID string `path:"session_id" doc:"세션 ID 입니다."`
+	}) (*struct{}, error) {
+		userID, _ := ctx.Value("user_id").(string)
+
+		err := sessionUseCase.RevokeSession(ctx, userID, i.SessionID)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionSessionRevoke,
+			TargetType: "session",
+			TargetID:   i.SessionID,
+		}, err)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, huma.Error404NotFound("존재하지 않는 세션 입니다.")
+			}
+			requestid.Logger(ctx, log).Error("auth.h.v1AuthRevokeSession 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("세션 폐기에 실패했습니다.")
+		}
+
+		return nil, nil
+	})
+
+	// 다른 세션 전체 폐기
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1AuthRevokeOtherSessions",
+		Method:        http.MethodPost,
+		Path:          "/sessions/revoke-others",
+		Summary:       "다른 로그인 세션 전체 폐기",
+		Description:   "현재 세션을 제외한 모든 세션을 로그아웃 하는 API 입니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct{}) (*revokeOtherSessionsResponse, error) {
+		var resp revokeOtherSessionsResponse
+		userID, _ := ctx.Value("user_id").(string)
+		accessToken, _ := ctx.Value("access_token").(string)
+
+		revoked, err := sessionUseCase.RevokeOtherSessions(ctx, userID, accessToken)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionSessionRevoke,
+			TargetType: "user",
+			TargetID:   userID,
+		}, err)
+		if err != nil {
+			requestid.Logger(ctx, log).Error("auth.h.v1AuthRevokeOtherSessions 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("세션 폐기에 실패했습니다.")
+		}
+
+		resp.Body.Revoked = revoked
+		return &resp, nil
+	})
+
+	log.Info("session Handler 등록")
+}
@@ -0,0 +1,301 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/middleware"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/danielgtaylor/huma/v2"
+	"go.uber.org/zap"
+)
+
+type twoFactorStatusResponse struct {
+	Status int
+	Body   *domain.TwoFactorStatus
+}
+
+type twoFactorEnrollmentResponse struct {
+	Status int
+	Body   *domain.TwoFactorEnrollment
+}
+
+type recoveryCodesResponse struct {
+	Status int
+	Body   struct {
+		RecoveryCodes []string `json:"recovery_codes" doc:"복구 코드 목록 입니다. 다시 조회할 수 없으므로 안전한 곳에 보관해야 하며, 각 코드는 한번만 사용할 수 있습니다." example:"[\"ABCDE-FGHIJ\"]"`
+	}
+}
+
+type twoFactorRequiredRolesResponse struct {
+	Status int
+	Body   struct {
+		Roles []domain.UserRole `json:"roles" enum:"admin" doc:"2단계 인증이 필요한 권한 목록 입니다."`
+	}
+}
+
+type twoFactorCodeInput struct {
+	Body struct {
+		Code string `json:"code,required" minLength:"6" doc:"OTP 앱의 6자리 인증 코드 입니다." example:"123456"`
+	}
+}
+
+// twoFactorError 2단계 인증 에러를 응답으로 변환합니다.
+func twoFactorError(ctx context.Context, log *zap.Logger, operationID string, err error) error {
+	var lockedErr *domain.SignInLockedError
+	if errors.As(err, &lockedErr) {
+		return huma.ErrorWithHeaders(
+			huma.Error429TooManyRequests("인증 코드가 여러 번 틀렸습니다. 잠시 후 다시 시도해주세요."),
+			http.Header{"Retry-After": {strconv.Itoa(int(math.Ceil(lockedErr.RetryAfter.Seconds())))}},
+		)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrTwoFactorInvalidCode):
+		return huma.Error400BadRequest(domain.ErrTwoFactorInvalidCode.Error())
+	case errors.Is(err, domain.ErrTwoFactorAlreadyEnabled):
+		return huma.Error409Conflict(domain.ErrTwoFactorAlreadyEnabled.Error())
+	case errors.Is(err, domain.ErrTwoFactorNotEnabled):
+		return huma.Error409Conflict(domain.ErrTwoFactorNotEnabled.Error())
+	}
+
+	requestid.Logger(ctx, log).Error("auth.h."+operationID+" 오류", zap.Error(err))
+	return huma.Error500InternalServerError("2단계 인증 처리에 실패했습니다.")
+}
+
+// joinRoles 감사 로그에 기록할 권한 목록 입니다.
+func joinRoles(roles []domain.UserRole) string {
+	list := make([]string, 0, len(roles))
+	for _, role := range roles {
+		list = append(list, string(role))
+	}
+	return strings.Join(list, ",")
+}
+
+// RegisterTwoFactorHandler 2단계 인증(TOTP) Handler
+//
+// twoFactorUseCase 가 nil 이면 모든 API 가 404 를 응답합니다.
+func RegisterTwoFactorHandler(api huma.API, log *zap.Logger, twoFactorUseCase domain.TwoFactorUseCase, auditUseCase domain.AuditUseCase, m middleware.Middleware) {
+	v1 := huma.NewGroup(api, "/api/v1/auth")
+	v1Admin := huma.NewGroup(api, "/api/v1/admin")
+	disabled := func() error {
+		return huma.Error404NotFound("2단계 인증을 사용할 수 없습니다.")
+	}
+
+	// 2단계 인증 상태
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1AuthGetTwoFactorStatus",
+		Method:        http.MethodGet,
+		Path:          "/two-factor",
+		Summary:       "2단계 인증 상태 조회",
+		Description:   "2단계 인증 사용 여부, 남은 복구 코드 수, 권한에 2단계 인증이 필요한지 조회하는 API 입니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct{}) (*twoFactorStatusResponse, error) {
+		if twoFactorUseCase == nil {
+			return nil, disabled()
+		}
+		var resp twoFactorStatusResponse
+		userID, _ := ctx.Value("user_id").(string)
+		role, _ := ctx.Value("user_role").(domain.UserRole)
+
+		status, err := twoFactorUseCase.GetTwoFactorStatus(ctx, userID, role)
+		if err != nil {
+			return nil, twoFactorError(ctx, log, "v1AuthGetTwoFactorStatus", err)
+		}
+
+		resp.Body = status
+		return &resp, nil
+	})
+
+	// 2단계 인증 등록 시작
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1AuthBeginTwoFactorEnrollment",
+		Method:        http.MethodPost,
+		Path:          "/two-factor/enrollment",
+		Summary:       "2단계 인증 등록 시작",
+		Description:   "OTP 앱에 등록할 secret 과 QR 코드용 otpauth URI 를 생성하는 API 입니다. 등록 확인 API 로 인증 코드를 확인해야 사용되며, 확인 전에 다시 요청하면 이전 secret 은 사용할 수 없습니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusCreated,
+	}), func(ctx context.Context, i *struct{}) (*twoFactorEnrollmentResponse, error) {
+		if twoFactorUseCase == nil {
+			return nil, disabled()
+		}
+		var resp twoFactorEnrollmentResponse
+		userID, _ := ctx.Value("user_id").(string)
+
+		enrollment, err := twoFactorUseCase.BeginTwoFactorEnrollment(ctx, userID)
+		if err != nil {
+			return nil, twoFactorError(ctx, log, "v1AuthBeginTwoFactorEnrollment", err)
+		}
+
+		resp.Body = enrollment
+		return &resp, nil
+	})
+
+	// 2단계 인증 등록 확인
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1AuthConfirmTwoFactorEnrollment",
+		Method:        http.MethodPost,
+		Path:          "/two-factor/enrollment/confirm",
+		Summary:       "2단계 인증 등록 확인",
+		Description:   "OTP 앱의 인증 코드를 확인하고 2단계 인증을 사용하는 API 입니다. 응답의 복구 코드는 다시 조회할 수 없습니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *twoFactorCodeInput) (*recoveryCodesResponse, error) {
+		if twoFactorUseCase == nil {
+			return nil, disabled()
+		}
+		var resp recoveryCodesResponse
+		userID, _ := ctx.Value("user_id").(string)
+
+		codes, err := twoFactorUseCase.ConfirmTwoFactorEnrollment(ctx, userID, i.Body.Code)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionTwoFactorEnable,
+			TargetType: "user",
+			TargetID:   userID,
+		}, err)
+		if err != nil {
+			return nil, twoFactorError(ctx, log, "v1AuthConfirmTwoFactorEnrollment", err)
+		}
+
+		resp.Body.RecoveryCodes = codes
+		return &resp, nil
+	})
+
+	// 복구 코드 재생성
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1AuthRegenerateRecoveryCodes",
+		Method:        http.MethodPost,
+		Path:          "/two-factor/recovery-codes",
+		Summary:       "복구 코드 재생성",
+		Description:   "OTP 앱의 인증 코드를 확인하고 복구 코드를 새로 생성하는 API 입니다. 이전 복구 코드는 사용할 수 없습니다. 인증 코드가 반복해서 틀리면 2단계 인증 로그인과 함께 잠기며 429 와 Retry-After 헤더를 응답합니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *twoFactorCodeInput) (*recoveryCodesResponse, error) {
+		if twoFactorUseCase == nil {
+			return nil, disabled()
+		}
+		var resp recoveryCodesResponse
+		userID, _ := ctx.Value("user_id").(string)
+
+		codes, err := twoFactorUseCase.RegenerateRecoveryCodes(ctx, userID, i.Body.Code)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionRecoveryCodeRegenerate,
+			TargetType: "user",
+			TargetID:   userID,
+		}, err)
+		if err != nil {
+			return nil, twoFactorError(ctx, log, "v1AuthRegenerateRecoveryCodes", err)
+		}
+
+		resp.Body.RecoveryCodes = codes
+		return &resp, nil
+	})
+
+	// 2단계 인증 해제
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1AuthDisableTwoFactor",
+		Method:        http.MethodPost,
+		Path:          "/two-factor/disable",
+		Summary:       "2단계 인증 해제",
+		Description:   "OTP 앱의 인증 코드 혹은 복구 코드를 확인하고 2단계 인증을 해제하는 API 입니다. 인증 코드가 반복해서 틀리면 2단계 인증 로그인과 함께 잠기며 429 와 Retry-After 헤더를 응답합니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusNoContent,
+	}), func(ctx context.Context, i *struct {
+		Body struct {
+			Code string `json:"code,required" minLength:"6" doc:"OTP 앱의 6자리 인증 코드 혹은 복구 코드 입니다." example:"123456"`
+		}
+	}) (*struct{}, error) {
+		if twoFactorUseCase == nil {
+			return nil, disabled()
+		}
+		userID, _ := ctx.Value("user_id").(string)
+
+		err := twoFactorUseCase.DisableTwoFactor(ctx, userID, i.Body.Code)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionTwoFactorDisable,
+			TargetType: "user",
+			TargetID:   userID,
+		}, err)
+		if err != nil {
+			return nil, twoFactorError(ctx, log, "v1AuthDisableTwoFactor", err)
+		}
+
+		return nil, nil
+	})
+
+	// 2단계 인증 필수 권한 조회
+	huma.Register(v1Admin, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminGetTwoFactorRequiredRoles",
+		Method:        http.MethodGet,
+		Path:          "/two-factor/required-roles",
+		Summary:       "2단계 인증 필수 권한 조회",
+		Description:   "2단계 인증을 사용해야 하는 권한 목록 조회 API 입니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct{}) (*twoFactorRequiredRolesResponse, error) {
+		if twoFactorUseCase == nil {
+			return nil, disabled()
+		}
+		var resp twoFactorRequiredRolesResponse
+
+		roles, err := twoFactorUseCase.GetTwoFactorRequiredRoleList(ctx)
+		if err != nil {
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminGetTwoFactorRequiredRoles 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("2단계 인증 필수 권한 조회에 실패했습니다.")
+		}
+
+		resp.Body.Roles = roles
+		return &resp, nil
+	})
+
+	// 2단계 인증 필수 권한 변경
+	huma.Register(v1Admin, m.WithAdmin(huma.Operation{
+		OperationID:   "v1AdminSetTwoFactorRequiredRoles",
+		Method:        http.MethodPut,
+		Path:          "/two-factor/required-roles",
+		Summary:       "2단계 인증 필수 권한 변경",
+		Description:   "2단계 인증을 사용해야 하는 권한 목록을 교체하는 API 입니다. admin 을 포함하면 2단계 인증을 사용하지 않는 관리자는 관리자 API 에서 403 을 응답받으며, 변경하는 관리자는 먼저 2단계 인증을 사용해야 합니다.",
+		Tags:          []string{"Admin"},
+		DefaultStatus: http.StatusOK,
+	}), func(ctx context.Context, i *struct {
+		Body struct {
+			Roles []domain.UserRole `json:"roles,required" enum:"admin" doc:"2단계 인증이 필요한 권한 목록 입니다. 빈 목록이면 필수로 요구하지 않습니다."`
+		}
+	}) (*twoFactorRequiredRolesResponse, error) {
+		if twoFactorUseCase == nil {
+			return nil, disabled()
+		}
+		var resp twoFactorRequiredRolesResponse
+		adminID, _ := ctx.Value("user_id").(string)
+
+		err := twoFactorUseCase.SetTwoFactorRequiredRoleList(ctx, i.Body.Roles, adminID)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionTwoFactorRequiredRoles,
+			TargetType: "role",
+			TargetID:   joinRoles(i.Body.Roles),
+		}, err)
+		if err != nil {
+			if errors.Is(err, domain.ErrTwoFactorNotEnabled) {
+				return nil, huma.Error409Conflict("관리자 권한에 요구하려면 먼저 2단계 인증을 사용해야 합니다.")
+			}
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminSetTwoFactorRequiredRoles 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("2단계 인증 필수 권한 변경에 실패했습니다.")
+		}
+
+		resp.Body.Roles, err = twoFactorUseCase.GetTwoFactorRequiredRoleList(ctx)
+		if err != nil {
+			requestid.Logger(ctx, log).Error("admin.h.v1AdminSetTwoFactorRequiredRoles 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("2단계 인증 필수 권한 조회에 실패했습니다.")
+		}
+		return &resp, nil
+	})
+
+	log.Info("two factor Handler 등록")
+}
@@ -2,11 +2,19 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
 	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/GDH-Project/api/internal/authcookie"
 	"github.com/GDH-Project/api/internal/domain"
 	"github.com/GDH-Project/api/internal/middleware"
+	"github.com/GDH-Project/api/internal/requestid"
 	"github.com/danielgtaylor/huma/v2"
 	"go.uber.org/zap"
 )
@@ -17,16 +25,140 @@ type userResponse struct {
 		Name  string `json:"name" doc:"사용자 닉네임 입니다." example:"사용자1"`
 		Email string `json:"email" doc:"이메일 입니다." example:"example@example.com"`
 		Role  string `json:"role" doc:"사용자의 권한 입니다. 'user','device','admin'이 존재합니다." example:"user"`
+
+		EmailVerified *bool `json:"email_verified,omitempty" doc:"이메일 인증 여부 입니다. 이메일 인증이 활성화 된 경우 사용자 정보 조회에서 응답합니다."`
 	}
 }
 
 type tokenResponse struct {
+	Status    int
+	SetCookie []http.Cookie `header:"Set-Cookie" doc:"token_delivery=cookie 인 경우 토큰, CSRF 쿠키 입니다."`
+	Body      *domain.Token
+}
+
+// signInResponse 토큰(200, 204) 혹은 2단계 인증 challenge(202) 를 응답합니다.
+// 응답별 스키마는 signInResponses 로 지정합니다.
+type signInResponse struct {
+	Status    int
+	SetCookie []http.Cookie `header:"Set-Cookie" doc:"token_delivery=cookie 인 경우 토큰, CSRF 쿠키 입니다."`
+	Body      any
+}
+
+type accountDeletionResponse struct {
 	Status int
-	Body   domain.Token
+	Body   struct {
+		DataHandling domain.AccountDeletionMode   `json:"data_handling,omitempty" enum:"purge,anonymize" doc:"데이터 처리 방식 입니다."`
+		Status       domain.AccountDeletionStatus `json:"status,omitempty" enum:"pending,confirmed,completed" doc:"데이터 정리 상태 입니다. completed 가 아니면 서버에서 이어서 정리합니다."`
+	}
+}
+
+type signOutResponse struct {
+	Status    int
+	SetCookie []http.Cookie `header:"Set-Cookie" doc:"쿠키 인증이 활성화 된 경우 토큰, CSRF 쿠키를 삭제합니다."`
+}
+
+// newTokenResponse token_delivery 에 따라 응답 본문 혹은 쿠키로 토큰을 전달합니다.
+//
+// 쿠키로 전달하는 경우 스크립트에서 토큰에 접근할 수 없도록 본문에는 포함하지 않는다.
+func newTokenResponse(token *domain.Token, delivery string, cookie *authcookie.Options) (*tokenResponse, error) {
+	var resp tokenResponse
+	if delivery != "cookie" {
+		resp.Body = token
+		return &resp, nil
+	}
+
+	cookies, err := cookie.TokenCookies(token)
+	if err != nil {
+		return nil, huma.Error500InternalServerError("쿠키 생성에 실패했습니다.")
+	}
+	resp.Status = http.StatusNoContent
+	resp.SetCookie = cookies
+	return &resp, nil
+}
+
+// newSignInResponse 2단계 인증이 필요하면 202 와 challenge 를, 아니면 token_delivery 에 따라 토큰을 응답합니다.
+func newSignInResponse(token *domain.Token, challenge *domain.TwoFactorChallenge, delivery string, cookie *authcookie.Options) (*signInResponse, error) {
+	if challenge != nil {
+		return &signInResponse{Status: http.StatusAccepted, Body: challenge}, nil
+	}
+
+	tokenResp, err := newTokenResponse(token, delivery, cookie)
+	if err != nil {
+		return nil, err
+	}
+	resp := &signInResponse{Status: tokenResp.Status, SetCookie: tokenResp.SetCookie}
+	if tokenResp.Body != nil {
+		resp.Body = tokenResp.Body
+	}
+	return resp, nil
+}
+
+// signInResponses 로그인 API 의 응답 스키마 입니다.
+func signInResponses(api huma.API) map[string]*huma.Response {
+	registry := api.OpenAPI().Components.Schemas
+	return map[string]*huma.Response{
+		"200": {
+			Description: "로그인 성공",
+			Content: map[string]*huma.MediaType{
+				"application/json": {Schema: registry.Schema(reflect.TypeFor[domain.Token](), true, "Token")},
+			},
+		},
+		"202": {
+			Description: "2단계 인증이 필요합니다. challenge_token 과 인증 코드로 2단계 인증 로그인 API 를 호출해야 합니다.",
+			Content: map[string]*huma.MediaType{
+				"application/json": {Schema: registry.Schema(reflect.TypeFor[domain.TwoFactorChallenge](), true, "TwoFactorChallenge")},
+			},
+		},
+		"204": {Description: "token_delivery=cookie 인 경우 토큰을 쿠키로 전달합니다."},
+	}
+}
+
+// auditSignIn 2단계 인증이 필요한 로그인은 로그인 성공 대신 challenge 발급으로 기록합니다.
+func auditSignIn(ctx context.Context, log *zap.Logger, auditUseCase domain.AuditUseCase, targetType string, targetID string, err error) *domain.TwoFactorChallenge {
+	event := &domain.AuditEvent{
+		Action:     domain.AuditActionSignIn,
+		TargetType: targetType,
+		TargetID:   targetID,
+	}
+
+	var twoFactorErr *domain.TwoFactorRequiredError
+	if errors.As(err, &twoFactorErr) {
+		event.Action = domain.AuditActionTwoFactorChallenge
+		event.ActorID = twoFactorErr.Challenge.UserID
+		recordAudit(ctx, log, auditUseCase, event, nil)
+		return twoFactorErr.Challenge
+	}
+
+	recordAudit(ctx, log, auditUseCase, event, err)
+	return nil
+}
+
+// signInError 로그인 잠금, 계정 정지, 인증 서버 장애를 응답으로 변환합니다. 해당하지 않으면 nil 을 반환합니다.
+func signInError(err error) error {
+	var lockedErr *domain.SignInLockedError
+	if errors.As(err, &lockedErr) {
+		retryAfter := strconv.Itoa(int(math.Ceil(lockedErr.RetryAfter.Seconds())))
+		return huma.ErrorWithHeaders(
+			huma.Error429TooManyRequests("로그인 시도가 너무 많습니다. 잠시 후 다시 시도해주세요."),
+			http.Header{"Retry-After": {retryAfter}},
+		)
+	}
+	if errors.Is(err, domain.ErrUserSuspended) {
+		return huma.Error403Forbidden(domain.ErrUserSuspended.Error())
+	}
+	if errors.Is(err, domain.ErrUnavailable) {
+		return huma.Error503ServiceUnavailable("잠시 후 다시 시도해주세요.")
+	}
+
+	return nil
 }
 
 // RegisterAuthHandler 인증 및 유저 관련 Handler
-func RegisterAuthHandler(api huma.API, log *zap.Logger, authUseCase domain.AuthUseCase, userUseCase domain.UserUseCase, m middleware.Middleware) {
+//
+// cookie 가 nil 이면 쿠키 인증(token_delivery=cookie)을, oauthUseCase 가 nil 이면 소셜 로그인을 사용할 수 없고,
+// 소셜 로그인의 state 는 oauthCookie 의 state 쿠키와 비교하며,
+// emailUseCase 가 nil 이면 가입시 인증 메일을 발송하지 않으며, accountDeletionUseCase 가 nil 이면 탈퇴시 데이터를 정리하지 않습니다.
+func RegisterAuthHandler(api huma.API, log *zap.Logger, authUseCase domain.AuthUseCase, userUseCase domain.UserUseCase, accountDeletionUseCase domain.AccountDeletionUseCase, oauthUseCase domain.OAuthUseCase, emailUseCase domain.EmailUseCase, auditUseCase domain.AuditUseCase, cookie *authcookie.Options, oauthCookie *authcookie.Options, m middleware.Middleware) {
 	v1 := huma.NewGroup(api, "/api/v1")
 
 	// 회원 가입
@@ -35,7 +167,7 @@ func RegisterAuthHandler(api huma.API, log *zap.Logger, authUseCase domain.AuthU
 		Method:        http.MethodPost,
 		Path:          "/auth/sign-up",
 		Summary:       "회원 가입",
-		Description:   "회원 가입 API 입니다.",
+		Description:   "회원 가입 API 입니다. 이메일 인증이 활성화 된 경우 가입한 이메일로 인증 메일을 발송합니다.",
 		Tags:          []string{"Auth"},
 		DefaultStatus: http.StatusCreated,
 	}, func(ctx context.Context, i *struct {
@@ -52,11 +184,27 @@ func RegisterAuthHandler(api huma.API, log *zap.Logger, authUseCase domain.AuthU
 			Password: i.Body.Password,
 			Role:     domain.ParseStringRoleToUserRole(i.Body.Role),
 		})
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionSignUp,
+			TargetType: "user",
+			TargetID:   i.Body.Email,
+		}, err)
 
 		if err != nil {
 			return nil, huma.Error400BadRequest("사용자 생성에 실패했습니다.", err)
 		}
 
+		// 인증 메일 발송에 실패해도 가입은 완료되며, 인증 메일 재발송 API 로 다시 요청할 수 있다.
+		if emailUseCase != nil {
+			u, err := userUseCase.GetUserInfoByEmail(ctx, i.Body.Email)
+			if err == nil {
+				err = emailUseCase.SendEmailVerification(ctx, u.ID)
+			}
+			if err != nil {
+				requestid.Logger(ctx, log).Warn("가입 인증 메일 발송 실패", zap.Error(err), zap.String("email", i.Body.Email))
+			}
+		}
+
 		return nil, nil
 	})
 
@@ -66,30 +214,130 @@ func RegisterAuthHandler(api huma.API, log *zap.Logger, authUseCase domain.AuthU
 		Method:        http.MethodPost,
 		Path:          "/auth/sign-in",
 		Summary:       "로그인",
-		Description:   "로그인 API 입니다.",
+		Description:   "로그인 API 입니다. type=password 는 email, password 가 필요하고, 소셜 로그인(type=google,kakao,naver)은 인가 URL 조회 API 로 받은 인가 코드(code)와 state 가 필요합니다. 소셜 계정과 연결된 사용자가 없으면 같은 이메일의 소셜 계정에 연결하거나 새로 가입하며, 같은 이메일로 비밀번호 가입한 사용자가 있으면 409 를 응답합니다. 실패가 반복되면 이메일, IP 별로 다음 시도가 지연되거나 일정 시간 잠기며 429 와 Retry-After 헤더를 응답합니다. token_delivery=cookie 인 경우 토큰을 HttpOnly 쿠키로 전달하며 204 를 응답합니다. 2단계 인증을 사용하는 사용자는 토큰 대신 challenge_token 과 202 를 응답하며, 2단계 인증 로그인 API 로 인증 코드를 확인해야 토큰을 받을 수 있습니다.",
 		Tags:          []string{"Auth"},
 		DefaultStatus: http.StatusOK,
+		Responses:     signInResponses(api),
 	}, func(ctx context.Context, i *struct {
-		Type string `query:"type" enum:"password" default:"password" doc:"로그인 구분 입니다."`
-		Body struct {
-			Email    string `json:"email,required" format:"email"`
-			Password string `json:"password,required" minLength:"8" format:"password"`
+		Type          string `query:"type" enum:"password,google,kakao,naver" default:"password" doc:"로그인 구분 입니다."`
+		TokenDelivery string `query:"token_delivery" enum:"body,cookie" default:"body" doc:"토큰 전달 방식 입니다. cookie 는 서버에서 쿠키 인증이 활성화 된 경우에만 사용할 수 있습니다."`
+		StateCookie   string `cookie:"gdh_oauth_state" doc:"소셜 로그인 인가 URL 조회 API 가 설정한 state 쿠키 입니다."`
+		Body          struct {
+			Email    string `json:"email,omitempty" format:"email" doc:"type=password 인 경우 필수 입니다."`
+			Password string `json:"password,omitempty" minLength:"8" format:"password" doc:"type=password 인 경우 필수 입니다."`
+			Code     string `json:"code,omitempty" doc:"소셜 로그인 인가 코드 입니다."`
+			State    string `json:"state,omitempty" doc:"소셜 로그인 인가 요청의 state 입니다."`
 		}
-	}) (*tokenResponse, error) {
-		var resp tokenResponse
+	}) (*signInResponse, error) {
+		if i.TokenDelivery == "cookie" && cookie == nil {
+			return nil, huma.Error400BadRequest("쿠키 인증을 사용할 수 없습니다.")
+		}
+
 		// ?type=password 인 경우
 		if strings.EqualFold(i.Type, "password") {
+			if i.Body.Email == "" || i.Body.Password == "" {
+				return nil, huma.Error400BadRequest("email, password 는 필수 입니다.")
+			}
+
 			token, err := authUseCase.Login(ctx, i.Body.Email, i.Body.Password)
+			if challenge := auditSignIn(ctx, log, auditUseCase, "user", i.Body.Email, err); challenge != nil {
+				return newSignInResponse(nil, challenge, i.TokenDelivery, cookie)
+			}
 			if err != nil {
+				if resp := signInError(err); resp != nil {
+					return nil, resp
+				}
 				return nil, huma.Error400BadRequest("id 혹은 패스워드를 확인해주세요")
 			}
-			resp.Body = *token
 
-			return &resp, nil
+			return newSignInResponse(token, nil, i.TokenDelivery, cookie)
+		}
+
+		// 소셜 로그인인 경우
+		if oauthUseCase != nil && slices.Contains(oauthUseCase.GetOAuthProviderList(), i.Type) {
+			if i.Body.Code == "" || i.Body.State == "" {
+				return nil, huma.Error400BadRequest("code, state 는 필수 입니다.")
+			}
+
+			// 다른 브라우저에서 시작된 인가 요청의 code, state 로는 로그인할 수 없다.
+			var err error
+			if !authcookie.ValidState(i.StateCookie, i.Body.State) {
+				err = fmt.Errorf("%w: state 쿠키 불일치", domain.ErrOAuthInvalidRequest)
+			}
+
+			var token *domain.Token
+			if err == nil {
+				token, err = oauthUseCase.OAuthLogin(ctx, i.Type, i.Body.Code, i.Body.State)
+			}
+			challenge := auditSignIn(ctx, log, auditUseCase, "oauth", i.Type, err)
+			if err != nil && challenge == nil {
+				if resp := signInError(err); resp != nil {
+					return nil, resp
+				}
+				if errors.Is(err, domain.ErrOAuthEmailConflict) {
+					return nil, huma.Error409Conflict(domain.ErrOAuthEmailConflict.Error())
+				}
+				if errors.Is(err, domain.ErrOAuthInvalidRequest) {
+					return nil, huma.Error400BadRequest(domain.ErrOAuthInvalidRequest.Error())
+				}
+				requestid.Logger(ctx, log).Error("auth.h.v1AuthSignIn 소셜 로그인 오류", zap.Error(err), zap.String("provider", i.Type))
+				return nil, huma.Error500InternalServerError("소셜 로그인에 실패했습니다.")
+			}
+
+			// state 는 한번만 사용할 수 있으므로 쿠키를 삭제한다.
+			resp, err := newSignInResponse(token, challenge, i.TokenDelivery, cookie)
+			if err != nil {
+				return nil, err
+			}
+			resp.SetCookie = append(resp.SetCookie, oauthCookie.ClearStateCookie())
+			return resp, nil
+		}
+
+		// 설정되지 않은 소셜 로그인인 경우
+		return nil, huma.Error400BadRequest(domain.ErrOAuthProviderNotFound.Error())
+	})
+
+	// 2단계 인증 로그인
+	huma.Register(v1, huma.Operation{
+		OperationID:   "v1AuthSignInTwoFactor",
+		Method:        http.MethodPost,
+		Path:          "/auth/sign-in/two-factor",
+		Summary:       "2단계 인증 로그인",
+		Description:   "로그인 API 가 202 로 응답한 challenge_token 과 OTP 앱의 인증 코드 혹은 복구 코드로 로그인을 완료하는 API 입니다. 인증 코드가 여러 번 틀리거나 challenge_token 이 만료되면 401 을 응답하며 다시 로그인해야 합니다. 틀린 인증 코드는 challenge 와 관계없이 사용자별로 집계되어 반복되면 일정 시간 잠기며, 잠긴 동안에는 로그인과 2단계 인증 모두 429 와 Retry-After 헤더를 응답합니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusOK,
+	}, func(ctx context.Context, i *struct {
+		TokenDelivery string `query:"token_delivery" enum:"body,cookie" default:"body" doc:"토큰 전달 방식 입니다. cookie 는 서버에서 쿠키 인증이 활성화 된 경우에만 사용할 수 있습니다."`
+		Body          struct {
+			ChallengeToken string `json:"challenge_token,required" doc:"로그인 API 가 응답한 challenge_token 입니다."`
+			Code           string `json:"code,required" minLength:"6" doc:"OTP 앱의 6자리 인증 코드 혹은 복구 코드 입니다." example:"123456"`
+		}
+	}) (*tokenResponse, error) {
+		if i.TokenDelivery == "cookie" && cookie == nil {
+			return nil, huma.Error400BadRequest("쿠키 인증을 사용할 수 없습니다.")
+		}
+
+		token, err := authUseCase.CompleteTwoFactorSignIn(ctx, i.Body.ChallengeToken, i.Body.Code)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionSignIn,
+			TargetType: "two_factor",
+		}, err)
+		if err != nil {
+			// 시도 횟수를 초과한 경우 인증 코드 오류도 함께 감싸므로 challenge 오류를 먼저 확인한다.
+			if errors.Is(err, domain.ErrTwoFactorChallengeInvalid) {
+				return nil, huma.Error401Unauthorized(domain.ErrTwoFactorChallengeInvalid.Error())
+			}
+			if errors.Is(err, domain.ErrTwoFactorInvalidCode) {
+				return nil, huma.Error400BadRequest(domain.ErrTwoFactorInvalidCode.Error())
+			}
+			if resp := signInError(err); resp != nil {
+				return nil, resp
+			}
+			requestid.Logger(ctx, log).Error("auth.h.v1AuthSignInTwoFactor 오류", zap.Error(err))
+			return nil, huma.Error500InternalServerError("로그인에 실패했습니다.")
 		}
 
-		// type 이 잘못된 경우
-		return nil, huma.Error400BadRequest("잘못된 접근 입니다.")
+		return newTokenResponse(token, i.TokenDelivery, cookie)
 	})
 
 	// 토큰 재발급
@@ -98,21 +346,98 @@ func RegisterAuthHandler(api huma.API, log *zap.Logger, authUseCase domain.AuthU
 		Method:        http.MethodPost,
 		Path:          "/auth/refresh",
 		Summary:       "토큰 재발급",
-		Description:   "토큰 재발급 API 입니다.",
+		Description:   "토큰 재발급 API 입니다. 본문에 refresh_token 이 없으면 쿠키의 refresh token 을 사용하며, 이 경우 X-CSRF-Token 헤더가 필요하고 토큰을 쿠키로 전달하며 204 를 응답합니다.",
 		Tags:          []string{"Auth"},
 		DefaultStatus: http.StatusCreated,
 	}, func(ctx context.Context, i *struct {
-		Body struct {
-			RefreshToken string `json:"refresh_token"`
+		TokenDelivery string `query:"token_delivery" enum:"body,cookie" default:"body" doc:"토큰 전달 방식 입니다. 쿠키의 refresh token 을 사용하면 항상 cookie 입니다."`
+		RefreshCookie string `cookie:"gdh_refresh_token" doc:"쿠키 인증시 리프레시 토큰 입니다."`
+		CSRFCookie    string `cookie:"gdh_csrf_token" doc:"쿠키 인증시 CSRF 토큰 입니다."`
+		CSRFToken     string `header:"X-CSRF-Token" doc:"쿠키 인증시 gdh_csrf_token 쿠키와 같은 값 입니다."`
+		Body          *struct {
+			RefreshToken string `json:"refresh_token,omitempty"`
 		}
 	}) (*tokenResponse, error) {
-		var resp tokenResponse
-		token, err := authUseCase.RefreshToken(ctx, i.Body.RefreshToken)
+		if i.TokenDelivery == "cookie" && cookie == nil {
+			return nil, huma.Error400BadRequest("쿠키 인증을 사용할 수 없습니다.")
+		}
+
+		var refreshToken string
+		if i.Body != nil {
+			refreshToken = i.Body.RefreshToken
+		}
+		delivery := i.TokenDelivery
+		if refreshToken == "" && cookie != nil && i.RefreshCookie != "" {
+			if !authcookie.ValidCSRF(i.CSRFCookie, i.CSRFToken) {
+				return nil, huma.Error403Forbidden("CSRF 토큰이 유효하지 않습니다.")
+			}
+			refreshToken = i.RefreshCookie
+			delivery = "cookie"
+		}
+		if refreshToken == "" {
+			return nil, huma.Error400BadRequest("refresh_token 은 필수 입니다.")
+		}
+
+		token, err := authUseCase.RefreshToken(ctx, refreshToken)
 		if err != nil {
 			return nil, huma.Error401Unauthorized("토큰이 유효하지 않습니다.")
 		}
 
-		resp.Body = *token
+		return newTokenResponse(token, delivery, cookie)
+	})
+
+	// 로그아웃
+	huma.Register(v1, m.WithAuth(huma.Operation{
+		OperationID:   "v1AuthSignOut",
+		Method:        http.MethodPost,
+		Path:          "/auth/sign-out",
+		Summary:       "로그아웃",
+		Description:   "로그아웃 API 입니다. 현재 access token 을 폐기하며 refresh_token 을 보내면(쿠키 포함) 함께 폐기합니다. all_sessions 가 true 이면 지금까지 발급된 사용자의 모든 토큰을 폐기합니다.",
+		Tags:          []string{"Auth"},
+		DefaultStatus: http.StatusNoContent,
+	}), func(ctx context.Context, i *struct {
+		RefreshCookie string `cookie:"gdh_refresh_token" doc:"쿠키 인증시 리프레시 토큰 입니다."`
+		Body          *struct {
+			RefreshToken string `json:"refresh_token,omitempty" doc:"함께 폐기할 리프레시 토큰 입니다." example:"refresh_token"`
+			AllSessions  bool   `json:"all_sessions,omitempty" default:"false" doc:"모든 기기의 세션을 로그아웃 합니다."`
+		}
+	}) (*signOutResponse, error) {
+		var resp signOutResponse
+		userID, _ := ctx.Value("user_id").(string)
+		accessToken, _ := ctx.Value("access_token").(string)
+
+		var refreshToken string
+		var allSessions bool
+		if i.Body != nil {
+			refreshToken = i.Body.RefreshToken
+			allSessions = i.Body.AllSessions
+		}
+		if refreshToken == "" && cookie != nil {
+			refreshToken = i.RefreshCookie
+		}
+
+		err := authUseCase.SignOut(ctx, userID, accessToken, refreshToken, allSessions)
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionSignOut,
+			TargetType: "user",
+			TargetID:   userID,
+		}, err)
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrInvalidParam):
+				return nil, huma.Error400BadRequest("로그아웃할 수 없는 refresh token 입니다.")
+			case errors.Is(err, domain.ErrNotFound):
+				return nil, huma.Error404NotFound("존재하지 않는 사용자 입니다.")
+			case errors.Is(err, domain.ErrUnavailable):
+				return nil, huma.Error503ServiceUnavailable("일시적으로 로그아웃할 수 없습니다. 잠시 후 다시 시도해주세요.")
+			}
+			return nil, huma.Error500InternalServerError("로그아웃에 실패했습니다.")
+		}
+
+		resp.Status = http.StatusNoContent
+		if cookie != nil {
+			resp.SetCookie = cookie.ClearCookies()
+		}
 		return &resp, nil
 	})
 
@@ -138,6 +463,15 @@ func RegisterAuthHandler(api huma.API, log *zap.Logger, authUseCase domain.AuthU
 		resp.Body.Email = u.Email
 		resp.Body.Role = string(u.Role)
 
+		if emailUseCase != nil {
+			verified, err := emailUseCase.IsEmailVerified(ctx, userId)
+			if err != nil {
+				requestid.Logger(ctx, log).Warn("이메일 인증 여부 조회 실패", zap.Error(err), zap.String("user_id", userId))
+			} else {
+				resp.Body.EmailVerified = &verified
+			}
+		}
+
 		return &resp, nil
 	})
 
@@ -164,6 +498,15 @@ func RegisterAuthHandler(api huma.API, log *zap.Logger, authUseCase domain.AuthU
 			Name:     i.Body.Name,
 			Password: i.Body.Password,
 		})
+		action := domain.AuditActionUserUpdate
+		if i.Body.Password != "" {
+			action = domain.AuditActionPasswordChange
+		}
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     action,
+			TargetType: "user",
+			TargetID:   userID,
+		}, err)
 		if err != nil {
 			return nil, huma.Error400BadRequest(err.Error())
 		}
@@ -181,22 +524,39 @@ func RegisterAuthHandler(api huma.API, log *zap.Logger, authUseCase domain.AuthU
 		Method:        http.MethodPost,
 		Path:          "/user/delete",
 		Summary:       "회원 탈퇴",
-		Description:   "회원 탈퇴 API 입니다.",
+		Description:   "회원 탈퇴 API 입니다. 탈퇴가 완료되면 모든 토큰을 폐기하고 사용자의 데이터를 정리합니다. data_handling=purge 는 모두 삭제하고, anonymize 는 인증 정보는 삭제하되 로그인 세션, 정지 이력을 사용자를 알 수 없게 남깁니다. 데이터 정리에 실패해도 탈퇴는 완료되며 서버에서 재시도 합니다.",
 		Tags:          []string{"Auth"},
 		DefaultStatus: http.StatusOK,
 	}), func(ctx context.Context, i *struct {
 		Body struct {
-			Password string `json:"password" minLength:"8" format:"password" doc:"사용자 비밀번호 입니다." example:"password"`
+			Password     string `json:"password" minLength:"8" format:"password" doc:"사용자 비밀번호 입니다." example:"password"`
+			DataHandling string `json:"data_handling,omitempty" enum:"purge,anonymize" doc:"탈퇴 후 데이터 처리 방식 입니다. 없으면 서버 설정(기본값 purge)을 사용합니다."`
 		}
-	}) (*struct{}, error) {
+	}) (*accountDeletionResponse, error) {
 		userID, _ := ctx.Value("user_id").(string)
 
-		err := userUseCase.DeleteUser(ctx, userID, i.Body.Password)
+		var deletion *domain.AccountDeletion
+		var err error
+		if accountDeletionUseCase != nil {
+			deletion, err = accountDeletionUseCase.DeleteAccount(ctx, userID, i.Body.Password, domain.AccountDeletionMode(i.Body.DataHandling))
+		} else {
+			err = userUseCase.DeleteUser(ctx, userID, i.Body.Password)
+		}
+		recordAudit(ctx, log, auditUseCase, &domain.AuditEvent{
+			Action:     domain.AuditActionUserDelete,
+			TargetType: "user",
+			TargetID:   userID,
+		}, err)
 		if err != nil {
 			return nil, huma.Error500InternalServerError(err.Error())
 		}
 
-		return nil, nil
+		var resp accountDeletionResponse
+		if deletion != nil {
+			resp.Body.DataHandling = deletion.Mode
+			resp.Body.Status = deletion.Status
+		}
+		return &resp, nil
 	})
 
 	// 이메일 혹은 닉네임이 사용중인지 확인
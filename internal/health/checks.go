@@ -0,0 +1,75 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// PostgresCheck pgxpool.Ping 으로 DB 연결을 확인합니다.
+func PostgresCheck(db *pgxpool.Pool) Check {
+	return Check{
+		Name: "postgres",
+		Fn: func(ctx context.Context) error {
+			return db.Ping(ctx)
+		},
+	}
+}
+
+// GrpcConnCheck gRPC ClientConn 의 연결 상태를 확인합니다.
+//
+// Idle 상태인 경우 연결을 시도하고, Ready 가 될 때까지 ctx 제한시간 동안 대기합니다.
+func GrpcConnCheck(name string, conn *grpc.ClientConn) Check {
+	return Check{
+		Name: name,
+		Fn: func(ctx context.Context) error {
+			state := conn.GetState()
+			if state == connectivity.Idle {
+				conn.Connect()
+			}
+
+			for state = conn.GetState(); state != connectivity.Ready; state = conn.GetState() {
+				if state == connectivity.Shutdown {
+					return fmt.Errorf("gRPC 연결이 종료되었습니다: %s", state)
+				}
+				if !conn.WaitForStateChange(ctx, state) {
+					return fmt.Errorf("gRPC 연결 상태가 Ready 가 아닙니다: %s", state)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// GrpcHealthCheck 표준 gRPC health 서비스(grpc.health.v1)로 서버 상태를 확인합니다.
+//
+// 서버가 health 서비스를 구현하지 않은 경우(Unimplemented) 성공으로 처리합니다.
+func GrpcHealthCheck(name string, conn *grpc.ClientConn) Check {
+	client := healthpb.NewHealthClient(conn)
+
+	return Check{
+		Name: name,
+		Fn: func(ctx context.Context) error {
+			resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+			if err != nil {
+				if status.Code(err) == codes.Unimplemented {
+					return nil
+				}
+				return err
+			}
+
+			if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+				return fmt.Errorf("gRPC health 상태가 SERVING 이 아닙니다: %s", resp.GetStatus())
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,120 @@
+package iplocation
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"strings"
+)
+
+// PrivateNetwork 사설망, loopback 주소의 위치 입니다.
+const PrivateNetwork = "내부망"
+
+// Locator
+//
+// IP 대역(CIDR)별 위치 목록으로 IP 의 대략적인 위치를 찾습니다.
+// 대역이 겹치면 가장 좁은 대역의 위치를 사용합니다.
+type Locator struct {
+	// prefixes prefix 길이별 대역 입니다.
+	prefixes map[int]map[netip.Prefix]string
+}
+
+// New 빈 Locator 를 생성합니다. 사설망, loopback 주소만 찾을 수 있습니다.
+func New() *Locator {
+	return &Locator{prefixes: make(map[int]map[netip.Prefix]string)}
+}
+
+// Load
+//
+// "대역,위치" 형식의 CSV 파일을 읽습니다. # 으로 시작하는 줄은 무시합니다.
+//
+//	1.208.0.0/12,대한민국 서울
+//	2001:2d8::/32,대한민국
+func Load(path string) (*Locator, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	l := New()
+
+	r := csv.NewReader(f)
+	r.Comment = '#'
+	r.FieldsPerRecord = 2
+	r.TrimLeadingSpace = true
+
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(record[0]))
+		if err != nil {
+			line, _ := r.FieldPos(0)
+			return nil, fmt.Errorf("%d 번째 줄: %w", line, err)
+		}
+		l.Add(prefix, strings.TrimSpace(record[1]))
+	}
+
+	return l, nil
+}
+
+// Add 대역의 위치를 추가합니다.
+func (l *Locator) Add(prefix netip.Prefix, location string) {
+	prefix = prefix.Masked()
+	if prefix.Addr().Is4In6() {
+		prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+	}
+
+	if l.prefixes[prefix.Bits()] == nil {
+		l.prefixes[prefix.Bits()] = make(map[netip.Prefix]string)
+	}
+	l.prefixes[prefix.Bits()][prefix] = location
+}
+
+// Len 등록된 대역 수를 반환합니다.
+func (l *Locator) Len() int {
+	var n int
+	for _, p := range l.prefixes {
+		n += len(p)
+	}
+	return n
+}
+
+// Lookup IP 의 위치를 반환합니다. 알 수 없으면 빈 문자열을 반환합니다.
+func (l *Locator) Lookup(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return ""
+	}
+	addr = addr.Unmap()
+
+	if addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() {
+		return PrivateNetwork
+	}
+
+	for bits := addr.BitLen(); bits >= 0; bits-- {
+		p, ok := l.prefixes[bits]
+		if !ok {
+			continue
+		}
+
+		prefix, err := addr.Prefix(bits)
+		if err != nil {
+			return ""
+		}
+		if location, ok := p[prefix]; ok {
+			return location
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,99 @@
+package iplocation
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	l := New()
+	l.Add(netip.MustParsePrefix("1.208.0.0/12"), "대한민국 서울")
+	l.Add(netip.MustParsePrefix("1.208.0.0/16"), "대한민국 서울 강남구")
+	l.Add(netip.MustParsePrefix("2001:2d8::/32"), "대한민국")
+	// IPv4-mapped IPv6 대역은 IPv4 대역으로 저장
+	l.Add(netip.MustParsePrefix("::ffff:8.8.8.0/120"), "미국")
+
+	tests := []struct {
+		name string
+		ip   string
+		want string
+	}{
+		{name: "넓은 대역", ip: "1.220.1.1", want: "대한민국 서울"},
+		{name: "좁은 대역 우선", ip: "1.208.10.1", want: "대한민국 서울 강남구"},
+		{name: "IPv6", ip: "2001:2d8:1234::1", want: "대한민국"},
+		{name: "IPv4-mapped 대역", ip: "8.8.8.8", want: "미국"},
+		{name: "IPv4-mapped 주소", ip: "::ffff:1.220.1.1", want: "대한민국 서울"},
+		{name: "사설망", ip: "192.168.0.10", want: PrivateNetwork},
+		{name: "loopback", ip: "127.0.0.1", want: PrivateNetwork},
+		{name: "IPv6 loopback", ip: "::1", want: PrivateNetwork},
+		{name: "link local", ip: "fe80::1", want: PrivateNetwork},
+		{name: "알 수 없음", ip: "9.9.9.9", want: ""},
+		{name: "형식 오류", ip: "not-an-ip", want: ""},
+		{name: "빈 값", ip: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := l.Lookup(tt.ip); got != tt.want {
+				t.Errorf("Lookup(%q) = %q, want %q", tt.ip, got, tt.want)
+			}
+		})
+	}
+
+	if got := l.Len(); got != 4 {
+		t.Errorf("Len() = %d, want 4", got)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantLen int
+		wantErr bool
+	}{
+		{
+			name:    "정상",
+			content: "# 대역,위치\n1.208.0.0/12,대한민국 서울\n 2001:2d8::/32 , 대한민국\n",
+			wantLen: 2,
+		},
+		{
+			name:    "빈 파일",
+			content: "",
+			wantLen: 0,
+		},
+		{
+			name:    "대역 형식 오류",
+			content: "1.208.0.0/12,대한민국 서울\n1.208.0.0,대한민국\n",
+			wantErr: true,
+		},
+		{
+			name:    "열 수 오류",
+			content: "1.208.0.0/12,대한민국,서울\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "ip.csv")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			l, err := Load(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && l.Len() != tt.wantLen {
+				t.Errorf("Len() = %d, want %d", l.Len(), tt.wantLen)
+			}
+		})
+	}
+
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.csv")); err == nil {
+		t.Error("Load(missing) error = nil")
+	}
+}
@@ -0,0 +1,121 @@
+// Package job 서버와 함께 시작하고 종료되는 주기 작업을 실행합니다.
+package job
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type job struct {
+	name     string
+	interval time.Duration
+	fn       func(ctx context.Context) error
+}
+
+// Runner
+//
+// 서비스는 생성시 Every 로 작업을 등록하고, 서버가 Start 로 시작한 뒤 종료시 context 를 취소하고 Wait 로 기다립니다.
+type Runner struct {
+	log *zap.Logger
+
+	mu      sync.Mutex
+	jobs    []*job
+	started bool
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+// Every interval 마다 fn 을 실행합니다. interval 이 0 이하면 등록하지 않습니다.
+//
+// fn 은 ctx 가 취소되면 가능한 빨리 반환해야 하며, 오류는 작업 이름과 함께 로그로 남깁니다.
+func (r *Runner) Every(name string, interval time.Duration, fn func(ctx context.Context) error) {
+	if interval <= 0 {
+		r.log.Info("주기 작업을 실행하지 않습니다", zap.String("job", name))
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.started {
+		panic(fmt.Sprintf("job: Start 이후에 등록할 수 없습니다 (%s)", name))
+	}
+	r.jobs = append(r.jobs, &job{name: name, interval: interval, fn: fn})
+}
+
+// Start ctx 가 취소될 때까지 등록된 작업을 실행합니다. Wait 이후에는 실행하지 않습니다.
+func (r *Runner) Start(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.started || r.stopped {
+		return
+	}
+	r.started = true
+
+	for _, j := range r.jobs {
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			r.loop(ctx, j)
+		}()
+	}
+	r.log.Info("주기 작업 시작", zap.Int("jobs", len(r.jobs)))
+}
+
+// Wait 실행 중인 작업이 끝날 때까지 기다립니다. ctx 가 끝나면 기다리지 않고 반환합니다.
+//
+// 작업은 Start 에 전달한 ctx 를 취소하여 중단합니다.
+func (r *Runner) Wait(ctx context.Context) error {
+	r.mu.Lock()
+	r.stopped = true
+	r.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *Runner) loop(ctx context.Context, j *job) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.run(ctx, j)
+		}
+	}
+}
+
+// run 작업 하나를 실행합니다. 작업이 panic 이 발생해도 다음 주기에 다시 실행합니다.
+func (r *Runner) run(ctx context.Context, j *job) {
+	defer func() {
+		if v := recover(); v != nil {
+			r.log.Error("주기 작업 panic", zap.String("job", j.name), zap.Any("panic", v), zap.Stack("stack"))
+		}
+	}()
+
+	if err := j.fn(ctx); err != nil && ctx.Err() == nil {
+		r.log.Warn("주기 작업 실패", zap.String("job", j.name), zap.Error(err))
+	}
+}
+
+func NewRunner(log *zap.Logger) *Runner {
+	return &Runner{
+		log: log,
+	}
+}
@@ -0,0 +1,127 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestRunner(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(calls *atomic.Int32) func(ctx context.Context) error
+	}{
+		{
+			name: "정상",
+			fn: func(calls *atomic.Int32) func(ctx context.Context) error {
+				return func(context.Context) error { calls.Add(1); return nil }
+			},
+		},
+		{
+			name: "오류가 발생해도 계속 실행",
+			fn: func(calls *atomic.Int32) func(ctx context.Context) error {
+				return func(context.Context) error { calls.Add(1); return errors.New("실패") }
+			},
+		},
+		{
+			name: "panic 이 발생해도 계속 실행",
+			fn: func(calls *atomic.Int32) func(ctx context.Context) error {
+				return func(context.Context) error { calls.Add(1); panic("panic") }
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRunner(zap.NewNop())
+			var calls atomic.Int32
+			r.Every("test", time.Millisecond, tt.fn(&calls))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			r.Start(ctx)
+
+			deadline := time.Now().Add(time.Second)
+			for calls.Load() < 3 && time.Now().Before(deadline) {
+				time.Sleep(time.Millisecond)
+			}
+			cancel()
+
+			waitCtx, waitCancel := context.WithTimeout(context.Background(), time.Second)
+			defer waitCancel()
+			if err := r.Wait(waitCtx); err != nil {
+				t.Fatalf("Wait() error = %v", err)
+			}
+			if calls.Load() < 3 {
+				t.Errorf("calls = %d, want >= 3", calls.Load())
+			}
+
+			// 종료 이후에는 실행하지 않는다.
+			stopped := calls.Load()
+			time.Sleep(10 * time.Millisecond)
+			if calls.Load() != stopped {
+				t.Errorf("Wait() 이후 실행 = %d, want %d", calls.Load(), stopped)
+			}
+		})
+	}
+}
+
+func TestRunnerDisabled(t *testing.T) {
+	r := NewRunner(zap.NewNop())
+	r.Every("disabled", 0, func(context.Context) error {
+		t.Error("interval 0 인 작업이 실행되었습니다")
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.Start(ctx)
+	time.Sleep(5 * time.Millisecond)
+	cancel()
+
+	if err := r.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+}
+
+func TestRunnerWaitTimeout(t *testing.T) {
+	r := NewRunner(zap.NewNop())
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	r.Every("slow", time.Millisecond, func(context.Context) error {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release // ctx 를 무시하는 작업
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.Start(ctx)
+	<-started
+	cancel()
+
+	waitCtx, waitCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer waitCancel()
+	if err := r.Wait(waitCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait() error = %v, want DeadlineExceeded", err)
+	}
+	close(release)
+}
+
+func TestRunnerEveryAfterStart(t *testing.T) {
+	r := NewRunner(zap.NewNop())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r.Start(ctx)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Start 이후 Every 가 panic 하지 않았습니다")
+		}
+	}()
+	r.Every("late", time.Second, func(context.Context) error { return nil })
+}
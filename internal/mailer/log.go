@@ -0,0 +1,30 @@
+package mailer
+
+import (
+	"context"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+type logMailer struct {
+	log *zap.Logger
+}
+
+func (m *logMailer) Send(ctx context.Context, mail *domain.Mail) error {
+	requestid.Logger(ctx, m.log).Info("메일 발송 (log)",
+		zap.String("to", mail.To),
+		zap.String("subject", mail.Subject),
+		zap.String("body", mail.Body),
+	)
+
+	return nil
+}
+
+// NewLogMailer
+//
+// 메일을 발송하지 않고 로그로 남깁니다. 본문에 토큰이 포함되므로 개발 환경에서만 사용합니다.
+func NewLogMailer(log *zap.Logger) domain.Mailer {
+	return &logMailer{log: log}
+}
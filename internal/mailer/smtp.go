@@ -0,0 +1,124 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+// sendTimeout 메일 한건 발송 제한시간 입니다.
+const sendTimeout = 30 * time.Second
+
+// SMTPConfig
+//
+// TLS 는 starttls(587), tls(465), none(로컬 테스트 서버) 중 하나 입니다.
+// Username 이 비어있으면 인증하지 않습니다.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	TLS      string
+	From     string
+}
+
+type smtpMailer struct {
+	log  *zap.Logger
+	cfg  SMTPConfig
+	from *mail.Address
+}
+
+func (m *smtpMailer) Send(ctx context.Context, msg *domain.Mail) error {
+	body, err := message(m.from, msg)
+	if err != nil {
+		return err
+	}
+
+	if err := m.send(ctx, msg.To, body); err != nil {
+		requestid.Logger(ctx, m.log).Warn("메일 발송 실패", zap.Error(err), zap.String("host", m.cfg.Host))
+		return fmt.Errorf("%w: %s", domain.ErrUnavailable, err)
+	}
+
+	return nil
+}
+
+func (m *smtpMailer) send(ctx context.Context, to string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(m.cfg.Host, strconv.Itoa(m.cfg.Port))
+	tlsConfig := &tls.Config{ServerName: m.cfg.Host}
+
+	var conn net.Conn
+	var err error
+	if m.cfg.TLS == "tls" {
+		conn, err = (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetDeadline(deadline)
+
+	c, err := smtp.NewClient(conn, m.cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if m.cfg.TLS == "starttls" {
+		if err := c.StartTLS(tlsConfig); err != nil {
+			return err
+		}
+	}
+	if m.cfg.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(m.from.Address); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(body); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+// NewSMTPMailer SMTP 서버로 메일을 발송합니다.
+func NewSMTPMailer(log *zap.Logger, cfg SMTPConfig) (domain.Mailer, error) {
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("발신 주소 오류: %w", err)
+	}
+
+	return &smtpMailer{
+		log:  log,
+		cfg:  cfg,
+		from: from,
+	}, nil
+}
@@ -0,0 +1,216 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// fakeSMTPServer 메일 한건을 받는 SMTP 서버 입니다. AUTH PLAIN 만 지원합니다.
+type fakeSMTPServer struct {
+	ln net.Listener
+	// rejectRcpt 수신자를 거절합니다.
+	rejectRcpt bool
+
+	mu       sync.Mutex
+	auth     string
+	from     string
+	rcpt     string
+	data     string
+	received chan struct{}
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeSMTPServer{ln: ln, received: make(chan struct{})}
+	t.Cleanup(func() { _ = ln.Close() })
+
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		s.serve(conn)
+	}()
+
+	return s
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn) {
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd, arg, _ := strings.Cut(line, " ")
+
+		s.mu.Lock()
+		switch strings.ToUpper(cmd) {
+		case "EHLO", "HELO":
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			s.auth = arg
+			reply("235 2.7.0 Authentication successful")
+		case "MAIL":
+			s.from = arg
+			reply("250 OK")
+		case "RCPT":
+			if s.rejectRcpt {
+				reply("550 5.1.1 no such user")
+				break
+			}
+			s.rcpt = arg
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var b strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil || l == ".\r\n" {
+					break
+				}
+				b.WriteString(l)
+			}
+			s.data = b.String()
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			s.mu.Unlock()
+			close(s.received)
+			return
+		default:
+			reply("502 command not implemented")
+		}
+		s.mu.Unlock()
+	}
+}
+
+func TestSMTPMailerSend(t *testing.T) {
+	srv := newFakeSMTPServer(t)
+
+	m, err := NewSMTPMailer(zap.NewNop(), SMTPConfig{
+		Host:     "127.0.0.1",
+		Port:     srv.port(),
+		Username: "user",
+		Password: "secret",
+		TLS:      "none",
+		From:     "GDH <no-reply@example.com>",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.Send(context.Background(), &domain.Mail{
+		To:      "a@example.com",
+		Subject: "비밀번호 재설정",
+		Body:    "아래 링크로 비밀번호를 재설정 하세요.\nhttps://example.com/reset?token=abc",
+	}); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	<-srv.received
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	if want := "PLAIN " + base64.StdEncoding.EncodeToString([]byte("\x00user\x00secret")); srv.auth != want {
+		t.Errorf("AUTH = %q, want %q", srv.auth, want)
+	}
+	if srv.from != "FROM:<no-reply@example.com>" || srv.rcpt != "TO:<a@example.com>" {
+		t.Errorf("MAIL %q, RCPT %q", srv.from, srv.rcpt)
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(srv.data))
+	if err != nil {
+		t.Fatalf("ReadMessage() error = %v", err)
+	}
+	if got, _ := msg.Header.AddressList("To"); len(got) != 1 || got[0].Address != "a@example.com" {
+		t.Errorf("To = %v", got)
+	}
+	if got, _ := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject")); got != "비밀번호 재설정" {
+		t.Errorf("Subject = %q", got)
+	}
+	if got := msg.Header.Get("Content-Transfer-Encoding"); got != "base64" {
+		t.Errorf("Content-Transfer-Encoding = %q", got)
+	}
+
+	body, err := io.ReadAll(msg.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(body), "\r\n", ""))
+	if err != nil {
+		t.Fatalf("본문 base64 오류: %v", err)
+	}
+	if !strings.Contains(string(decoded), "https://example.com/reset?token=abc") {
+		t.Errorf("본문 = %q", decoded)
+	}
+}
+
+func TestSMTPMailerError(t *testing.T) {
+	t.Run("수신자 거절", func(t *testing.T) {
+		srv := newFakeSMTPServer(t)
+		srv.mu.Lock()
+		srv.rejectRcpt = true
+		srv.mu.Unlock()
+
+		m, _ := NewSMTPMailer(zap.NewNop(), SMTPConfig{Host: "127.0.0.1", Port: srv.port(), TLS: "none", From: "no-reply@example.com"})
+		if err := m.Send(context.Background(), &domain.Mail{To: "a@example.com", Subject: "제목", Body: "본문"}); !errors.Is(err, domain.ErrUnavailable) {
+			t.Errorf("Send() error = %v, want %v", err, domain.ErrUnavailable)
+		}
+	})
+
+	t.Run("연결 실패", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		port := ln.Addr().(*net.TCPAddr).Port
+		_ = ln.Close()
+
+		m, _ := NewSMTPMailer(zap.NewNop(), SMTPConfig{Host: "127.0.0.1", Port: port, TLS: "none", From: "no-reply@example.com"})
+		if err := m.Send(context.Background(), &domain.Mail{To: "a@example.com", Subject: "제목", Body: "본문"}); !errors.Is(err, domain.ErrUnavailable) {
+			t.Errorf("Send() error = %v, want %v", err, domain.ErrUnavailable)
+		}
+	})
+
+	t.Run("헤더 줄바꿈", func(t *testing.T) {
+		m, _ := NewSMTPMailer(zap.NewNop(), SMTPConfig{Host: "127.0.0.1", Port: 1, TLS: "none", From: "no-reply@example.com"})
+		err := m.Send(context.Background(), &domain.Mail{To: "a@example.com\r\nBcc: b@example.com", Subject: "제목", Body: "본문"})
+		if err == nil || errors.Is(err, domain.ErrUnavailable) {
+			t.Errorf("Send() error = %v, want 헤더 오류", err)
+		}
+	})
+
+	t.Run("발신 주소 오류", func(t *testing.T) {
+		if _, err := NewSMTPMailer(zap.NewNop(), SMTPConfig{From: "not an address"}); err == nil {
+			t.Error("NewSMTPMailer() error = nil")
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// dbPoolCollector
+//
+// 수집 시점에 pgxpool.Stat() 을 읽어 커넥션 풀 지표를 노출합니다.
+type dbPoolCollector struct {
+	db *pgxpool.Pool
+
+	acquiredConns        *prometheus.Desc
+	idleConns            *prometheus.Desc
+	constructingConns    *prometheus.Desc
+	totalConns           *prometheus.Desc
+	maxConns             *prometheus.Desc
+	acquireCount         *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	emptyAcquireCount    *prometheus.Desc
+	emptyAcquireWaitTime *prometheus.Desc
+	canceledAcquireCount *prometheus.Desc
+}
+
+func (c *dbPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c *dbPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.db.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(s.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(s.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.constructingConns, prometheus.GaugeValue, float64(s.ConstructingConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(s.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(s.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(s.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, s.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquireCount, prometheus.CounterValue, float64(s.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquireWaitTime, prometheus.CounterValue, s.EmptyAcquireWaitTime().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquireCount, prometheus.CounterValue, float64(s.CanceledAcquireCount()))
+}
+
+// RegisterDBPool pgxpool 지표 수집기를 등록합니다.
+func (m *Metrics) RegisterDBPool(db *pgxpool.Pool) {
+	desc := func(name string, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+
+	m.Registry.MustRegister(&dbPoolCollector{
+		db:                   db,
+		acquiredConns:        desc("acquired_conns", "현재 사용중인 커넥션 수"),
+		idleConns:            desc("idle_conns", "유휴 커넥션 수"),
+		constructingConns:    desc("constructing_conns", "생성중인 커넥션 수"),
+		totalConns:           desc("total_conns", "전체 커넥션 수"),
+		maxConns:             desc("max_conns", "최대 커넥션 수"),
+		acquireCount:         desc("acquire_total", "커넥션 획득 누적 횟수"),
+		acquireDuration:      desc("acquire_duration_seconds_total", "커넥션 획득 누적 소요 시간 (초)"),
+		emptyAcquireCount:    desc("empty_acquire_total", "유휴 커넥션이 없어 대기한 누적 횟수"),
+		emptyAcquireWaitTime: desc("empty_acquire_wait_seconds_total", "유휴 커넥션이 없어 대기한 누적 시간 (초)"),
+		canceledAcquireCount: desc("canceled_acquire_total", "커넥션 획득 중 취소된 누적 횟수"),
+	})
+}
@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// GrpcUnaryClientInterceptor
+//
+// gRPC 클라이언트 호출을 method 단위로 기록합니다.
+func (m *Metrics) GrpcUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		m.grpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+		m.grpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
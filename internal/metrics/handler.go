@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+// Guard
+//
+// /metrics 접근 제한 설정 입니다. 값이 비어있으면 해당 제한은 적용하지 않습니다.
+type Guard struct {
+	User      string   // basic auth 사용자
+	Password  string   // basic auth 비밀번호
+	AllowList []string // 허용 IP 혹은 CIDR 목록
+}
+
+// RegisterRoute gin 엔진에 /metrics 라우트를 등록합니다.
+func (m *Metrics) RegisterRoute(r *gin.Engine, log *zap.Logger, guard Guard) {
+	var handlers []gin.HandlerFunc
+
+	if len(guard.AllowList) > 0 {
+		handlers = append(handlers, allowListMiddleware(log, guard.AllowList))
+	}
+	if guard.User != "" && guard.Password != "" {
+		handlers = append(handlers, gin.BasicAuth(gin.Accounts{guard.User: guard.Password}))
+	}
+
+	handlers = append(handlers, gin.WrapH(promhttp.HandlerFor(m.Registry, promhttp.HandlerOpts{})))
+
+	r.GET("/metrics", handlers...)
+
+	log.Info("Metrics Handler 등록",
+		zap.Bool("basic_auth", guard.User != ""),
+		zap.Strings("allow_list", guard.AllowList),
+	)
+}
+
+func allowListMiddleware(log *zap.Logger, allowList []string) gin.HandlerFunc {
+	var nets []*net.IPNet
+	for _, v := range allowList {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if !strings.Contains(v, "/") {
+			if strings.Contains(v, ":") {
+				v += "/128"
+			} else {
+				v += "/32"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(v)
+		if err != nil {
+			log.Fatal("metrics 허용 IP 목록을 해석할 수 없습니다.", zap.Error(err),
+				zap.String("value", v),
+			)
+		}
+		nets = append(nets, ipNet)
+	}
+
+	return func(c *gin.Context) {
+		ip := net.ParseIP(c.ClientIP())
+		for _, n := range nets {
+			if ip != nil && n.Contains(ip) {
+				c.Next()
+				return
+			}
+		}
+
+		log.Info("허용되지 않은 IP 의 metrics 접근", zap.String("ip", c.ClientIP()))
+		c.AbortWithStatus(http.StatusForbidden)
+	}
+}
@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// HumaMiddleware
+//
+// huma 오퍼레이션 단위로 요청 수와 처리 시간을 기록합니다.
+// api.UseMiddleware() 로 등록해야 합니다.
+func (m *Metrics) HumaMiddleware() func(ctx huma.Context, next func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		start := time.Now()
+
+		next(ctx)
+
+		status := ctx.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		operation := "unknown"
+		if op := ctx.Operation(); op != nil && op.OperationID != "" {
+			operation = op.OperationID
+		}
+
+		labels := []string{operation, ctx.Method(), strconv.Itoa(status)}
+		m.httpRequests.WithLabelValues(labels...).Inc()
+		m.httpDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+	}
+}
@@ -2,9 +2,13 @@ package middleware
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 
+	"github.com/GDH-Project/api/internal/authcookie"
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
 	"github.com/danielgtaylor/huma/v2"
 	"go.uber.org/zap"
 )
@@ -13,25 +17,129 @@ import (
 //
 // 인증 미들 웨어 입니다.
 func (m *middleware) WithAuth(op huma.Operation) huma.Operation {
-	op.Security = append(op.Security, map[string][]string{"bearer": {}})
-	op.Middlewares = huma.Middlewares{m.authMiddleware}
+	op.Security = append(op.Security, map[string][]string{"bearer": {}}, map[string][]string{"cookie": {}})
+	op.Middlewares = huma.Middlewares{m.authMiddleware, m.userRateLimitMiddleware}
 	return op
 }
 
+// WithScopes
+//
+// 개인 액세스 토큰으로도 사용할 수 있는 API 의 인증 미들 웨어 입니다.
+// 개인 액세스 토큰은 scopes 를 모두 가지고 있어야 하며, JWT 는 범위 제한 없이 사용할 수 있습니다.
+// 개인 액세스 토큰 요청에는 user_role 이 없으므로 역할을 확인하는 API 에는 사용하지 않는다.
+func (m *middleware) WithScopes(op huma.Operation, scopes ...domain.TokenScope) huma.Operation {
+	op = m.WithAuth(op)
+
+	required := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		required = append(required, string(s))
+	}
+	op.Security = append(op.Security, map[string][]string{personalAccessTokenScheme: required})
+	return op
+}
+
+// WithAdmin
+//
+// 관리자 권한이 필요한 API 의 미들 웨어 입니다.
+// 관리자 권한에 2단계 인증이 필수로 설정된 경우 2단계 인증을 사용하지 않는 관리자는 사용할 수 없습니다.
+func (m *middleware) WithAdmin(op huma.Operation) huma.Operation {
+	op = m.WithAuth(op)
+	op.Middlewares = append(op.Middlewares, m.adminMiddleware)
+	return op
+}
+
+// WithVerifiedEmail
+//
+// 이메일 인증이 필요한 API(장치 등록 등)의 미들 웨어 입니다.
+func (m *middleware) WithVerifiedEmail(op huma.Operation) huma.Operation {
+	op = m.WithAuth(op)
+	op.Middlewares = append(op.Middlewares, m.verifiedEmailMiddleware)
+	return op
+}
+
+func (m *middleware) verifiedEmailMiddleware(ctx huma.Context, next func(huma.Context)) {
+	if m.emailUseCase == nil {
+		next(ctx)
+		return
+	}
+
+	userID, _ := ctx.Context().Value("user_id").(string)
+	verified, err := m.emailUseCase.IsEmailVerified(ctx.Context(), userID)
+	if err != nil {
+		requestid.Logger(ctx.Context(), m.log).Error("이메일 인증 여부 확인 실패", zap.Error(err), zap.String("user_id", userID))
+		_ = huma.WriteErr(m.api, ctx, http.StatusServiceUnavailable, "잠시 후 다시 시도해주세요.")
+		return
+	}
+	if !verified {
+		_ = huma.WriteErr(m.api, ctx, http.StatusForbidden, domain.ErrEmailNotVerified.Error())
+		return
+	}
+
+	next(ctx)
+}
+
+func (m *middleware) adminMiddleware(ctx huma.Context, next func(huma.Context)) {
+	role, _ := ctx.Context().Value("user_role").(domain.UserRole)
+	if role != domain.UserRoleAdmin {
+		userID, _ := ctx.Context().Value("user_id").(string)
+		requestid.Logger(ctx.Context(), m.log).Info("관리자 권한이 없습니다", zap.String("user_id", userID),
+			zap.String("operation", ctx.Operation().OperationID),
+		)
+		_ = huma.WriteErr(m.api, ctx, http.StatusForbidden, "관리자 권한이 필요합니다.")
+		return
+	}
+
+	if m.twoFactorUseCase != nil {
+		userID, _ := ctx.Context().Value("user_id").(string)
+		if err := m.twoFactorUseCase.CheckTwoFactorRequirement(ctx.Context(), userID, role); err != nil {
+			if errors.Is(err, domain.ErrTwoFactorRequired) {
+				requestid.Logger(ctx.Context(), m.log).Info("2단계 인증이 필요합니다", zap.String("user_id", userID),
+					zap.String("operation", ctx.Operation().OperationID),
+				)
+				_ = huma.WriteErr(m.api, ctx, http.StatusForbidden, domain.ErrTwoFactorRequired.Error())
+				return
+			}
+			requestid.Logger(ctx.Context(), m.log).Error("2단계 인증 필수 여부 확인 실패", zap.Error(err), zap.String("user_id", userID))
+			_ = huma.WriteErr(m.api, ctx, http.StatusServiceUnavailable, "잠시 후 다시 시도해주세요.")
+			return
+		}
+	}
+
+	next(ctx)
+}
+
 func (m *middleware) authMiddleware(ctx huma.Context, next func(huma.Context)) {
-	authHeader := ctx.Header("Authorization")
-	if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
+	token, fromCookie := m.accessToken(ctx)
+	if token == "" {
 		err := errors.New("인증 헤더가 없거나 유효하지 않습니다")
-		m.log.Info("Authorization 헤더가 없거나 유효하지 않습니다", zap.Error(err))
+		requestid.Logger(ctx.Context(), m.log).Info("Authorization 헤더가 없거나 유효하지 않습니다", zap.Error(err))
 		_ = huma.WriteErr(m.api, ctx, http.StatusForbidden, "Authorization 헤더가 없거나 유효하지 않습니다.", err)
 		return
 	}
 
-	token := authHeader[len("Bearer "):]
+	if strings.HasPrefix(token, domain.PersonalAccessTokenPrefix) {
+		m.personalAccessTokenAuth(ctx, next, token, fromCookie)
+		return
+	}
+
+	// 쿠키 인증은 브라우저가 자동으로 전송하므로 상태를 변경하는 요청에 CSRF 토큰을 요구한다.
+	if fromCookie && !authcookie.SafeMethod(ctx.Method()) {
+		var csrfCookie string
+		if c, err := huma.ReadCookie(ctx, authcookie.CSRFTokenCookie); err == nil {
+			csrfCookie = c.Value
+		}
+		if !authcookie.ValidCSRF(csrfCookie, ctx.Header(authcookie.CSRFHeader)) {
+			requestid.Logger(ctx.Context(), m.log).Info("CSRF 토큰이 유효하지 않습니다",
+				zap.String("operation", ctx.Operation().OperationID),
+			)
+			_ = huma.WriteErr(m.api, ctx, http.StatusForbidden, "CSRF 토큰이 유효하지 않습니다.")
+			return
+		}
+	}
 
 	user, err := m.authUseCase.Validate(ctx.Context(), token)
 	if err != nil {
-		m.log.Info("accessToken이 유효하지 않습니다.", zap.Error(err),
+		requestid.Logger(ctx.Context(), m.log).Info("accessToken이 유효하지 않습니다.", zap.Error(err),
 			zap.String("token", token),
 		)
 
@@ -42,6 +150,82 @@ func (m *middleware) authMiddleware(ctx huma.Context, next func(huma.Context)) {
 
 	ctx = huma.WithValue(ctx, "user_id", user.ID)
 	ctx = huma.WithValue(ctx, "user_role", user.Role)
+	ctx = huma.WithValue(ctx, "access_token", token)
 
 	next(ctx)
 }
+
+// personalAccessTokenAuth 개인 액세스 토큰은 WithScopes 로 범위가 지정된 API 에서만 사용할 수 있습니다.
+//
+// 개인 액세스 토큰은 user_role 을 설정하지 않으므로 역할이 필요한 API(WithAdmin 등)에는 사용할 수 없습니다.
+func (m *middleware) personalAccessTokenAuth(ctx huma.Context, next func(huma.Context), token string, fromCookie bool) {
+	scopes, ok := operationScopes(ctx.Operation())
+	if !ok || fromCookie || m.personalAccessTokenUseCase == nil {
+		requestid.Logger(ctx.Context(), m.log).Info("개인 액세스 토큰으로 사용할 수 없는 API 입니다",
+			zap.String("operation", ctx.Operation().OperationID),
+		)
+		_ = huma.WriteErr(m.api, ctx, http.StatusForbidden, "개인 액세스 토큰으로 사용할 수 없는 API 입니다.")
+		return
+	}
+
+	t, err := m.personalAccessTokenUseCase.ValidatePersonalAccessToken(ctx.Context(), token)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPersonalAccessTokenInvalid):
+			requestid.Logger(ctx.Context(), m.log).Info("개인 액세스 토큰이 유효하지 않습니다", zap.Error(err))
+			_ = huma.WriteErr(m.api, ctx, http.StatusForbidden, "개인 액세스 토큰이 유효하지 않습니다.")
+		case errors.Is(err, domain.ErrUserSuspended):
+			_ = huma.WriteErr(m.api, ctx, http.StatusForbidden, domain.ErrUserSuspended.Error())
+		default:
+			requestid.Logger(ctx.Context(), m.log).Error("개인 액세스 토큰 확인 실패", zap.Error(err))
+			_ = huma.WriteErr(m.api, ctx, http.StatusServiceUnavailable, "잠시 후 다시 시도해주세요.")
+		}
+		return
+	}
+
+	if !t.HasScopes(scopes...) {
+		requestid.Logger(ctx.Context(), m.log).Info("개인 액세스 토큰의 범위가 부족합니다", zap.String("token_id", t.ID),
+			zap.String("operation", ctx.Operation().OperationID),
+		)
+		_ = huma.WriteErr(m.api, ctx, http.StatusForbidden, fmt.Sprintf("개인 액세스 토큰에 %v 범위가 필요합니다.", scopes))
+		return
+	}
+
+	ctx = huma.WithValue(ctx, "user_id", t.UserID)
+	ctx = huma.WithValue(ctx, "token_id", t.ID)
+
+	next(ctx)
+}
+
+// operationScopes WithScopes 로 지정된 개인 액세스 토큰의 범위를 찾습니다.
+func operationScopes(op *huma.Operation) ([]domain.TokenScope, bool) {
+	for _, requirement := range op.Security {
+		required, ok := requirement[personalAccessTokenScheme]
+		if !ok {
+			continue
+		}
+
+		scopes := make([]domain.TokenScope, 0, len(required))
+		for _, s := range required {
+			scopes = append(scopes, domain.TokenScope(s))
+		}
+		return scopes, true
+	}
+
+	return nil, false
+}
+
+// accessToken Authorization 헤더를 우선 사용하고, 쿠키 인증이 활성화 되어 있으면 쿠키를 사용한다.
+func (m *middleware) accessToken(ctx huma.Context) (token string, fromCookie bool) {
+	if authHeader := ctx.Header("Authorization"); strings.HasPrefix(authHeader, "Bearer ") {
+		return authHeader[len("Bearer "):], false
+	}
+
+	if m.cookie != nil {
+		if c, err := huma.ReadCookie(ctx, authcookie.AccessTokenCookie); err == nil && c.Value != "" {
+			return c.Value, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,163 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/service"
+	usecase "github.com/GDH-Project/api/internal/use_case"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"go.uber.org/zap"
+)
+
+// fakePersonalAccessTokenRepository 토큰을 해시별로 메모리에 저장합니다.
+type fakePersonalAccessTokenRepository struct {
+	tokens map[string]*domain.PersonalAccessToken
+}
+
+func (r *fakePersonalAccessTokenRepository) CreatePersonalAccessToken(_ context.Context, token *domain.PersonalAccessToken, tokenHash string) error {
+	token.ID = tokenHash[:8]
+	token.CreatedAt = time.Now()
+	r.tokens[tokenHash] = token
+	return nil
+}
+
+func (r *fakePersonalAccessTokenRepository) GetPersonalAccessTokenByHash(_ context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	t, ok := r.tokens[tokenHash]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return t, nil
+}
+
+func (r *fakePersonalAccessTokenRepository) GetPersonalAccessTokenList(_ context.Context, userID string) ([]*domain.PersonalAccessToken, error) {
+	tokenList := make([]*domain.PersonalAccessToken, 0)
+	for _, t := range r.tokens {
+		if t.UserID == userID {
+			tokenList = append(tokenList, t)
+		}
+	}
+	return tokenList, nil
+}
+
+func (r *fakePersonalAccessTokenRepository) DeletePersonalAccessToken(_ context.Context, userID string, tokenID string) error {
+	for hash, t := range r.tokens {
+		if t.UserID == userID && t.ID == tokenID {
+			delete(r.tokens, hash)
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+func (r *fakePersonalAccessTokenRepository) UpdatePersonalAccessTokenLastUsed(context.Context, string) error {
+	return nil
+}
+
+type fakeSuspensionService struct {
+	domain.UserSuspensionService
+}
+
+func (s *fakeSuspensionService) CheckUser(context.Context, string) error {
+	return nil
+}
+
+// fakeAuthUseCase "jwt" 만 유효한 JWT 로 인정합니다.
+type fakeAuthUseCase struct {
+	domain.AuthUseCase
+}
+
+func (a *fakeAuthUseCase) Validate(_ context.Context, token string) (*domain.User, error) {
+	if token != "jwt" {
+		return nil, domain.ErrTokenRevoked
+	}
+	return &domain.User{ID: "user", Role: domain.UserRoleUser}, nil
+}
+
+func TestPersonalAccessTokenScopes(t *testing.T) {
+	ctx := context.Background()
+
+	repo := &fakePersonalAccessTokenRepository{tokens: make(map[string]*domain.PersonalAccessToken)}
+	svc := service.NewPersonalAccessTokenService(zap.NewNop(), repo, domain.PersonalAccessTokenPolicy{MaxTTL: 24 * time.Hour, MaxPerUser: 10})
+	uc := usecase.NewPersonalAccessTokenUseCase(zap.NewNop(), svc, &fakeSuspensionService{})
+
+	_, api := humatest.New(t)
+	m := NewMiddleware(api, zap.NewNop(), &fakeAuthUseCase{}, nil, nil, nil, uc, nil)
+
+	handler := func(ctx context.Context, _ *struct{}) (*struct{}, error) {
+		if userID, _ := ctx.Value("user_id").(string); userID != "user" {
+			return nil, huma.Error500InternalServerError("user_id = " + userID)
+		}
+		return nil, nil
+	}
+	huma.Register(api, m.WithScopes(huma.Operation{
+		OperationID:   "readDevices",
+		Method:        http.MethodGet,
+		Path:          "/devices",
+		DefaultStatus: http.StatusNoContent,
+	}, domain.TokenScopeReadDevices), handler)
+	huma.Register(api, m.WithScopes(huma.Operation{
+		OperationID:   "writeData",
+		Method:        http.MethodPut,
+		Path:          "/data",
+		DefaultStatus: http.StatusNoContent,
+	}, domain.TokenScopeReadDevices, domain.TokenScopeWriteData), handler)
+	huma.Register(api, m.WithAuth(huma.Operation{
+		OperationID:   "jwtOnly",
+		Method:        http.MethodGet,
+		Path:          "/me",
+		DefaultStatus: http.StatusNoContent,
+	}), handler)
+
+	expiresAt := time.Now().Add(time.Hour)
+	_, readDevicesToken, err := uc.CreatePersonalAccessToken(ctx, "user", "read", []domain.TokenScope{domain.TokenScopeReadDevices}, expiresAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, allToken, err := uc.CreatePersonalAccessToken(ctx, "user", "all", domain.TokenScopes, expiresAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired, expiredToken, err := uc.CreatePersonalAccessToken(ctx, "user", "expired", domain.TokenScopes, expiresAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+	revoked, revokedToken, err := uc.CreatePersonalAccessToken(ctx, "user", "revoked", domain.TokenScopes, expiresAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := uc.RevokePersonalAccessToken(ctx, "user", revoked.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		want   int
+	}{
+		{name: "범위가 있는 토큰", method: http.MethodGet, path: "/devices", token: readDevicesToken, want: http.StatusNoContent},
+		{name: "범위를 모두 가진 토큰", method: http.MethodPut, path: "/data", token: allToken, want: http.StatusNoContent},
+		{name: "범위가 부족한 토큰", method: http.MethodPut, path: "/data", token: readDevicesToken, want: http.StatusForbidden},
+		{name: "범위가 지정되지 않은 API", method: http.MethodGet, path: "/me", token: allToken, want: http.StatusForbidden},
+		{name: "만료된 토큰", method: http.MethodGet, path: "/devices", token: expiredToken, want: http.StatusForbidden},
+		{name: "폐기된 토큰", method: http.MethodGet, path: "/devices", token: revokedToken, want: http.StatusForbidden},
+		{name: "JWT 는 범위 제한 없음", method: http.MethodPut, path: "/data", token: "jwt", want: http.StatusNoContent},
+		{name: "JWT 전용 API", method: http.MethodGet, path: "/me", token: "jwt", want: http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := api.Do(tt.method, tt.path, "Authorization: Bearer "+tt.token)
+			if resp.Code != tt.want {
+				t.Errorf("%s %s = %d, want %d: %s", tt.method, tt.path, resp.Code, tt.want, resp.Body.String())
+			}
+		})
+	}
+}
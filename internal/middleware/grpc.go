@@ -1,6 +1,9 @@
 package middleware
 
 import (
+	"context"
+
+	"github.com/GDH-Project/api/internal/requestid"
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc/metadata"
 )
@@ -8,6 +11,7 @@ import (
 // WithGrpcMeta grpc 메타 데이터 설정
 //
 // 해당 미들웨어는 gin.Use()로 사용을 해야 한다.
+// handler 에서도 사용할 수 있도록 client_ip, user_agent 를 context 에 설정한다.
 func (m *middleware) WithGrpcMeta() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		clientIP := c.ClientIP()
@@ -16,6 +20,11 @@ func (m *middleware) WithGrpcMeta() gin.HandlerFunc {
 		ctx := c.Request.Context()
 		ctx = metadata.AppendToOutgoingContext(ctx, "x-user-agent", userAgent)
 		ctx = metadata.AppendToOutgoingContext(ctx, "x-client-ip", clientIP)
+		if id := requestid.FromContext(ctx); id != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, requestid.MetadataKey, id)
+		}
+		ctx = context.WithValue(ctx, "client_ip", clientIP)
+		ctx = context.WithValue(ctx, "user_agent", userAgent)
 
 		c.Request = c.Request.WithContext(ctx)
 		c.Next()
@@ -1,7 +1,9 @@
 package middleware
 
 import (
+	"github.com/GDH-Project/api/internal/authcookie"
 	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/ratelimit"
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -9,19 +11,42 @@ import (
 
 type Middleware interface {
 	WithAuth(op huma.Operation) huma.Operation
+	WithScopes(op huma.Operation, scopes ...domain.TokenScope) huma.Operation
+	WithAdmin(op huma.Operation) huma.Operation
+	WithVerifiedEmail(op huma.Operation) huma.Operation
 	WithGrpcMeta() gin.HandlerFunc
+	WithRequestID() gin.HandlerFunc
+	WithRateLimit() func(ctx huma.Context, next func(huma.Context))
 }
 
+// personalAccessTokenScheme 개인 액세스 토큰의 OpenAPI security scheme 이름 입니다.
+const personalAccessTokenScheme = "personal_access_token"
+
 type middleware struct {
 	api         huma.API
 	log         *zap.Logger
 	authUseCase domain.AuthUseCase
+	limiter     *ratelimit.Limiter
+	cookie      *authcookie.Options
+
+	emailUseCase               domain.EmailUseCase
+	personalAccessTokenUseCase domain.PersonalAccessTokenUseCase
+	twoFactorUseCase           domain.TwoFactorUseCase
 }
 
-func NewMiddleware(api huma.API, log *zap.Logger, authUseCase domain.AuthUseCase) Middleware {
+// NewMiddleware limiter 가 nil 인 경우 요청 제한을, cookie 가 nil 인 경우 쿠키 인증을,
+// emailUseCase 가 nil 인 경우 이메일 인증 확인을, personalAccessTokenUseCase 가 nil 인 경우 개인 액세스 토큰 인증을,
+// twoFactorUseCase 가 nil 인 경우 관리자 API 의 2단계 인증 필수 확인을 적용하지 않습니다.
+func NewMiddleware(api huma.API, log *zap.Logger, authUseCase domain.AuthUseCase, limiter *ratelimit.Limiter, cookie *authcookie.Options, emailUseCase domain.EmailUseCase, personalAccessTokenUseCase domain.PersonalAccessTokenUseCase, twoFactorUseCase domain.TwoFactorUseCase) Middleware {
 	return &middleware{
-		api:         api,
-		log:         log,
-		authUseCase: authUseCase,
+		api:          api,
+		log:          log,
+		authUseCase:  authUseCase,
+		limiter:      limiter,
+		cookie:       cookie,
+		emailUseCase: emailUseCase,
+
+		personalAccessTokenUseCase: personalAccessTokenUseCase,
+		twoFactorUseCase:           twoFactorUseCase,
 	}
 }
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/adapters/humagin"
+	"go.uber.org/zap"
+)
+
+// WithRateLimit
+//
+// 클라이언트 IP(신뢰하는 플랫폼, 프록시 헤더 반영) 기준 요청 제한 미들웨어 입니다.
+// 해당 미들웨어는 api.UseMiddleware()로 사용을 해야 한다.
+func (m *middleware) WithRateLimit() func(ctx huma.Context, next func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		m.rateLimit(ctx, next, "ip:"+humagin.Unwrap(ctx).ClientIP())
+	}
+}
+
+// userRateLimitMiddleware 인증된 사용자 기준 요청 제한 입니다. authMiddleware 다음에 실행되어야 한다.
+func (m *middleware) userRateLimitMiddleware(ctx huma.Context, next func(huma.Context)) {
+	userID, _ := ctx.Context().Value("user_id").(string)
+	m.rateLimit(ctx, next, "user:"+userID)
+}
+
+func (m *middleware) rateLimit(ctx huma.Context, next func(huma.Context), subject string) {
+	if m.limiter == nil {
+		next(ctx)
+		return
+	}
+
+	operationID := ctx.Operation().OperationID
+	result := m.limiter.Allow(ctx.Context(), operationID, subject)
+	if result == nil {
+		next(ctx)
+		return
+	}
+
+	ctx.SetHeader("RateLimit-Limit", strconv.Itoa(result.Limit))
+	ctx.SetHeader("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	ctx.SetHeader("RateLimit-Reset", ceilSeconds(result.Reset))
+
+	if !result.Allowed {
+		ctx.SetHeader("Retry-After", ceilSeconds(result.RetryAfter))
+		requestid.Logger(ctx.Context(), m.log).Info("요청 제한 초과",
+			zap.String("operation", operationID),
+			zap.String("subject", subject),
+		)
+		_ = huma.WriteErr(m.api, ctx, http.StatusTooManyRequests, "요청이 너무 많습니다. 잠시 후 다시 시도해주세요.")
+		return
+	}
+
+	next(ctx)
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
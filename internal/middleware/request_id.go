@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/gin-gonic/gin"
+)
+
+// WithRequestID 요청 ID 설정
+//
+// X-Request-ID 헤더가 올바르면 그대로 사용하고 아니면 새로 생성하여 context 와 응답 헤더에 설정한다.
+// 해당 미들웨어는 gin.Use()로 WithGrpcMeta 보다 먼저 사용을 해야 한다.
+func (m *middleware) WithRequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestid.Header)
+		if !requestid.Valid(id) {
+			id = requestid.New()
+		}
+
+		c.Header(requestid.Header, id)
+		c.Request = c.Request.WithContext(requestid.NewContext(c.Request.Context(), id))
+		c.Next()
+	}
+}
@@ -0,0 +1,149 @@
+package migration
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+func TestFileNamePattern(t *testing.T) {
+	tests := []struct {
+		name string
+		ok   bool
+	}{
+		{name: "0001_init_meta.up.sql", ok: true},
+		{name: "0001_init_meta.down.sql", ok: true},
+		{name: "20260301_add_index.up.sql", ok: true},
+		{name: "0001_init_meta.sql", ok: false},
+		{name: "0001-init-meta.up.sql", ok: false},
+		{name: "init_meta.up.sql", ok: false},
+		{name: "0001_Init.up.sql", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok := fileNamePattern.MatchString(tt.name); ok != tt.ok {
+				t.Errorf("MatchString() = %v, want %v", ok, tt.ok)
+			}
+		})
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatalf("loadMigrations() error = %v", err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("loadMigrations() = 0 개")
+	}
+
+	for i, m := range migrations {
+		if i > 0 && m.Version <= migrations[i-1].Version {
+			t.Errorf("버전 순서 오류: %d 다음 %d", migrations[i-1].Version, m.Version)
+		}
+		if m.Up == "" {
+			t.Errorf("%d_%s: up 이 없습니다", m.Version, m.Name)
+		}
+		// migrate down 으로 되돌릴 수 있어야 한다.
+		if m.Down == "" {
+			t.Errorf("%d_%s: down 이 없습니다", m.Version, m.Name)
+		}
+	}
+}
+
+// testMigrator TEST_DATABASE_URL 이 설정된 경우에만 실행합니다. 모든 마이그레이션을 되돌리므로 테스트 전용 DB 를 사용해야 합니다.
+func testMigrator(t *testing.T) *Migrator {
+	t.Helper()
+
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL 이 설정되지 않았습니다")
+	}
+
+	db, err := pgxpool.New(context.Background(), url)
+	if err != nil {
+		t.Fatalf("pgxpool.New() error = %v", err)
+	}
+	t.Cleanup(db.Close)
+
+	m, err := NewMigrator(zap.NewNop(), db)
+	if err != nil {
+		t.Fatalf("NewMigrator() error = %v", err)
+	}
+	return m
+}
+
+func appliedCount(t *testing.T, m *Migrator) int {
+	t.Helper()
+
+	statusList, err := m.Status(context.Background())
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	var n int
+	for _, s := range statusList {
+		if s.Applied {
+			n++
+		}
+	}
+	return n
+}
+
+func TestMigratorUpDown(t *testing.T) {
+	m := testMigrator(t)
+	ctx := context.Background()
+	total := len(m.migrations)
+
+	if _, err := m.Up(ctx, 0); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if got := appliedCount(t, m); got != total {
+		t.Fatalf("Up() 이후 적용 = %d, want %d", got, total)
+	}
+
+	tests := []struct {
+		name    string
+		steps   int
+		wantErr bool
+		applied int
+	}{
+		{name: "0 은 거절", steps: 0, wantErr: true, applied: total},
+		{name: "음수는 거절", steps: -1, wantErr: true, applied: total},
+		{name: "1 개", steps: 1, applied: total - 1},
+		{name: "2 개", steps: 2, applied: total - 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reverted, err := m.Down(ctx, tt.steps)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Down(%d) error = %v, wantErr %v", tt.steps, err, tt.wantErr)
+			}
+			if !tt.wantErr && len(reverted) != tt.steps {
+				t.Errorf("Down(%d) = %d 개", tt.steps, len(reverted))
+			}
+			if got := appliedCount(t, m); got != tt.applied {
+				t.Errorf("적용 = %d, want %d", got, tt.applied)
+			}
+		})
+	}
+
+	// 되돌린 마이그레이션을 다시 적용할 수 있어야 한다.
+	if applied, err := m.Up(ctx, 1); err != nil || len(applied) != 1 {
+		t.Fatalf("Up(1) = %d 개, error = %v", len(applied), err)
+	}
+
+	if _, err := m.DownAll(ctx); err != nil {
+		t.Fatalf("DownAll() error = %v", err)
+	}
+	if got := appliedCount(t, m); got != 0 {
+		t.Errorf("DownAll() 이후 적용 = %d, want 0", got)
+	}
+
+	if _, err := m.Up(ctx, 0); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+}
@@ -0,0 +1,216 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// advisoryLockKey 여러 인스턴스가 동시에 마이그레이션 하지 않도록 사용하는 잠금 키 입니다.
+const advisoryLockKey int64 = 0x6764685f6d6967 // "gdh_mig"
+
+// querier 커넥션, 커넥션 풀 모두 사용할 수 있도록 조회에 필요한 메서드만 정의합니다.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+type Migrator struct {
+	log        *zap.Logger
+	db         *pgxpool.Pool
+	migrations []*Migration
+}
+
+// Up 적용되지 않은 마이그레이션을 steps 개 적용합니다. steps 가 0 이하면 전부 적용합니다.
+func (m *Migrator) Up(ctx context.Context, steps int) ([]*Migration, error) {
+	var applied []*Migration
+
+	err := m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		done, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, mig := range m.migrations {
+			if steps > 0 && len(applied) >= steps {
+				break
+			}
+			if _, ok := done[mig.Version]; ok {
+				continue
+			}
+
+			m.log.Info("마이그레이션 적용", zap.Int64("version", mig.Version), zap.String("name", mig.Name))
+			if err := pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+				if _, err := tx.Exec(ctx, mig.Up); err != nil {
+					return err
+				}
+				_, err := tx.Exec(ctx,
+					`INSERT INTO public.schema_migrations (version, name) VALUES ($1, $2);`,
+					mig.Version, mig.Name,
+				)
+				return err
+			}); err != nil {
+				return fmt.Errorf("마이그레이션 %d_%s 적용 실패: %w", mig.Version, mig.Name, err)
+			}
+
+			applied = append(applied, mig)
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down 최근 적용된 마이그레이션부터 steps 개 되돌립니다. steps 는 1 이상이어야 합니다.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]*Migration, error) {
+	if steps < 1 {
+		return nil, fmt.Errorf("되돌릴 마이그레이션 수는 1 이상이어야 합니다 (%d)", steps)
+	}
+	return m.down(ctx, steps)
+}
+
+// DownAll 적용된 마이그레이션을 모두 되돌립니다.
+func (m *Migrator) DownAll(ctx context.Context) ([]*Migration, error) {
+	return m.down(ctx, 0)
+}
+
+// down steps 가 0 이면 전부 되돌립니다.
+func (m *Migrator) down(ctx context.Context, steps int) ([]*Migration, error) {
+	var reverted []*Migration
+
+	err := m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		done, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			mig := m.migrations[i]
+			if steps > 0 && len(reverted) >= steps {
+				break
+			}
+			if _, ok := done[mig.Version]; !ok {
+				continue
+			}
+			if mig.Down == "" {
+				return fmt.Errorf("down 마이그레이션이 없습니다: %d_%s", mig.Version, mig.Name)
+			}
+
+			m.log.Info("마이그레이션 되돌리기", zap.Int64("version", mig.Version), zap.String("name", mig.Name))
+			if err := pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+				if _, err := tx.Exec(ctx, mig.Down); err != nil {
+					return err
+				}
+				_, err := tx.Exec(ctx, `DELETE FROM public.schema_migrations WHERE version = $1;`, mig.Version)
+				return err
+			}); err != nil {
+				return fmt.Errorf("마이그레이션 %d_%s 되돌리기 실패: %w", mig.Version, mig.Name, err)
+			}
+
+			reverted = append(reverted, mig)
+		}
+
+		return nil
+	})
+
+	return reverted, err
+}
+
+// Status 전체 마이그레이션의 적용 현황을 반환합니다.
+//
+// 조회만 하므로 잠금을 획득하지 않고 schema_migrations 테이블이 없으면 모두 pending 으로 표시합니다.
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	var exists bool
+	if err := m.db.QueryRow(ctx, `SELECT to_regclass('public.schema_migrations') IS NOT NULL;`).Scan(&exists); err != nil {
+		return nil, err
+	}
+
+	done := make(map[int64]time.Time)
+	if exists {
+		var err error
+		if done, err = m.appliedVersions(ctx, m.db); err != nil {
+			return nil, err
+		}
+	}
+
+	statusList := make([]*Status, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		s := &Status{Version: mig.Version, Name: mig.Name}
+		if appliedAt, ok := done[mig.Version]; ok {
+			s.Applied = true
+			s.AppliedAt = &appliedAt
+		}
+		statusList = append(statusList, s)
+	}
+
+	return statusList, nil
+}
+
+// withLock advisory lock 을 획득한 커넥션으로 fn 을 실행합니다.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *pgxpool.Conn) error) error {
+	conn, err := m.db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1);`, advisoryLockKey); err != nil {
+		return fmt.Errorf("advisory lock 획득 실패: %w", err)
+	}
+	defer func() {
+		// ctx 가 취소되어도 잠금은 해제해야 한다.
+		if _, err := conn.Exec(context.Background(), `SELECT pg_advisory_unlock($1);`, advisoryLockKey); err != nil {
+			m.log.Error("advisory lock 해제 실패", zap.Error(err))
+		}
+	}()
+
+	if _, err := conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS public.schema_migrations
+		(
+			version    BIGINT PRIMARY KEY,
+			name       TEXT        NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+		);
+	`); err != nil {
+		return fmt.Errorf("schema_migrations 테이블 생성 실패: %w", err)
+	}
+
+	return fn(conn)
+}
+
+func (m *Migrator) appliedVersions(ctx context.Context, q querier) (map[int64]time.Time, error) {
+	rows, err := q.Query(ctx, `SELECT version, applied_at FROM public.schema_migrations;`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	done := make(map[int64]time.Time)
+	for rows.Next() {
+		var version int64
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		done[version] = appliedAt
+	}
+
+	return done, rows.Err()
+}
+
+func NewMigrator(log *zap.Logger, db *pgxpool.Pool) (*Migrator, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		log:        log,
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS device.address_city;
+DROP TABLE IF EXISTS device.address_state;
+DROP TABLE IF EXISTS device.update_cycle;
+DROP TABLE IF EXISTS device.crop;
+DROP TABLE IF EXISTS device.sensor;
+DROP SCHEMA IF EXISTS device;
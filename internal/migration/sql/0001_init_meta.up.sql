@@ -0,0 +1,44 @@
+CREATE SCHEMA IF NOT EXISTS device;
+
+-- 센서 정보
+CREATE TABLE device.sensor
+(
+    id               SERIAL PRIMARY KEY,
+    title            TEXT NOT NULL UNIQUE,
+    eng_title        TEXT NOT NULL,
+    description      TEXT NOT NULL DEFAULT '',
+    unit             TEXT,
+    unit_description TEXT
+);
+
+-- 작물 정보
+CREATE TABLE device.crop
+(
+    id          SERIAL PRIMARY KEY,
+    title       TEXT NOT NULL UNIQUE,
+    description TEXT
+);
+
+-- 통신 주기 (분)
+CREATE TABLE device.update_cycle
+(
+    id          SERIAL PRIMARY KEY,
+    interval    INTEGER NOT NULL UNIQUE CHECK (interval > 0),
+    description TEXT
+);
+
+-- 주소 도/특별시
+CREATE TABLE device.address_state
+(
+    id    SERIAL PRIMARY KEY,
+    title TEXT NOT NULL UNIQUE
+);
+
+-- 주소 시/군/구
+CREATE TABLE device.address_city
+(
+    id               SERIAL PRIMARY KEY,
+    address_state_id INTEGER NOT NULL REFERENCES device.address_state (id) ON DELETE CASCADE,
+    title            TEXT    NOT NULL,
+    UNIQUE (address_state_id, title)
+);
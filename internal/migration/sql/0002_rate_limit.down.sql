@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ratelimit.bucket;
+DROP SCHEMA IF EXISTS ratelimit;
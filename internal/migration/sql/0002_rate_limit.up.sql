@@ -0,0 +1,13 @@
+CREATE SCHEMA IF NOT EXISTS ratelimit;
+
+-- 인스턴스간 공유하는 token bucket (손실되어도 무방하므로 UNLOGGED)
+CREATE UNLOGGED TABLE ratelimit.bucket
+(
+    key        TEXT PRIMARY KEY,
+    tokens     FLOAT8      NOT NULL,
+    allowed    BOOLEAN     NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX bucket_expires_at_idx ON ratelimit.bucket (expires_at);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS auth.sign_in_failure;
+DROP SCHEMA IF EXISTS auth;
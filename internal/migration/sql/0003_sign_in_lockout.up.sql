@@ -0,0 +1,15 @@
+CREATE SCHEMA IF NOT EXISTS auth;
+
+-- 이메일, IP 별 로그인 실패 집계
+CREATE TABLE auth.sign_in_failure
+(
+    kind            TEXT        NOT NULL CHECK (kind IN ('email', 'ip')),
+    subject         TEXT        NOT NULL,
+    failures        INTEGER     NOT NULL,
+    first_failed_at TIMESTAMPTZ NOT NULL,
+    last_failed_at  TIMESTAMPTZ NOT NULL,
+    locked_until    TIMESTAMPTZ,
+    PRIMARY KEY (kind, subject)
+);
+
+CREATE INDEX sign_in_failure_locked_until_idx ON auth.sign_in_failure (locked_until) WHERE locked_until IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS audit.event;
+DROP FUNCTION IF EXISTS audit.reject_modification();
+DROP SCHEMA IF EXISTS audit;
@@ -0,0 +1,40 @@
+CREATE SCHEMA IF NOT EXISTS audit;
+
+-- 보안 감사 로그 (추가만 가능)
+CREATE TABLE audit.event
+(
+    id          BIGSERIAL PRIMARY KEY,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    actor_id    TEXT        NOT NULL DEFAULT '',
+    action      TEXT        NOT NULL,
+    target_type TEXT        NOT NULL DEFAULT '',
+    target_id   TEXT        NOT NULL DEFAULT '',
+    client_ip   TEXT        NOT NULL DEFAULT '',
+    user_agent  TEXT        NOT NULL DEFAULT '',
+    result      TEXT        NOT NULL CHECK (result IN ('success', 'failure')),
+    reason      TEXT        NOT NULL DEFAULT ''
+);
+
+CREATE INDEX event_occurred_at_idx ON audit.event (occurred_at DESC);
+CREATE INDEX event_actor_id_idx ON audit.event (actor_id, occurred_at DESC) WHERE actor_id <> '';
+CREATE INDEX event_action_idx ON audit.event (action, occurred_at DESC);
+CREATE INDEX event_target_id_idx ON audit.event (target_id, occurred_at DESC) WHERE target_id <> '';
+
+CREATE FUNCTION audit.reject_modification() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit.event 는 수정하거나 삭제할 수 없습니다';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER event_append_only
+    BEFORE UPDATE OR DELETE
+    ON audit.event
+    FOR EACH ROW
+EXECUTE FUNCTION audit.reject_modification();
+
+CREATE TRIGGER event_no_truncate
+    BEFORE TRUNCATE
+    ON audit.event
+    FOR EACH STATEMENT
+EXECUTE FUNCTION audit.reject_modification();
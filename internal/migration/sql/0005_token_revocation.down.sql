@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS auth.user_token_revocation;
+DROP TABLE IF EXISTS auth.revoked_token;
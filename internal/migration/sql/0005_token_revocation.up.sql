@@ -0,0 +1,18 @@
+-- 로그아웃 등으로 폐기된 토큰 (만료 전까지 보관)
+CREATE TABLE auth.revoked_token
+(
+    token_hash TEXT PRIMARY KEY,
+    kind       TEXT        NOT NULL CHECK (kind IN ('access', 'refresh')),
+    user_id    TEXT        NOT NULL DEFAULT '',
+    revoked_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX revoked_token_expires_at_idx ON auth.revoked_token (expires_at);
+
+-- 사용자별 전체 세션 폐기 시각, 이 시각 이전에 발급된 토큰은 사용할 수 없다.
+CREATE TABLE auth.user_token_revocation
+(
+    user_id        TEXT PRIMARY KEY,
+    revoked_before TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS auth.oauth_credential;
+DROP TABLE IF EXISTS auth.oauth_identity;
+DROP TABLE IF EXISTS auth.oauth_state;
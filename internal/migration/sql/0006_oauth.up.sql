@@ -0,0 +1,34 @@
+-- 소셜 로그인 인가 요청 (일회용)
+CREATE UNLOGGED TABLE auth.oauth_state
+(
+    state         TEXT PRIMARY KEY,
+    provider      TEXT        NOT NULL,
+    code_verifier TEXT        NOT NULL,
+    nonce         TEXT        NOT NULL,
+    redirect_url  TEXT        NOT NULL,
+    expires_at    TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX oauth_state_expires_at_idx ON auth.oauth_state (expires_at);
+
+-- 소셜 계정과 사용자 연결
+CREATE TABLE auth.oauth_identity
+(
+    provider          TEXT        NOT NULL,
+    subject           TEXT        NOT NULL,
+    user_id           TEXT        NOT NULL,
+    email             TEXT        NOT NULL DEFAULT '',
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_signed_in_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (provider, subject)
+);
+
+CREATE INDEX oauth_identity_user_id_idx ON auth.oauth_identity (user_id);
+
+-- 소셜 전용 계정의 비밀번호 (인증 서버 로그인에 사용, AES-GCM 암호화)
+CREATE TABLE auth.oauth_credential
+(
+    user_id            TEXT PRIMARY KEY,
+    encrypted_password BYTEA       NOT NULL,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS auth.email_verification;
+DROP TABLE IF EXISTS auth.email_token;
@@ -0,0 +1,22 @@
+-- 이메일 인증, 비밀번호 재설정 토큰 (일회용)
+CREATE TABLE auth.email_token
+(
+    token_hash TEXT PRIMARY KEY,
+    purpose    TEXT        NOT NULL CHECK (purpose IN ('verify_email', 'reset_password')),
+    user_id    TEXT        NOT NULL,
+    email      TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at    TIMESTAMPTZ
+);
+
+CREATE INDEX email_token_expires_at_idx ON auth.email_token (expires_at);
+CREATE INDEX email_token_user_id_idx ON auth.email_token (user_id, purpose);
+
+-- 인증된 이메일, 이메일이 변경되면 다시 인증해야 한다.
+CREATE TABLE auth.email_verification
+(
+    user_id     TEXT PRIMARY KEY,
+    email       TEXT        NOT NULL,
+    verified_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,8 @@
+-- 관리자가 정지한 계정, 정지된 사용자는 로그인 할 수 없다.
+CREATE TABLE auth.user_suspension
+(
+    user_id      TEXT PRIMARY KEY,
+    reason       TEXT        NOT NULL DEFAULT '',
+    suspended_by TEXT        NOT NULL DEFAULT '',
+    suspended_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS auth.personal_access_token;
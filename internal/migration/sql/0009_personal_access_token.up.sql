@@ -0,0 +1,14 @@
+-- 스크립트 등에서 사용하는 개인 액세스 토큰, 토큰 원문은 저장하지 않는다.
+CREATE TABLE auth.personal_access_token
+(
+    id           UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    user_id      TEXT        NOT NULL,
+    name         TEXT        NOT NULL,
+    token_hash   TEXT        NOT NULL UNIQUE,
+    scopes       TEXT[]      NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at   TIMESTAMPTZ NOT NULL,
+    last_used_at TIMESTAMPTZ
+);
+
+CREATE INDEX personal_access_token_user_id_idx ON auth.personal_access_token (user_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS auth.session_token;
+DROP TABLE IF EXISTS auth.session;
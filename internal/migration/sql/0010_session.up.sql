@@ -0,0 +1,28 @@
+-- 로그인 세션, 로그인 한번에 하나씩 생성되고 토큰을 재발급해도 유지된다.
+CREATE TABLE auth.session
+(
+    id             UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    user_id        TEXT        NOT NULL,
+    user_agent     TEXT        NOT NULL DEFAULT '',
+    client_ip      TEXT        NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    -- 마지막 토큰 발급 시각, 전체 세션 폐기(auth.user_token_revocation) 여부 확인에 사용한다.
+    issued_at      TIMESTAMPTZ NOT NULL DEFAULT date_trunc('second', now()),
+    last_active_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at     TIMESTAMPTZ NOT NULL,
+    revoked_at     TIMESTAMPTZ
+);
+
+CREATE INDEX session_user_id_idx ON auth.session (user_id);
+CREATE INDEX session_expires_at_idx ON auth.session (expires_at);
+
+-- 세션에서 발급된 토큰 해시
+CREATE TABLE auth.session_token
+(
+    token_hash TEXT PRIMARY KEY,
+    session_id UUID        NOT NULL REFERENCES auth.session (id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX session_token_session_id_idx ON auth.session_token (session_id);
+CREATE INDEX session_token_expires_at_idx ON auth.session_token (expires_at);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS auth.two_factor_required_role;
+DROP TABLE IF EXISTS auth.two_factor_challenge;
+DROP TABLE IF EXISTS auth.two_factor_recovery_code;
+DROP TABLE IF EXISTS auth.two_factor;
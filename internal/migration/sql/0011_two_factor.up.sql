@@ -0,0 +1,39 @@
+-- TOTP 2단계 인증, secret 은 암호화해서 저장한다. enabled_at 이 없으면 등록 확인 전 상태이다.
+CREATE TABLE auth.two_factor
+(
+    user_id          TEXT PRIMARY KEY,
+    encrypted_secret BYTEA       NOT NULL,
+    -- 마지막으로 사용한 TOTP 구간, 같은 코드를 다시 사용할 수 없다.
+    last_used_step   BIGINT      NOT NULL DEFAULT 0,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+    enabled_at       TIMESTAMPTZ
+);
+
+-- 복구 코드 해시, 한번만 사용할 수 있다.
+CREATE TABLE auth.two_factor_recovery_code
+(
+    user_id   TEXT NOT NULL,
+    code_hash TEXT NOT NULL,
+    used_at   TIMESTAMPTZ,
+    PRIMARY KEY (user_id, code_hash)
+);
+
+-- 2단계 인증 대기중인 로그인, 발급된 토큰은 인증 전까지 암호화해서 보관한다.
+CREATE UNLOGGED TABLE auth.two_factor_challenge
+(
+    challenge_hash  TEXT PRIMARY KEY,
+    user_id         TEXT        NOT NULL,
+    encrypted_token BYTEA       NOT NULL,
+    attempts        INT         NOT NULL DEFAULT 0,
+    expires_at      TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX two_factor_challenge_expires_at_idx ON auth.two_factor_challenge (expires_at);
+
+-- 2단계 인증이 필요한 권한
+CREATE TABLE auth.two_factor_required_role
+(
+    role       TEXT PRIMARY KEY,
+    updated_by TEXT        NOT NULL DEFAULT '',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
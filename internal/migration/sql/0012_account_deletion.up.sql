@@ -0,0 +1,19 @@
+-- 회원 탈퇴 후 사용자 데이터 정리 작업, 사용자 서버 탈퇴 전에 pending 으로 기록하고 실패하면 재시도한다.
+CREATE TABLE auth.account_deletion
+(
+    id              UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    user_id         TEXT        NOT NULL UNIQUE,
+    -- 이메일 기준 데이터(로그인 실패 기록) 정리에 사용하고 완료되면 비운다.
+    email           TEXT        NOT NULL DEFAULT '',
+    mode            TEXT        NOT NULL CHECK (mode IN ('purge', 'anonymize')),
+    status          TEXT        NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'confirmed', 'completed')),
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    last_error      TEXT        NOT NULL DEFAULT '',
+    -- 정리한 데이터 종류별 건수
+    result          JSONB       NOT NULL DEFAULT '{}',
+    requested_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    completed_at    TIMESTAMPTZ
+);
+
+CREATE INDEX account_deletion_status_idx ON auth.account_deletion (status, next_attempt_at) WHERE status <> 'completed';
@@ -0,0 +1,6 @@
+DELETE FROM device.sensor WHERE formula IS NOT NULL;
+
+ALTER TABLE device.sensor
+    DROP CONSTRAINT sensor_formula_inputs_check,
+    DROP COLUMN inputs,
+    DROP COLUMN formula;
@@ -0,0 +1,5 @@
+-- 파생 센서, 같은 시각에 수집된 inputs 센서 값으로 조회시 계산한다. (formula 는 internal/agro 의 계산식 이름)
+ALTER TABLE device.sensor
+    ADD COLUMN formula TEXT,
+    ADD COLUMN inputs  TEXT[] NOT NULL DEFAULT '{}',
+    ADD CONSTRAINT sensor_formula_inputs_check CHECK ((formula IS NULL) = (cardinality(inputs) = 0));
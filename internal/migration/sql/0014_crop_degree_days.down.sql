@@ -0,0 +1,4 @@
+ALTER TABLE device.crop
+    DROP CONSTRAINT crop_gdd_check,
+    DROP COLUMN gdd_upper,
+    DROP COLUMN gdd_base;
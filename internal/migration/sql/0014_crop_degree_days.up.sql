@@ -0,0 +1,5 @@
+-- 생육 도일 계산의 기준 온도와 상한 온도 (°C)
+ALTER TABLE device.crop
+    ADD COLUMN gdd_base  DOUBLE PRECISION,
+    ADD COLUMN gdd_upper DOUBLE PRECISION,
+    ADD CONSTRAINT crop_gdd_check CHECK (gdd_upper > gdd_base);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS device.rollup_pending;
+DROP TABLE IF EXISTS device.reading_daily;
+DROP TABLE IF EXISTS device.reading_hourly;
+DROP TABLE IF EXISTS device.reading;
+DROP FUNCTION IF EXISTS device.queue_rollup();
@@ -0,0 +1,82 @@
+-- 장치 센서 원본 데이터
+CREATE TABLE device.reading
+(
+    device_id UUID             NOT NULL,
+    sensor_id INTEGER          NOT NULL REFERENCES device.sensor (id),
+    time      TIMESTAMPTZ      NOT NULL,
+    value     DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (device_id, sensor_id, time)
+);
+
+-- 시간 단위 집계
+CREATE TABLE device.reading_hourly
+(
+    device_id  UUID             NOT NULL,
+    sensor_id  INTEGER          NOT NULL,
+    bucket     TIMESTAMPTZ      NOT NULL,
+    min        DOUBLE PRECISION NOT NULL,
+    max        DOUBLE PRECISION NOT NULL,
+    sum        DOUBLE PRECISION NOT NULL,
+    count      BIGINT           NOT NULL,
+    updated_at TIMESTAMPTZ      NOT NULL DEFAULT now(),
+    PRIMARY KEY (device_id, sensor_id, bucket)
+);
+
+-- 일 단위 집계, bucket 은 한국 표준시 자정
+CREATE TABLE device.reading_daily
+(
+    device_id  UUID             NOT NULL,
+    sensor_id  INTEGER          NOT NULL,
+    bucket     TIMESTAMPTZ      NOT NULL,
+    min        DOUBLE PRECISION NOT NULL,
+    max        DOUBLE PRECISION NOT NULL,
+    sum        DOUBLE PRECISION NOT NULL,
+    count      BIGINT           NOT NULL,
+    updated_at TIMESTAMPTZ      NOT NULL DEFAULT now(),
+    PRIMARY KEY (device_id, sensor_id, bucket)
+);
+
+-- 다시 집계할 시간 단위 구간, 원본 데이터가 추가, 수정되면 트리거로 등록한다. (늦게 도착한 데이터 포함)
+-- 원본 데이터 삭제(보관 기간 정리)는 집계에 반영하지 않는다.
+CREATE TABLE device.rollup_pending
+(
+    device_id UUID        NOT NULL,
+    sensor_id INTEGER     NOT NULL,
+    bucket    TIMESTAMPTZ NOT NULL,
+    queued_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (device_id, sensor_id, bucket)
+);
+
+CREATE FUNCTION device.queue_rollup() RETURNS TRIGGER
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    INSERT INTO device.rollup_pending (device_id, sensor_id, bucket)
+    SELECT DISTINCT device_id, sensor_id, date_trunc('hour', time, 'UTC')
+    FROM new_rows
+    ON CONFLICT DO NOTHING;
+
+    IF TG_OP = 'UPDATE' THEN
+        INSERT INTO device.rollup_pending (device_id, sensor_id, bucket)
+        SELECT DISTINCT device_id, sensor_id, date_trunc('hour', time, 'UTC')
+        FROM old_rows
+        ON CONFLICT DO NOTHING;
+    END IF;
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER reading_queue_rollup_insert
+    AFTER INSERT
+    ON device.reading
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT
+EXECUTE FUNCTION device.queue_rollup();
+
+CREATE TRIGGER reading_queue_rollup_update
+    AFTER UPDATE
+    ON device.reading
+    REFERENCING OLD TABLE AS old_rows NEW TABLE AS new_rows
+    FOR EACH STATEMENT
+EXECUTE FUNCTION device.queue_rollup();
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS device.retention_run;
+DROP INDEX IF EXISTS device.reading_daily_bucket_idx;
+DROP INDEX IF EXISTS device.reading_hourly_bucket_idx;
+DROP INDEX IF EXISTS device.reading_time_idx;
+DROP TABLE IF EXISTS device.device_retention_tier;
+DROP TABLE IF EXISTS device.retention_policy;
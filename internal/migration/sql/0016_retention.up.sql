@@ -0,0 +1,38 @@
+-- 장치 등급별 센서 데이터 보관 정책, 보관 일수가 NULL 이면 삭제하지 않는다.
+CREATE TABLE device.retention_policy
+(
+    tier        TEXT PRIMARY KEY,
+    raw_days    INTEGER CHECK (raw_days > 0),
+    hourly_days INTEGER CHECK (hourly_days > 0),
+    daily_days  INTEGER CHECK (daily_days > 0),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- 등급이 지정되지 않은 장치에 적용하는 전체 정책, 기본값은 삭제하지 않음
+INSERT INTO device.retention_policy (tier) VALUES ('default');
+
+-- 장치 등급, 없으면 default
+CREATE TABLE device.device_retention_tier
+(
+    device_id UUID PRIMARY KEY,
+    tier      TEXT NOT NULL REFERENCES device.retention_policy (tier)
+);
+
+CREATE INDEX device_retention_tier_tier_idx ON device.device_retention_tier (tier);
+
+-- 보관 기간이 지난 데이터 삭제용
+CREATE INDEX reading_time_idx ON device.reading (time);
+CREATE INDEX reading_hourly_bucket_idx ON device.reading_hourly (bucket);
+CREATE INDEX reading_daily_bucket_idx ON device.reading_daily (bucket);
+
+-- 보관 기간 정리 결과
+CREATE TABLE device.retention_run
+(
+    id          BIGSERIAL PRIMARY KEY,
+    started_at  TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ NOT NULL,
+    items       JSONB       NOT NULL DEFAULT '[]',
+    error       TEXT        NOT NULL DEFAULT ''
+);
+
+CREATE INDEX retention_run_started_at_idx ON device.retention_run (started_at DESC);
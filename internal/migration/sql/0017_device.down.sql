@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS device.device_request_schema;
+DROP TABLE IF EXISTS device.device_info;
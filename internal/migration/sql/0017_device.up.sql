@@ -0,0 +1,25 @@
+-- 사용자가 등록한 장치
+CREATE TABLE device.device_info
+(
+    id              UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    user_id         TEXT        NOT NULL,
+    title           TEXT        NOT NULL,
+    name            TEXT,
+    crop_id         INTEGER     NOT NULL REFERENCES device.crop (id),
+    update_cycle_id INTEGER     NOT NULL REFERENCES device.update_cycle (id),
+    address_city_id INTEGER     NOT NULL REFERENCES device.address_city (id),
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX device_info_user_id_idx ON device.device_info (user_id, created_at DESC);
+
+-- 장치가 보내는 데이터의 json key 와 센서 연결
+CREATE TABLE device.device_request_schema
+(
+    id        SERIAL PRIMARY KEY,
+    device_id UUID    NOT NULL REFERENCES device.device_info (id) ON DELETE CASCADE,
+    key       TEXT    NOT NULL,
+    sensor_id INTEGER NOT NULL REFERENCES device.sensor (id),
+    UNIQUE (device_id, key)
+);
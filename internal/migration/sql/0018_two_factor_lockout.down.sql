@@ -0,0 +1,5 @@
+DELETE FROM auth.sign_in_failure WHERE kind = 'two_factor';
+
+ALTER TABLE auth.sign_in_failure
+    DROP CONSTRAINT sign_in_failure_kind_check,
+    ADD CONSTRAINT sign_in_failure_kind_check CHECK (kind IN ('email', 'ip'));
@@ -0,0 +1,4 @@
+-- 사용자 ID 별 2단계 인증 코드 실패 집계
+ALTER TABLE auth.sign_in_failure
+    DROP CONSTRAINT sign_in_failure_kind_check,
+    ADD CONSTRAINT sign_in_failure_kind_check CHECK (kind IN ('email', 'ip', 'two_factor'));
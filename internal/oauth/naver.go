@@ -0,0 +1,102 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+// naverProvider
+//
+// 네이버는 OIDC 를 지원하지 않으므로 OAuth2 인가 코드로 토큰을 받은 뒤 프로필 API 로 사용자를 확인한다.
+// 네이버 계정의 이메일은 인증된 이메일 입니다.
+type naverProvider struct {
+	log    *zap.Logger
+	cfg    Config
+	client *http.Client
+}
+
+type naverProfile struct {
+	ResultCode string `json:"resultcode"`
+	Message    string `json:"message"`
+	Response   struct {
+		ID       string `json:"id"`
+		Email    string `json:"email"`
+		Name     string `json:"name"`
+		Nickname string `json:"nickname"`
+	} `json:"response"`
+}
+
+func (p *naverProvider) endpoint() oauth2.Endpoint {
+	return oauth2.Endpoint{
+		AuthURL:  p.cfg.AuthURL,
+		TokenURL: p.cfg.TokenURL,
+	}
+}
+
+func (p *naverProvider) AuthCodeURL(_ context.Context, state *domain.OAuthState) (string, error) {
+	return p.cfg.oauth2Config(p.endpoint(), state.RedirectURL).AuthCodeURL(state.State,
+		oauth2.S256ChallengeOption(state.CodeVerifier),
+	), nil
+}
+
+func (p *naverProvider) Exchange(ctx context.Context, state *domain.OAuthState, code string) (*domain.OAuthUserInfo, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.client)
+	conf := p.cfg.oauth2Config(p.endpoint(), state.RedirectURL)
+	token, err := conf.Exchange(ctx, code,
+		oauth2.VerifierOption(state.CodeVerifier),
+		oauth2.SetAuthURLParam("state", state.State),
+	)
+	if err != nil {
+		return nil, exchangeError(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.cfg.UserInfoURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := conf.Client(ctx, token).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		p.log.Warn("네이버 프로필 조회 실패", zap.Int("status", resp.StatusCode))
+		return nil, fmt.Errorf("%w: 프로필 조회 실패 (%d)", domain.ErrOAuthInvalidRequest, resp.StatusCode)
+	}
+
+	var profile naverProfile
+	if err := json.NewDecoder(resp.Body).Decode(&profile); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrOAuthInvalidRequest, err)
+	}
+	if profile.ResultCode != "00" || profile.Response.ID == "" {
+		return nil, fmt.Errorf("%w: %s", domain.ErrOAuthInvalidRequest, profile.Message)
+	}
+
+	info := &domain.OAuthUserInfo{
+		Subject:       profile.Response.ID,
+		Email:         profile.Response.Email,
+		EmailVerified: true,
+		Name:          profile.Response.Name,
+	}
+	if info.Name == "" {
+		info.Name = profile.Response.Nickname
+	}
+
+	return info, nil
+}
+
+// NewNaverProvider 네이버 로그인 제공자
+func NewNaverProvider(log *zap.Logger, cfg Config) domain.OAuthProvider {
+	return &naverProvider{
+		log:    log,
+		cfg:    cfg,
+		client: newHTTPClient(),
+	}
+}
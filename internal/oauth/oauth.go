@@ -0,0 +1,60 @@
+package oauth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"golang.org/x/oauth2"
+)
+
+// 소셜 로그인 제공자 이름 입니다. 로그인 API 의 type 으로도 사용한다.
+const (
+	ProviderGoogle = "google"
+	ProviderKakao  = "kakao"
+	ProviderNaver  = "naver"
+)
+
+// requestTimeout 제공자 서버 요청 제한시간 입니다.
+const requestTimeout = 10 * time.Second
+
+// Config
+//
+// 소셜 로그인 제공자 설정 입니다.
+// OIDC 제공자는 Issuer 로 엔드포인트를 찾고, OAuth2 만 지원하는 제공자는 AuthURL, TokenURL, UserInfoURL 을 사용합니다.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	Issuer       string
+	AuthURL      string
+	TokenURL     string
+	UserInfoURL  string
+	Scopes       []string
+}
+
+func (c *Config) oauth2Config(endpoint oauth2.Endpoint, redirectURL string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		Endpoint:     endpoint,
+		RedirectURL:  redirectURL,
+		Scopes:       c.Scopes,
+	}
+}
+
+func newHTTPClient() *http.Client {
+	return &http.Client{Timeout: requestTimeout}
+}
+
+// exchangeError 인가 코드 교환 오류를 구분합니다.
+//
+// 제공자가 요청을 거절한 경우는 ErrOAuthInvalidRequest, 그 외 연결 오류는 ErrUnavailable 로 감싼다.
+func exchangeError(err error) error {
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) {
+		return fmt.Errorf("%w: %s", domain.ErrOAuthInvalidRequest, retrieveErr.ErrorCode)
+	}
+	return fmt.Errorf("%w: %s", domain.ErrUnavailable, err)
+}
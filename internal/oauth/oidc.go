@@ -0,0 +1,128 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+type oidcProvider struct {
+	log    *zap.Logger
+	cfg    Config
+	client *http.Client
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+}
+
+// discover
+//
+// 서버 시작이 제공자 상태에 영향을 받지 않도록 처음 사용할 때 discovery 문서를 조회한다.
+// 실패하면 다음 요청에서 다시 시도한다.
+func (p *oidcProvider) discover(ctx context.Context) (*oidc.Provider, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.provider != nil {
+		return p.provider, nil
+	}
+
+	provider, err := oidc.NewProvider(oidc.ClientContext(context.WithoutCancel(ctx), p.client), p.cfg.Issuer)
+	if err != nil {
+		p.log.Warn("OIDC discovery 실패", zap.String("issuer", p.cfg.Issuer), zap.Error(err))
+		return nil, fmt.Errorf("%w: %s", domain.ErrUnavailable, err)
+	}
+	p.provider = provider
+
+	return provider, nil
+}
+
+func (p *oidcProvider) AuthCodeURL(ctx context.Context, state *domain.OAuthState) (string, error) {
+	provider, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return p.cfg.oauth2Config(provider.Endpoint(), state.RedirectURL).AuthCodeURL(state.State,
+		oauth2.S256ChallengeOption(state.CodeVerifier),
+		oidc.Nonce(state.Nonce),
+	), nil
+}
+
+func (p *oidcProvider) Exchange(ctx context.Context, state *domain.OAuthState, code string) (*domain.OAuthUserInfo, error) {
+	provider, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = oidc.ClientContext(ctx, p.client)
+	token, err := p.cfg.oauth2Config(provider.Endpoint(), state.RedirectURL).Exchange(ctx, code,
+		oauth2.VerifierOption(state.CodeVerifier),
+	)
+	if err != nil {
+		return nil, exchangeError(err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: id_token 없음", domain.ErrOAuthInvalidRequest)
+	}
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: p.cfg.ClientID}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrOAuthInvalidRequest, err)
+	}
+	if idToken.Nonce != state.Nonce {
+		return nil, fmt.Errorf("%w: nonce 불일치", domain.ErrOAuthInvalidRequest)
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified *bool  `json:"email_verified"`
+		Name          string `json:"name"`
+		Nickname      string `json:"nickname"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrOAuthInvalidRequest, err)
+	}
+
+	// id_token 에 이메일이나 인증 여부가 없는 제공자(Kakao 등)는 userinfo 에서 확인한다.
+	if (claims.Email == "" || claims.EmailVerified == nil) && provider.UserInfoEndpoint() != "" {
+		userInfo, err := provider.UserInfo(ctx, oauth2.StaticTokenSource(token))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", domain.ErrUnavailable, err)
+		}
+		if userInfo.Subject != idToken.Subject {
+			return nil, fmt.Errorf("%w: userinfo sub 불일치", domain.ErrOAuthInvalidRequest)
+		}
+		if err := userInfo.Claims(&claims); err != nil {
+			return nil, fmt.Errorf("%w: %s", domain.ErrOAuthInvalidRequest, err)
+		}
+	}
+
+	info := &domain.OAuthUserInfo{
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified != nil && *claims.EmailVerified,
+		Name:          claims.Name,
+	}
+	if info.Name == "" {
+		info.Name = claims.Nickname
+	}
+
+	return info, nil
+}
+
+// NewOIDCProvider OIDC 를 지원하는 제공자(Google, Kakao 등)
+func NewOIDCProvider(log *zap.Logger, cfg Config) domain.OAuthProvider {
+	return &oidcProvider{
+		log:    log,
+		cfg:    cfg,
+		client: newHTTPClient(),
+	}
+}
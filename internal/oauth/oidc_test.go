@@ -0,0 +1,242 @@
+package oauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+const testClientID = "client"
+
+// fakeOIDCServer discovery, jwks, token, userinfo 엔드포인트만 제공하는 OIDC 제공자 입니다.
+type fakeOIDCServer struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+
+	// idTokenClaims 토큰 응답의 id_token claim 입니다. iss, aud, exp, iat 는 자동으로 채운다.
+	idTokenClaims map[string]any
+	// userInfo userinfo 응답 입니다. nil 이면 404 를 응답한다.
+	userInfo map[string]any
+	// codeChallenge 인가 URL 의 code_challenge 입니다. 토큰 요청의 code_verifier 와 비교한다.
+	codeChallenge string
+}
+
+func newFakeOIDCServer(t *testing.T) *fakeOIDCServer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &fakeOIDCServer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{
+			"issuer":                                s.URL,
+			"authorization_endpoint":                s.URL + "/authorize",
+			"token_endpoint":                        s.URL + "/token",
+			"userinfo_endpoint":                     s.URL + "/userinfo",
+			"jwks_uri":                              s.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{"keys": []map[string]any{{
+			"kty": "RSA",
+			"kid": "test",
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		sum := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+		if r.PostFormValue("code") != "code" || base64.RawURLEncoding.EncodeToString(sum[:]) != s.codeChallenge {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		writeJSON(w, map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     s.idToken(t),
+		})
+	})
+	mux.HandleFunc("GET /userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if s.userInfo == nil || r.Header.Get("Authorization") != "Bearer access" {
+			http.NotFound(w, r)
+			return
+		}
+		writeJSON(w, s.userInfo)
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// idToken RS256 으로 서명한 id_token 을 만듭니다.
+func (s *fakeOIDCServer) idToken(t *testing.T) string {
+	claims := map[string]any{
+		"iss": s.URL,
+		"aud": testClientID,
+		"iat": time.Now().Unix(),
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range s.idTokenClaims {
+		claims[k] = v
+	}
+
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "test", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	sum := sha256.Sum256([]byte(signingInput))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, sum[:])
+	if err != nil {
+		t.Error(err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestOIDCProvider(t *testing.T) {
+	tests := []struct {
+		name     string
+		claims   map[string]any
+		userInfo map[string]any
+		code     string
+		want     *domain.OAuthUserInfo
+		wantErr  error
+	}{
+		{
+			name:   "인증된 이메일",
+			claims: map[string]any{"sub": "1", "nonce": "nonce", "email": "a@example.com", "email_verified": true, "name": "사용자"},
+			want:   &domain.OAuthUserInfo{Subject: "1", Email: "a@example.com", EmailVerified: true, Name: "사용자"},
+		},
+		{
+			name:   "인증되지 않은 이메일",
+			claims: map[string]any{"sub": "1", "nonce": "nonce", "email": "a@example.com", "email_verified": false},
+			want:   &domain.OAuthUserInfo{Subject: "1", Email: "a@example.com"},
+		},
+		{
+			name:     "email_verified 가 없으면 인증되지 않은 것으로 본다",
+			claims:   map[string]any{"sub": "1", "nonce": "nonce", "email": "a@example.com", "nickname": "별명"},
+			userInfo: map[string]any{"sub": "1", "email": "a@example.com"},
+			want:     &domain.OAuthUserInfo{Subject: "1", Email: "a@example.com", Name: "별명"},
+		},
+		{
+			name:     "id_token 에 없는 값은 userinfo 에서 확인",
+			claims:   map[string]any{"sub": "1", "nonce": "nonce"},
+			userInfo: map[string]any{"sub": "1", "email": "a@example.com", "email_verified": true},
+			want:     &domain.OAuthUserInfo{Subject: "1", Email: "a@example.com", EmailVerified: true},
+		},
+		{
+			name:     "userinfo sub 불일치",
+			claims:   map[string]any{"sub": "1", "nonce": "nonce"},
+			userInfo: map[string]any{"sub": "2", "email": "a@example.com", "email_verified": true},
+			wantErr:  domain.ErrOAuthInvalidRequest,
+		},
+		{
+			name:    "nonce 불일치",
+			claims:  map[string]any{"sub": "1", "nonce": "other", "email": "a@example.com", "email_verified": true},
+			wantErr: domain.ErrOAuthInvalidRequest,
+		},
+		{
+			name:    "다른 client 의 id_token",
+			claims:  map[string]any{"sub": "1", "nonce": "nonce", "aud": "other"},
+			wantErr: domain.ErrOAuthInvalidRequest,
+		},
+		{
+			name:    "제공자가 인가 코드를 거절",
+			claims:  map[string]any{"sub": "1", "nonce": "nonce"},
+			code:    "wrong",
+			wantErr: domain.ErrOAuthInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newFakeOIDCServer(t)
+			srv.idTokenClaims = tt.claims
+			srv.userInfo = tt.userInfo
+
+			p := NewOIDCProvider(zap.NewNop(), Config{
+				ClientID:     testClientID,
+				ClientSecret: "secret",
+				Issuer:       srv.URL,
+				Scopes:       []string{"openid", "email"},
+			})
+			state := &domain.OAuthState{
+				State:        "state",
+				CodeVerifier: "verifier-verifier-verifier-verifier-verifier",
+				Nonce:        "nonce",
+				RedirectURL:  "https://example.com/oauth/callback",
+			}
+
+			authURL, err := p.AuthCodeURL(context.Background(), state)
+			if err != nil {
+				t.Fatalf("AuthCodeURL() error = %v", err)
+			}
+			u, err := url.Parse(authURL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			q := u.Query()
+			if q.Get("state") != "state" || q.Get("nonce") != "nonce" || q.Get("code_challenge_method") != "S256" {
+				t.Fatalf("AuthCodeURL() = %s", authURL)
+			}
+			srv.codeChallenge = q.Get("code_challenge")
+
+			code := tt.code
+			if code == "" {
+				code = "code"
+			}
+			got, err := p.Exchange(context.Background(), state, code)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Exchange() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Exchange() error = %v", err)
+			}
+			if *got != *tt.want {
+				t.Errorf("Exchange() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOIDCProviderUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+
+	p := NewOIDCProvider(zap.NewNop(), Config{ClientID: testClientID, Issuer: srv.URL})
+	if _, err := p.AuthCodeURL(context.Background(), &domain.OAuthState{}); !errors.Is(err, domain.ErrUnavailable) {
+		t.Errorf("AuthCodeURL() error = %v, want %v", err, domain.ErrUnavailable)
+	}
+}
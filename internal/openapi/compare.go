@@ -0,0 +1,348 @@
+package openapi
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// Change
+//
+// 두 OpenAPI 문서 사이의 변경 사항 입니다.
+type Change struct {
+	Breaking bool
+	Location string // "GET /api/v1/user"
+	Message  string
+}
+
+func (c *Change) String() string {
+	level := "INFO    "
+	if c.Breaking {
+		level = "BREAKING"
+	}
+	return fmt.Sprintf("%s %s: %s", level, c.Location, c.Message)
+}
+
+type direction int
+
+const (
+	request direction = iota
+	response
+)
+
+type document struct {
+	raw map[string]any
+}
+
+func parse(b []byte) (*document, error) {
+	var raw map[string]any
+	// yaml 은 json 의 상위 집합이므로 두 형식 모두 해석 가능
+	if err := yaml.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+	if _, ok := raw["openapi"]; !ok {
+		return nil, fmt.Errorf("openapi 문서가 아닙니다")
+	}
+	return &document{raw: raw}, nil
+}
+
+// Compare
+//
+// 이전 문서(oldSpec) 대비 새 문서(newSpec)의 변경 사항을 반환합니다.
+// OpenAPI 3.0, 3.1 문서와 json, yaml 형식을 모두 지원합니다.
+// 기존 클라이언트가 동작하지 않게 되는 변경은 Breaking 으로 표시합니다.
+func Compare(oldSpec []byte, newSpec []byte) ([]*Change, error) {
+	oldDoc, err := parse(oldSpec)
+	if err != nil {
+		return nil, fmt.Errorf("이전 문서 해석 실패: %w", err)
+	}
+	newDoc, err := parse(newSpec)
+	if err != nil {
+		return nil, fmt.Errorf("새 문서 해석 실패: %w", err)
+	}
+
+	c := &comparer{oldDoc: oldDoc, newDoc: newDoc}
+	c.comparePaths()
+
+	sort.SliceStable(c.changes, func(i, j int) bool {
+		if c.changes[i].Breaking != c.changes[j].Breaking {
+			return c.changes[i].Breaking
+		}
+		return c.changes[i].Location < c.changes[j].Location
+	})
+
+	return c.changes, nil
+}
+
+type comparer struct {
+	oldDoc, newDoc *document
+	changes        []*Change
+}
+
+func (c *comparer) add(breaking bool, location string, format string, args ...any) {
+	c.changes = append(c.changes, &Change{
+		Breaking: breaking,
+		Location: location,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *comparer) comparePaths() {
+	oldPaths := asMap(c.oldDoc.raw["paths"])
+	newPaths := asMap(c.newDoc.raw["paths"])
+
+	for _, path := range sortedKeys(oldPaths) {
+		oldItem := asMap(oldPaths[path])
+		newItem := asMap(newPaths[path])
+		for _, method := range methods {
+			oldOp, ok := oldItem[method]
+			if !ok {
+				continue
+			}
+			location := strings.ToUpper(method) + " " + path
+			newOp, ok := newItem[method]
+			if !ok {
+				c.add(true, location, "오퍼레이션이 삭제되었습니다")
+				continue
+			}
+			c.compareOperation(location, asMap(oldOp), asMap(newOp))
+		}
+	}
+
+	for _, path := range sortedKeys(newPaths) {
+		oldItem := asMap(oldPaths[path])
+		newItem := asMap(newPaths[path])
+		for _, method := range methods {
+			if _, ok := newItem[method]; ok {
+				if _, ok := oldItem[method]; !ok {
+					c.add(false, strings.ToUpper(method)+" "+path, "오퍼레이션이 추가되었습니다")
+				}
+			}
+		}
+	}
+}
+
+func (c *comparer) compareOperation(location string, oldOp, newOp map[string]any) {
+	// parameters
+	oldParams := paramsByKey(oldOp["parameters"])
+	newParams := paramsByKey(newOp["parameters"])
+	for _, key := range sortedKeys(newParams) {
+		newParam := newParams[key]
+		oldParam, existed := oldParams[key]
+		required, _ := newParam["required"].(bool)
+		wasRequired, _ := oldParam["required"].(bool)
+
+		switch {
+		case !existed && required:
+			c.add(true, location, "필수 파라미터 %s 가 추가되었습니다", key)
+		case !existed:
+			c.add(false, location, "선택 파라미터 %s 가 추가되었습니다", key)
+		case required && !wasRequired:
+			c.add(true, location, "파라미터 %s 가 필수로 변경되었습니다", key)
+		}
+		if existed {
+			c.compareSchema(location, "파라미터 "+key, request, asMap(oldParam["schema"]), asMap(newParam["schema"]), 0)
+		}
+	}
+	// 삭제된 파라미터를 보내는 기존 클라이언트는 거절되거나 다르게 동작할 수 있다.
+	for _, key := range sortedKeys(oldParams) {
+		if _, ok := newParams[key]; !ok {
+			c.add(true, location, "파라미터 %s 가 삭제되었습니다", key)
+		}
+	}
+
+	// request body
+	oldBody := asMap(oldOp["requestBody"])
+	newBody := asMap(newOp["requestBody"])
+	if newRequired, _ := newBody["required"].(bool); newRequired && len(oldBody) == 0 {
+		c.add(true, location, "필수 요청 본문이 추가되었습니다")
+	}
+	if len(oldBody) > 0 && len(newBody) > 0 {
+		oldContent := asMap(oldBody["content"])
+		newContent := asMap(newBody["content"])
+		for _, mediaType := range sortedKeys(oldContent) {
+			if _, ok := newContent[mediaType]; !ok {
+				c.add(true, location, "요청 본문 %s 형식이 삭제되었습니다", mediaType)
+				continue
+			}
+			c.compareSchema(location, "요청 본문", request,
+				asMap(asMap(oldContent[mediaType])["schema"]),
+				asMap(asMap(newContent[mediaType])["schema"]),
+				0,
+			)
+		}
+	}
+
+	// responses (성공 응답만 비교)
+	oldResponses := asMap(oldOp["responses"])
+	newResponses := asMap(newOp["responses"])
+	for _, status := range sortedKeys(oldResponses) {
+		if !strings.HasPrefix(status, "2") {
+			continue
+		}
+		newResp, ok := newResponses[status]
+		if !ok {
+			c.add(true, location, "응답 %s 가 삭제되었습니다", status)
+			continue
+		}
+		oldContent := asMap(asMap(oldResponses[status])["content"])
+		newContent := asMap(asMap(newResp)["content"])
+		for _, mediaType := range sortedKeys(oldContent) {
+			if _, ok := newContent[mediaType]; !ok {
+				c.add(true, location, "응답 %s 의 %s 형식이 삭제되었습니다", status, mediaType)
+				continue
+			}
+			c.compareSchema(location, "응답 "+status, response,
+				asMap(asMap(oldContent[mediaType])["schema"]),
+				asMap(asMap(newContent[mediaType])["schema"]),
+				0,
+			)
+		}
+	}
+}
+
+const maxDepth = 16
+
+func (c *comparer) compareSchema(location string, at string, dir direction, oldSchema, newSchema map[string]any, depth int) {
+	if depth > maxDepth || len(oldSchema) == 0 || len(newSchema) == 0 {
+		return
+	}
+	oldSchema = c.oldDoc.resolve(oldSchema)
+	newSchema = c.newDoc.resolve(newSchema)
+
+	oldTypes, newTypes := schemaTypes(oldSchema), schemaTypes(newSchema)
+	if len(oldTypes) > 0 && len(newTypes) > 0 && !slices.Equal(oldTypes, newTypes) {
+		c.add(true, location, "%s 의 타입이 %v 에서 %v 로 변경되었습니다", at, oldTypes, newTypes)
+		return
+	}
+
+	// 요청 값의 enum 이 줄어들면 기존 값을 보낼 수 없다.
+	if dir == request {
+		newEnum := asSlice(newSchema["enum"])
+		if len(newEnum) > 0 {
+			for _, v := range asSlice(oldSchema["enum"]) {
+				if !slices.ContainsFunc(newEnum, func(e any) bool { return fmt.Sprint(e) == fmt.Sprint(v) }) {
+					c.add(true, location, "%s 의 허용값 %v 가 삭제되었습니다", at, v)
+				}
+			}
+		}
+	}
+
+	oldRequired := asStrings(oldSchema["required"])
+	newRequired := asStrings(newSchema["required"])
+	oldProps := asMap(oldSchema["properties"])
+	newProps := asMap(newSchema["properties"])
+
+	switch dir {
+	case request:
+		for _, name := range newRequired {
+			if !slices.Contains(oldRequired, name) {
+				c.add(true, location, "%s 에 필수 필드 %s 가 추가되었습니다", at, name)
+			}
+		}
+		if additional, ok := newSchema["additionalProperties"].(bool); ok && !additional {
+			for _, name := range sortedKeys(oldProps) {
+				if _, ok := newProps[name]; !ok {
+					c.add(true, location, "%s 의 필드 %s 가 삭제되었습니다", at, name)
+				}
+			}
+		}
+	case response:
+		for _, name := range sortedKeys(oldProps) {
+			if _, ok := newProps[name]; !ok {
+				c.add(true, location, "%s 의 필드 %s 가 삭제되었습니다", at, name)
+			}
+		}
+		for _, name := range oldRequired {
+			if !slices.Contains(newRequired, name) {
+				if _, ok := newProps[name]; ok {
+					c.add(true, location, "%s 의 필드 %s 가 선택값으로 변경되었습니다", at, name)
+				}
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(oldProps) {
+		if newProp, ok := newProps[name]; ok {
+			c.compareSchema(location, at+"."+name, dir, asMap(oldProps[name]), asMap(newProp), depth+1)
+		}
+	}
+
+	if oldItems, ok := oldSchema["items"]; ok {
+		c.compareSchema(location, at+"[]", dir, asMap(oldItems), asMap(newSchema["items"]), depth+1)
+	}
+}
+
+// resolve "#/components/schemas/..." 참조를 따라갑니다.
+func (d *document) resolve(schema map[string]any) map[string]any {
+	for i := 0; i < maxDepth; i++ {
+		ref, ok := schema["$ref"].(string)
+		if !ok {
+			return schema
+		}
+		node := any(d.raw)
+		for _, part := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+			node = asMap(node)[part]
+		}
+		schema = asMap(node)
+	}
+	return schema
+}
+
+// schemaTypes 3.0(nullable) 과 3.1(type 배열) 표현을 동일하게 비교하기 위해 null 을 제외한 타입 목록을 반환합니다.
+func schemaTypes(schema map[string]any) []string {
+	var types []string
+	switch t := schema["type"].(type) {
+	case string:
+		types = []string{t}
+	case []any:
+		for _, v := range t {
+			if s, ok := v.(string); ok && s != "null" {
+				types = append(types, s)
+			}
+		}
+	}
+	slices.Sort(types)
+	return types
+}
+
+func paramsByKey(v any) map[string]map[string]any {
+	params := make(map[string]map[string]any)
+	for _, p := range asSlice(v) {
+		param := asMap(p)
+		params[fmt.Sprintf("%v:%v", param["in"], param["name"])] = param
+	}
+	return params
+}
+
+func asMap(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	return m
+}
+
+func asSlice(v any) []any {
+	s, _ := v.([]any)
+	return s
+}
+
+func asStrings(v any) []string {
+	var out []string
+	for _, s := range asSlice(v) {
+		out = append(out, fmt.Sprint(s))
+	}
+	return out
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,178 @@
+package openapi
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// baseSpec 비교 기준이 되는 OpenAPI 3.1 문서 입니다.
+const baseSpec = `
+openapi: 3.1.0
+paths:
+  /api/v1/device:
+    get:
+      parameters:
+        - {in: query, name: page, schema: {type: integer}}
+        - {in: query, name: crop, schema: {type: string, enum: [tomato, strawberry]}}
+      responses:
+        "200":
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/Device"}
+    post:
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              type: object
+              additionalProperties: false
+              required: [title]
+              properties:
+                title: {type: string}
+                memo: {type: string}
+      responses:
+        "201": {}
+components:
+  schemas:
+    Device:
+      type: object
+      required: [id, title]
+      properties:
+        id: {type: string}
+        title: {type: string}
+        memo: {type: [string, "null"]}
+`
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name    string
+		replace [][2]string // baseSpec 에서 바꿀 문자열
+		want    []string    // 포함되어야 하는 변경 사항
+	}{
+		{
+			name: "변경 없음",
+		},
+		{
+			name:    "오퍼레이션 삭제",
+			replace: [][2]string{{"    post:", "    x-post:"}},
+			want:    []string{"BREAKING POST /api/v1/device: 오퍼레이션이 삭제되었습니다"},
+		},
+		{
+			name:    "오퍼레이션 추가",
+			replace: [][2]string{{"    post:", "    put:\n      responses: {}\n    post:"}},
+			want:    []string{"INFO     PUT /api/v1/device: 오퍼레이션이 추가되었습니다"},
+		},
+		{
+			name:    "파라미터 삭제",
+			replace: [][2]string{{"        - {in: query, name: page, schema: {type: integer}}\n", ""}},
+			want:    []string{"BREAKING GET /api/v1/device: 파라미터 query:page 가 삭제되었습니다"},
+		},
+		{
+			name:    "필수 파라미터 추가",
+			replace: [][2]string{{"name: page,", "name: page, required: true,"}},
+			want:    []string{"BREAKING GET /api/v1/device: 파라미터 query:page 가 필수로 변경되었습니다"},
+		},
+		{
+			name:    "선택 파라미터 추가",
+			replace: [][2]string{{"      parameters:\n", "      parameters:\n        - {in: query, name: size, schema: {type: integer}}\n"}},
+			want:    []string{"INFO     GET /api/v1/device: 선택 파라미터 query:size 가 추가되었습니다"},
+		},
+		{
+			name:    "파라미터 타입 변경",
+			replace: [][2]string{{"name: page, schema: {type: integer}", "name: page, schema: {type: string}"}},
+			want:    []string{"BREAKING GET /api/v1/device: 파라미터 query:page 의 타입이 [integer] 에서 [string] 로 변경되었습니다"},
+		},
+		{
+			name:    "요청 enum 축소",
+			replace: [][2]string{{"enum: [tomato, strawberry]", "enum: [tomato]"}},
+			want:    []string{"BREAKING GET /api/v1/device: 파라미터 query:crop 의 허용값 strawberry 가 삭제되었습니다"},
+		},
+		{
+			name:    "요청 필수 필드 추가",
+			replace: [][2]string{{"required: [title]", "required: [title, memo]"}},
+			want:    []string{"BREAKING POST /api/v1/device: 요청 본문 에 필수 필드 memo 가 추가되었습니다"},
+		},
+		{
+			name:    "응답 필드 삭제",
+			replace: [][2]string{{"        memo: {type: [string, \"null\"]}\n", ""}},
+			want:    []string{"BREAKING GET /api/v1/device: 응답 200 의 필드 memo 가 삭제되었습니다"},
+		},
+		{
+			name:    "응답 필수 필드가 선택으로 변경",
+			replace: [][2]string{{"required: [id, title]", "required: [id]"}},
+			want:    []string{"BREAKING GET /api/v1/device: 응답 200 의 필드 title 가 선택값으로 변경되었습니다"},
+		},
+		{
+			name:    "3.0 nullable 과 3.1 type 배열은 같은 타입",
+			replace: [][2]string{{`memo: {type: [string, "null"]}`, "memo: {type: string, nullable: true}"}},
+		},
+		{
+			name:    "응답 삭제",
+			replace: [][2]string{{`"201": {}`, `"204": {}`}},
+			want:    []string{"BREAKING POST /api/v1/device: 응답 201 가 삭제되었습니다"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newSpec := baseSpec
+			for _, r := range tt.replace {
+				if !strings.Contains(newSpec, r[0]) {
+					t.Fatalf("baseSpec 에 %q 가 없습니다", r[0])
+				}
+				newSpec = strings.Replace(newSpec, r[0], r[1], 1)
+			}
+
+			changes, err := Compare([]byte(baseSpec), []byte(newSpec))
+			if err != nil {
+				t.Fatalf("Compare() error = %v", err)
+			}
+
+			got := make([]string, 0, len(changes))
+			for _, c := range changes {
+				got = append(got, c.String())
+			}
+			if len(tt.want) == 0 && len(got) > 0 {
+				t.Errorf("Compare() = %q, want 변경 없음", got)
+			}
+			for _, want := range tt.want {
+				if !slices.Contains(got, want) {
+					t.Errorf("Compare() = %q, want %q 포함", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCompareBreakingFirst(t *testing.T) {
+	newSpec := strings.Replace(baseSpec, "    post:", "    x-post:", 1)
+	newSpec = strings.Replace(newSpec, "      parameters:\n", "      parameters:\n        - {in: query, name: size, schema: {type: integer}}\n", 1)
+
+	changes, err := Compare([]byte(baseSpec), []byte(newSpec))
+	if err != nil {
+		t.Fatalf("Compare() error = %v", err)
+	}
+	if len(changes) != 2 || !changes[0].Breaking || changes[1].Breaking {
+		t.Errorf("Compare() = %v, want breaking 변경이 먼저", changes)
+	}
+}
+
+func TestCompareInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+	}{
+		{name: "이전 문서 오류", old: "paths: {}", new: baseSpec},
+		{name: "새 문서 오류", old: baseSpec, new: "{"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Compare([]byte(tt.old), []byte(tt.new)); err == nil {
+				t.Error("Compare() error = nil")
+			}
+		})
+	}
+}
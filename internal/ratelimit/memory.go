@@ -0,0 +1,71 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/GDH-Project/api/internal/job"
+)
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	period  time.Duration
+}
+
+// memoryStore
+//
+// 단일 인스턴스용 메모리 저장소 입니다.
+type memoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+func (s *memoryStore) Take(_ context.Context, key string, rule Rule) (*Result, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(rule.Requests), updated: now}
+		s.buckets[key] = b
+	}
+	b.period = rule.Period
+
+	tokens := min(float64(rule.Requests), b.tokens+now.Sub(b.updated).Seconds()*rule.rate())
+	allowed := tokens >= 1
+	if allowed {
+		tokens--
+	}
+	b.tokens = tokens
+	b.updated = now
+
+	return newResult(rule, allowed, tokens), nil
+}
+
+// cleanup 가득 찬 상태로 충분히 오래된 bucket 을 삭제합니다.
+func (s *memoryStore) cleanup(_ context.Context) error {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, b := range s.buckets {
+		if now.Sub(b.updated) > b.period {
+			delete(s.buckets, key)
+		}
+	}
+
+	return nil
+}
+
+func NewMemoryStore(jobs *job.Runner) Store {
+	s := &memoryStore{
+		buckets: make(map[string]*bucket),
+	}
+	jobs.Every("rate_limit_memory_cleanup", time.Minute, s.cleanup)
+
+	return s
+}
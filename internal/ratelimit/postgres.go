@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/GDH-Project/api/internal/job"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// postgresStore
+//
+// 여러 인스턴스가 카운터를 공유하기 위한 Postgres 저장소 입니다.
+// token 계산은 한번의 UPSERT 로 처리되어 동시 요청에도 원자적으로 동작합니다.
+type postgresStore struct {
+	log *zap.Logger
+	db  *pgxpool.Pool
+}
+
+func (s *postgresStore) Take(ctx context.Context, key string, rule Rule) (*Result, error) {
+	q := `
+		INSERT INTO ratelimit.bucket AS b (key, tokens, allowed, updated_at, expires_at)
+		VALUES ($1, $2::FLOAT8 - 1, TRUE, now(), now() + $4::INTERVAL)
+		ON CONFLICT (key) DO UPDATE SET
+			allowed    = LEAST($2::FLOAT8, b.tokens + EXTRACT(EPOCH FROM (now() - b.updated_at))::FLOAT8 * $3::FLOAT8) >= 1,
+			tokens     = LEAST($2::FLOAT8, b.tokens + EXTRACT(EPOCH FROM (now() - b.updated_at))::FLOAT8 * $3::FLOAT8)
+				- CASE WHEN LEAST($2::FLOAT8, b.tokens + EXTRACT(EPOCH FROM (now() - b.updated_at))::FLOAT8 * $3::FLOAT8) >= 1 THEN 1 ELSE 0 END,
+			updated_at = now(),
+			expires_at = now() + $4::INTERVAL
+		RETURNING tokens, allowed;
+	`
+	var tokens float64
+	var allowed bool
+	if err := s.db.QueryRow(ctx, q,
+		key,
+		float64(rule.Requests),
+		rule.rate(),
+		rule.Period,
+	).Scan(&tokens, &allowed); err != nil {
+		return nil, err
+	}
+
+	return newResult(rule, allowed, tokens), nil
+}
+
+// cleanup 만료된 bucket 을 삭제합니다.
+func (s *postgresStore) cleanup(ctx context.Context) error {
+	tag, err := s.db.Exec(ctx, `DELETE FROM ratelimit.bucket WHERE expires_at < now();`)
+	if err != nil {
+		return err
+	}
+	s.log.Debug("만료된 rate limit bucket 삭제", zap.Int64("deleted", tag.RowsAffected()))
+
+	return nil
+}
+
+func NewPostgresStore(log *zap.Logger, db *pgxpool.Pool, jobs *job.Runner) Store {
+	s := &postgresStore{
+		log: log,
+		db:  db,
+	}
+	jobs.Every("rate_limit_postgres_cleanup", 5*time.Minute, s.cleanup)
+
+	return s
+}
@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+// Rule
+//
+// Period 동안 Requests 회 요청을 허용하는 token bucket 규칙 입니다.
+// Requests 가 0 이하면 제한하지 않습니다.
+type Rule struct {
+	Requests int
+	Period   time.Duration
+}
+
+func (r Rule) Unlimited() bool {
+	return r.Requests <= 0 || r.Period <= 0
+}
+
+// rate 초당 충전되는 token 수
+func (r Rule) rate() float64 {
+	return float64(r.Requests) / r.Period.Seconds()
+}
+
+// Result 요청 허용 여부와 응답 헤더에 필요한 정보 입니다.
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration // bucket 이 가득 찰 때까지 남은 시간
+	RetryAfter time.Duration // 거부된 경우 다음 요청 가능 시간
+}
+
+// newResult 요청 처리 후 남은 token 으로 Result 를 계산합니다.
+func newResult(rule Rule, allowed bool, tokens float64) *Result {
+	rate := rule.rate()
+
+	result := &Result{
+		Allowed:   allowed,
+		Limit:     rule.Requests,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     time.Duration((float64(rule.Requests) - tokens) / rate * float64(time.Second)),
+	}
+	if !allowed {
+		result.RetryAfter = time.Duration((1 - tokens) / rate * float64(time.Second))
+	}
+
+	return result
+}
+
+// Store token bucket 저장소 입니다.
+type Store interface {
+	// Take key 에 해당하는 bucket 에서 token 1개를 사용합니다.
+	Take(ctx context.Context, key string, rule Rule) (*Result, error)
+}
+
+// Limiter
+//
+// 오퍼레이션 별 규칙으로 요청을 제한합니다.
+type Limiter struct {
+	log        *zap.Logger
+	store      Store
+	def        Rule
+	operations map[string]Rule
+}
+
+// Allow operationID 와 subject(IP, 사용자 등)로 요청 허용 여부를 판단합니다.
+//
+// 규칙이 없거나 저장소 오류가 발생한 경우 요청을 허용합니다. (fail open)
+func (l *Limiter) Allow(ctx context.Context, operationID string, subject string) *Result {
+	rule, ok := l.operations[operationID]
+	if !ok {
+		rule = l.def
+	}
+	if rule.Unlimited() {
+		return nil
+	}
+
+	result, err := l.store.Take(ctx, operationID+"|"+subject, rule)
+	if err != nil {
+		requestid.Logger(ctx, l.log).Error("rate limit 저장소 오류", zap.Error(err),
+			zap.String("operation", operationID),
+		)
+		return nil
+	}
+
+	return result
+}
+
+func NewLimiter(log *zap.Logger, store Store, def Rule, operations map[string]Rule) *Limiter {
+	return &Limiter{
+		log:        log,
+		store:      store,
+		def:        def,
+		operations: operations,
+	}
+}
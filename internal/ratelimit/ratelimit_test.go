@@ -0,0 +1,149 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GDH-Project/api/internal/job"
+	"go.uber.org/zap"
+)
+
+func TestMemoryStoreTake(t *testing.T) {
+	tests := []struct {
+		name      string
+		rule      Rule
+		takes     int
+		allowed   int
+		remaining int
+	}{
+		{name: "한도 안", rule: Rule{Requests: 5, Period: time.Minute}, takes: 3, allowed: 3, remaining: 2},
+		{name: "한도 도달", rule: Rule{Requests: 5, Period: time.Minute}, takes: 5, allowed: 5, remaining: 0},
+		{name: "한도 초과", rule: Rule{Requests: 5, Period: time.Minute}, takes: 8, allowed: 5, remaining: 0},
+		{name: "1회", rule: Rule{Requests: 1, Period: time.Hour}, takes: 2, allowed: 1, remaining: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewMemoryStore(job.NewRunner(zap.NewNop()))
+
+			var allowed int
+			var last *Result
+			for range tt.takes {
+				result, err := s.Take(context.Background(), "key", tt.rule)
+				if err != nil {
+					t.Fatalf("Take() error = %v", err)
+				}
+				if result.Allowed {
+					allowed++
+				}
+				last = result
+			}
+
+			if allowed != tt.allowed {
+				t.Errorf("allowed = %d, want %d", allowed, tt.allowed)
+			}
+			if last.Remaining != tt.remaining {
+				t.Errorf("Remaining = %d, want %d", last.Remaining, tt.remaining)
+			}
+			if last.Limit != tt.rule.Requests {
+				t.Errorf("Limit = %d, want %d", last.Limit, tt.rule.Requests)
+			}
+			if !last.Allowed && last.RetryAfter <= 0 {
+				t.Errorf("RetryAfter = %s, want > 0", last.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestMemoryStoreSeparateKeys(t *testing.T) {
+	s := NewMemoryStore(job.NewRunner(zap.NewNop()))
+	rule := Rule{Requests: 1, Period: time.Minute}
+
+	for _, key := range []string{"a", "b"} {
+		result, err := s.Take(context.Background(), key, rule)
+		if err != nil {
+			t.Fatalf("Take(%q) error = %v", key, err)
+		}
+		if !result.Allowed {
+			t.Errorf("Take(%q) Allowed = false, want true", key)
+		}
+	}
+}
+
+func TestNewResult(t *testing.T) {
+	rule := Rule{Requests: 60, Period: time.Minute} // 초당 1개
+
+	tests := []struct {
+		name       string
+		allowed    bool
+		tokens     float64
+		remaining  int
+		reset      time.Duration
+		retryAfter time.Duration
+	}{
+		{name: "가득 참", allowed: true, tokens: 60, remaining: 60, reset: 0},
+		{name: "일부 사용", allowed: true, tokens: 50.5, remaining: 50, reset: 9500 * time.Millisecond},
+		{name: "거부", allowed: false, tokens: 0.25, remaining: 0, reset: 59750 * time.Millisecond, retryAfter: 750 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := newResult(rule, tt.allowed, tt.tokens)
+			if result.Remaining != tt.remaining {
+				t.Errorf("Remaining = %d, want %d", result.Remaining, tt.remaining)
+			}
+			if result.Reset != tt.reset {
+				t.Errorf("Reset = %s, want %s", result.Reset, tt.reset)
+			}
+			if result.RetryAfter != tt.retryAfter {
+				t.Errorf("RetryAfter = %s, want %s", result.RetryAfter, tt.retryAfter)
+			}
+		})
+	}
+}
+
+func TestLimiterAllow(t *testing.T) {
+	l := NewLimiter(zap.NewNop(), NewMemoryStore(job.NewRunner(zap.NewNop())),
+		Rule{Requests: 2, Period: time.Minute},
+		map[string]Rule{
+			"signIn":  {Requests: 1, Period: time.Minute},
+			"healthz": {},
+		},
+	)
+
+	tests := []struct {
+		name        string
+		operationID string
+		subject     string
+		want        []bool // nil 이면 제한하지 않음
+	}{
+		{name: "오퍼레이션 규칙", operationID: "signIn", subject: "1.1.1.1", want: []bool{true, false}},
+		{name: "기본 규칙", operationID: "getUser", subject: "1.1.1.1", want: []bool{true, true, false}},
+		{name: "다른 대상", operationID: "signIn", subject: "2.2.2.2", want: []bool{true, false}},
+		{name: "제한 없음", operationID: "healthz", subject: "1.1.1.1", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.want == nil {
+				for range 10 {
+					if result := l.Allow(context.Background(), tt.operationID, tt.subject); result != nil {
+						t.Fatalf("Allow() = %+v, want nil", result)
+					}
+				}
+				return
+			}
+
+			for i, want := range tt.want {
+				result := l.Allow(context.Background(), tt.operationID, tt.subject)
+				if result == nil {
+					t.Fatalf("Allow() = nil")
+				}
+				if result.Allowed != want {
+					t.Errorf("%d 번째 Allowed = %v, want %v", i+1, result.Allowed, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// accountDataStep 탈퇴한 사용자 데이터 정리 단계 입니다.
+//
+// @user_id, @email, @pseudonym 을 사용할 수 있고 anonymize 가 비어있으면 purge 를 사용합니다.
+// 인증 정보(토큰, 2단계 인증, 소셜 계정)는 익명화 하지 않고 항상 삭제합니다.
+// auth.user_token_revocation 은 발급된 토큰이 만료될 때까지 거절해야 하므로 정리하지 않으며,
+// audit.event 는 수정, 삭제할 수 없는 감사 로그 이므로 정리하지 않는다.
+// 장치와 수집 데이터는 익명화 하지 않고 항상 삭제하며, 장치를 참조하는 테이블을 먼저 정리한다.
+type accountDataStep struct {
+	name      string
+	purge     string
+	anonymize string
+}
+
+var accountDataSteps = []accountDataStep{
+	{
+		name:  "personal_access_token",
+		purge: `DELETE FROM auth.personal_access_token WHERE user_id = @user_id`,
+	},
+	{
+		name:  "session",
+		purge: `DELETE FROM auth.session WHERE user_id = @user_id`,
+		anonymize: `
+			WITH token AS (
+				DELETE FROM auth.session_token t USING auth.session s
+					WHERE t.session_id = s.id AND s.user_id = @user_id
+			)
+			UPDATE auth.session SET user_id = @pseudonym, user_agent = '', client_ip = '', revoked_at = COALESCE(revoked_at, now())
+				WHERE user_id = @user_id`,
+	},
+	{
+		name:  "two_factor",
+		purge: `DELETE FROM auth.two_factor WHERE user_id = @user_id`,
+	},
+	{
+		name:  "two_factor_recovery_code",
+		purge: `DELETE FROM auth.two_factor_recovery_code WHERE user_id = @user_id`,
+	},
+	{
+		name:  "two_factor_challenge",
+		purge: `DELETE FROM auth.two_factor_challenge WHERE user_id = @user_id`,
+	},
+	{
+		name:  "oauth_identity",
+		purge: `DELETE FROM auth.oauth_identity WHERE user_id = @user_id`,
+	},
+	{
+		name:  "oauth_credential",
+		purge: `DELETE FROM auth.oauth_credential WHERE user_id = @user_id`,
+	},
+	{
+		name:  "email_token",
+		purge: `DELETE FROM auth.email_token WHERE user_id = @user_id`,
+	},
+	{
+		name:  "email_verification",
+		purge: `DELETE FROM auth.email_verification WHERE user_id = @user_id`,
+	},
+	{
+		name: "sign_in_failure",
+		purge: `
+			DELETE FROM auth.sign_in_failure
+				WHERE (kind = 'email' AND subject = @email AND @email <> '') OR (kind = 'two_factor' AND subject = @user_id)`,
+	},
+	{
+		name:      "user_suspension",
+		purge:     `DELETE FROM auth.user_suspension WHERE user_id = @user_id`,
+		anonymize: `UPDATE auth.user_suspension SET user_id = @pseudonym WHERE user_id = @user_id`,
+	},
+	{
+		// 관리자가 정지한 기록은 남기고 관리자만 알 수 없게 한다.
+		name:      "user_suspension_admin",
+		purge:     `UPDATE auth.user_suspension SET suspended_by = '' WHERE suspended_by = @user_id`,
+		anonymize: `UPDATE auth.user_suspension SET suspended_by = @pseudonym WHERE suspended_by = @user_id`,
+	},
+	{
+		name:      "two_factor_required_role_admin",
+		purge:     `UPDATE auth.two_factor_required_role SET updated_by = '' WHERE updated_by = @user_id`,
+		anonymize: `UPDATE auth.two_factor_required_role SET updated_by = @pseudonym WHERE updated_by = @user_id`,
+	},
+	{
+		// 폐기된 토큰은 만료될 때까지 남겨야 하므로 사용자 ID 만 지운다.
+		name:      "revoked_token",
+		purge:     `UPDATE auth.revoked_token SET user_id = '' WHERE user_id = @user_id`,
+		anonymize: `UPDATE auth.revoked_token SET user_id = @pseudonym WHERE user_id = @user_id`,
+	},
+	{
+		name:  "device_reading",
+		purge: `DELETE FROM device.reading WHERE device_id IN (SELECT id FROM device.device_info WHERE user_id = @user_id)`,
+	},
+	{
+		name:  "device_reading_hourly",
+		purge: `DELETE FROM device.reading_hourly WHERE device_id IN (SELECT id FROM device.device_info WHERE user_id = @user_id)`,
+	},
+	{
+		name:  "device_reading_daily",
+		purge: `DELETE FROM device.reading_daily WHERE device_id IN (SELECT id FROM device.device_info WHERE user_id = @user_id)`,
+	},
+	{
+		name:  "device_rollup_pending",
+		purge: `DELETE FROM device.rollup_pending WHERE device_id IN (SELECT id FROM device.device_info WHERE user_id = @user_id)`,
+	},
+	{
+		name:  "device_retention_tier",
+		purge: `DELETE FROM device.device_retention_tier WHERE device_id IN (SELECT id FROM device.device_info WHERE user_id = @user_id)`,
+	},
+	{
+		// device.device_request_schema 는 함께 삭제된다. (ON DELETE CASCADE)
+		name:  "device_info",
+		purge: `DELETE FROM device.device_info WHERE user_id = @user_id`,
+	},
+}
+
+const accountDeletionColumns = `
+	id::text, user_id, email, mode, status, attempts, last_error, result, requested_at, next_attempt_at, completed_at
+`
+
+type accountDeletionRepository struct {
+	log *zap.Logger
+	db  *pgxpool.Pool
+}
+
+func scanAccountDeletion(row pgx.Row) (*domain.AccountDeletion, error) {
+	var d domain.AccountDeletion
+	if err := row.Scan(
+		&d.ID,
+		&d.UserID,
+		&d.Email,
+		&d.Mode,
+		&d.Status,
+		&d.Attempts,
+		&d.LastError,
+		&d.Result,
+		&d.RequestedAt,
+		&d.NextAttemptAt,
+		&d.CompletedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func (r *accountDeletionRepository) CreateAccountDeletion(ctx context.Context, deletion *domain.AccountDeletion) error {
+	q := `
+		INSERT INTO auth.account_deletion (user_id, email, mode)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (user_id) DO UPDATE SET email = excluded.email, mode = excluded.mode, requested_at = now()
+				WHERE auth.account_deletion.status = 'pending'
+			RETURNING ` + accountDeletionColumns + `;
+	`
+
+	d, err := scanAccountDeletion(r.db.QueryRow(ctx, q, deletion.UserID, deletion.Email, deletion.Mode))
+	if err != nil {
+		// 이미 탈퇴가 확인된 작업이 있는 경우
+		requestid.Logger(ctx, r.log).Error("auth.r.CreateAccountDeletion() 오류", zap.Error(err))
+		return translateError(err)
+	}
+
+	*deletion = *d
+	return nil
+}
+
+func (r *accountDeletionRepository) ConfirmAccountDeletion(ctx context.Context, userID string) error {
+	q := `
+		UPDATE auth.account_deletion SET status = 'confirmed', next_attempt_at = now()
+			WHERE user_id = $1 AND status = 'pending';
+	`
+
+	tag, err := r.db.Exec(ctx, q, userID)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.ConfirmAccountDeletion() 오류", zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *accountDeletionRepository) DeleteAccountDeletion(ctx context.Context, userID string) error {
+	q := `
+		DELETE FROM auth.account_deletion WHERE user_id = $1 AND status = 'pending';
+	`
+
+	if _, err := r.db.Exec(ctx, q, userID); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.DeleteAccountDeletion() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// PurgeAccountData
+//
+// 작업을 잠그고 처리 방식에 맞게 모든 단계를 실행한 뒤 완료 처리합니다.
+// 한 단계라도 실패하면 전체를 되돌리므로 재시도해도 같은 결과가 됩니다.
+func (r *accountDeletionRepository) PurgeAccountData(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	qSelect := `
+		SELECT ` + accountDeletionColumns + ` FROM auth.account_deletion
+			WHERE user_id = $1 AND status = 'confirmed'
+			FOR UPDATE;
+	`
+	qComplete := `
+		UPDATE auth.account_deletion SET status = 'completed', email = '', last_error = '', result = $2, completed_at = now()
+			WHERE user_id = $1
+			RETURNING ` + accountDeletionColumns + `;
+	`
+
+	var deletion *domain.AccountDeletion
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		d, err := scanAccountDeletion(tx.QueryRow(ctx, qSelect, userID))
+		if err != nil {
+			return err
+		}
+
+		args := pgx.NamedArgs{
+			"user_id":   d.UserID,
+			"email":     d.Email,
+			"pseudonym": d.Pseudonym(),
+		}
+		result := make(map[string]int64, len(accountDataSteps))
+		for _, step := range accountDataSteps {
+			q := step.purge
+			if d.Mode == domain.AccountDeletionModeAnonymize && step.anonymize != "" {
+				q = step.anonymize
+			}
+
+			tag, err := tx.Exec(ctx, q, args)
+			if err != nil {
+				return err
+			}
+			if tag.RowsAffected() > 0 {
+				result[step.name] = tag.RowsAffected()
+			}
+		}
+
+		deletion, err = scanAccountDeletion(tx.QueryRow(ctx, qComplete, userID, result))
+		return err
+	})
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.PurgeAccountData() 오류", zap.Error(err))
+		return nil, translateError(err)
+	}
+
+	return deletion, nil
+}
+
+func (r *accountDeletionRepository) FailAccountDeletion(ctx context.Context, userID string, reason string, retryAfter time.Duration) error {
+	// 재시도 간격은 실패할 때마다 늘리고 최대 12배로 제한한다.
+	q := `
+		UPDATE auth.account_deletion
+			SET attempts = attempts + 1, last_error = $2, next_attempt_at = now() + make_interval(secs => $3 * LEAST(attempts + 1, 12))
+			WHERE user_id = $1 AND status = 'confirmed';
+	`
+
+	if _, err := r.db.Exec(ctx, q, userID, reason, retryAfter.Seconds()); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.FailAccountDeletion() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *accountDeletionRepository) GetAccountDeletion(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	q := `
+		SELECT ` + accountDeletionColumns + ` FROM auth.account_deletion WHERE user_id = $1;
+	`
+
+	d, err := scanAccountDeletion(r.db.QueryRow(ctx, q, userID))
+	if err != nil {
+		requestid.Logger(ctx, r.log).Debug("auth.r.GetAccountDeletion() 오류", zap.Error(err))
+		return nil, translateError(err)
+	}
+
+	return d, nil
+}
+
+func (r *accountDeletionRepository) GetAccountDeletionListByStatus(ctx context.Context, status domain.AccountDeletionStatus, limit int) ([]*domain.AccountDeletion, error) {
+	q := `
+		SELECT ` + accountDeletionColumns + ` FROM auth.account_deletion
+			WHERE status = $1
+			ORDER BY requested_at DESC
+			LIMIT $2;
+	`
+
+	return r.list(ctx, "auth.r.GetAccountDeletionListByStatus()", q, status, limit)
+}
+
+func (r *accountDeletionRepository) GetDueAccountDeletionList(ctx context.Context, limit int) ([]*domain.AccountDeletion, error) {
+	q := `
+		SELECT ` + accountDeletionColumns + ` FROM auth.account_deletion
+			WHERE status = 'confirmed' AND next_attempt_at <= now()
+			ORDER BY next_attempt_at
+			LIMIT $1;
+	`
+
+	return r.list(ctx, "auth.r.GetDueAccountDeletionList()", q, limit)
+}
+
+func (r *accountDeletionRepository) GetStaleAccountDeletionList(ctx context.Context, requestedBefore time.Time, limit int) ([]*domain.AccountDeletion, error) {
+	q := `
+		SELECT ` + accountDeletionColumns + ` FROM auth.account_deletion
+			WHERE status = 'pending' AND requested_at < $1
+			ORDER BY requested_at
+			LIMIT $2;
+	`
+
+	return r.list(ctx, "auth.r.GetStaleAccountDeletionList()", q, requestedBefore, limit)
+}
+
+func (r *accountDeletionRepository) list(ctx context.Context, method string, q string, args ...any) ([]*domain.AccountDeletion, error) {
+	rows, err := r.db.Query(ctx, q, args...)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error(method+" 오류", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*domain.AccountDeletion, 0)
+	for rows.Next() {
+		d, err := scanAccountDeletion(rows)
+		if err != nil {
+			requestid.Logger(ctx, r.log).Error(method+" 오류", zap.Error(err))
+			return nil, err
+		}
+		list = append(list, d)
+	}
+	if err := rows.Err(); err != nil {
+		requestid.Logger(ctx, r.log).Error(method+" 오류", zap.Error(err))
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func AccountDeletionRepository(logger *zap.Logger, db *pgxpool.Pool) domain.AccountDeletionRepository {
+	return &accountDeletionRepository{
+		log: logger,
+		db:  db,
+	}
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+func TestPurgeAccountDataDevice(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	userID := "purge-test-" + time.Now().Format("20060102150405.000000")
+
+	// 장치, 요청 스키마, 수집 데이터, 집계, 보관 등급을 하나씩 만든다.
+	deviceID, sensorID := testDevice(t, db, userID)
+
+	for _, q := range []string{
+		`INSERT INTO device.device_request_schema (device_id, key, sensor_id) VALUES ($1, 'temp', $2)`,
+		`INSERT INTO device.reading (device_id, sensor_id, time, value) VALUES ($1, $2, now(), 1)`,
+		`INSERT INTO device.reading_hourly (device_id, sensor_id, bucket, min, max, sum, count) VALUES ($1, $2, date_trunc('hour', now()), 1, 1, 1, 1)`,
+		`INSERT INTO device.reading_daily (device_id, sensor_id, bucket, min, max, sum, count) VALUES ($1, $2, date_trunc('day', now()), 1, 1, 1, 1)`,
+	} {
+		if _, err := db.Exec(ctx, q, deviceID, sensorID); err != nil {
+			t.Fatalf("%s error = %v", q, err)
+		}
+	}
+	if _, err := db.Exec(ctx, `INSERT INTO device.device_retention_tier (device_id, tier) VALUES ($1, 'default')`, deviceID); err != nil {
+		t.Fatalf("보관 등급 지정 error = %v", err)
+	}
+
+	repo := AccountDeletionRepository(zap.NewNop(), db)
+	if err := repo.CreateAccountDeletion(ctx, &domain.AccountDeletion{UserID: userID, Mode: domain.AccountDeletionModeAnonymize}); err != nil {
+		t.Fatalf("CreateAccountDeletion() error = %v", err)
+	}
+	if err := repo.ConfirmAccountDeletion(ctx, userID); err != nil {
+		t.Fatalf("ConfirmAccountDeletion() error = %v", err)
+	}
+	if _, err := repo.PurgeAccountData(ctx, userID); err != nil {
+		t.Fatalf("PurgeAccountData() error = %v", err)
+	}
+
+	// 익명화 방식이어도 장치 데이터는 남지 않는다.
+	for _, table := range []string{
+		"device.reading",
+		"device.reading_hourly",
+		"device.reading_daily",
+		"device.rollup_pending",
+		"device.device_retention_tier",
+		"device.device_request_schema",
+		"device.device_info",
+	} {
+		var n int
+		if err := db.QueryRow(ctx, `SELECT count(*) FROM `+table+` WHERE `+deviceColumn(table)+` = $1`, deviceID).Scan(&n); err != nil {
+			t.Fatalf("%s 조회 error = %v", table, err)
+		}
+		if n != 0 {
+			t.Errorf("%s = %d 건 남음, want 0", table, n)
+		}
+	}
+}
+
+func deviceColumn(table string) string {
+	if table == "device.device_info" {
+		return "id"
+	}
+	return "device_id"
+}
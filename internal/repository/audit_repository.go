@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type auditRepository struct {
+	log *zap.Logger
+	db  *pgxpool.Pool
+}
+
+func (r *auditRepository) CreateAuditEvent(ctx context.Context, event *domain.AuditEvent) error {
+	q := `
+		INSERT INTO audit.event (actor_id, action, target_type, target_id, client_ip, user_agent, result, reason)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			RETURNING id, occurred_at;
+	`
+	if err := r.db.QueryRow(ctx, q,
+		event.ActorID,
+		event.Action,
+		event.TargetType,
+		event.TargetID,
+		event.ClientIP,
+		event.UserAgent,
+		event.Result,
+		event.Reason,
+	).Scan(
+		&event.ID,
+		&event.OccurredAt,
+	); err != nil {
+		requestid.Logger(ctx, r.log).Error("audit.r.CreateAuditEvent() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *auditRepository) GetAuditEventListByFilterWithPage(ctx context.Context, filter *domain.AuditFilter, page domain.Page) ([]*domain.AuditEvent, int, error) {
+	q := `
+		SELECT id, occurred_at, actor_id, action, target_type, target_id, client_ip, user_agent, result, reason,
+		       count(*) OVER ()
+			FROM audit.event
+			WHERE
+				($1 = '' OR actor_id = $1)
+				AND ($2 = '' OR action = $2)
+				AND ($3 = '' OR target_id = $3)
+				AND ($4 = '' OR result = $4)
+				AND ($5::TIMESTAMPTZ IS NULL OR occurred_at >= $5)
+				AND ($6::TIMESTAMPTZ IS NULL OR occurred_at < $6)
+			ORDER BY occurred_at DESC, id DESC
+			LIMIT $7 OFFSET $8;
+	`
+	rows, err := r.db.Query(ctx, q,
+		filter.ActorID,
+		filter.Action,
+		filter.TargetID,
+		filter.Result,
+		filter.From,
+		filter.To,
+		page.Size,
+		(page.Page-1)*page.Size,
+	)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("audit.r.GetAuditEventListByFilterWithPage() 오류", zap.Error(err))
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	eventList := make([]*domain.AuditEvent, 0)
+	total := 0
+
+	for rows.Next() {
+		var e domain.AuditEvent
+		if err := rows.Scan(
+			&e.ID,
+			&e.OccurredAt,
+			&e.ActorID,
+			&e.Action,
+			&e.TargetType,
+			&e.TargetID,
+			&e.ClientIP,
+			&e.UserAgent,
+			&e.Result,
+			&e.Reason,
+			&total,
+		); err != nil {
+			requestid.Logger(ctx, r.log).Error("audit.r.GetAuditEventListByFilterWithPage() 오류", zap.Error(err))
+			return nil, 0, err
+		}
+
+		eventList = append(eventList, &e)
+	}
+
+	if err := rows.Err(); err != nil {
+		requestid.Logger(ctx, r.log).Error("audit.r.GetAuditEventListByFilterWithPage() 오류", zap.Error(err))
+		return nil, 0, err
+	}
+
+	return eventList, total, nil
+}
+
+func AuditRepository(logger *zap.Logger, db *pgxpool.Pool) domain.AuditRepository {
+	return &auditRepository{
+		log: logger,
+		db:  db,
+	}
+}
@@ -0,0 +1,313 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// deviceInfoColumns 작물, 통신 주기, 주소를 이름으로 조회합니다.
+const deviceInfoColumns = `d.id::text, d.user_id, d.title, d.name, c.title, u.interval, s.title, ac.title, d.created_at, d.updated_at`
+
+const deviceInfoFrom = `
+		FROM device.device_info d
+			JOIN device.crop c ON c.id = d.crop_id
+			JOIN device.update_cycle u ON u.id = d.update_cycle_id
+			JOIN device.address_city ac ON ac.id = d.address_city_id
+			JOIN device.address_state s ON s.id = ac.address_state_id
+`
+
+// deviceReferenceSelect 이름으로 작물, 통신 주기, 주소 ID 를 찾습니다. 하나라도 없으면 결과가 없습니다.
+const deviceReferenceSelect = `
+	SELECT c.id AS crop_id, u.id AS update_cycle_id, ac.id AS address_city_id
+		FROM device.crop c, device.update_cycle u, device.address_city ac
+			JOIN device.address_state s ON s.id = ac.address_state_id
+		WHERE c.title = @crop AND u.interval = @update_cycle AND s.title = @state AND ac.title = @city
+`
+
+// deviceDataTables 장치 삭제시 함께 삭제하는 센서 데이터 테이블 입니다. 장치 ID 외래 키가 없어 직접 삭제합니다.
+var deviceDataTables = []string{
+	"device.reading",
+	"device.reading_hourly",
+	"device.reading_daily",
+	"device.rollup_pending",
+	"device.device_retention_tier",
+}
+
+type deviceRepository struct {
+	log *zap.Logger
+	db  *pgxpool.Pool
+}
+
+func deviceArgs(in *domain.DeviceInfo) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"id":           in.ID,
+		"user_id":      in.UserID,
+		"title":        in.Title,
+		"name":         in.Name,
+		"crop":         in.Crop,
+		"update_cycle": in.UpdateCycle,
+		"state":        in.Address.State,
+		"city":         in.Address.City,
+	}
+}
+
+func scanDeviceInfo(row pgx.Row) (*domain.DeviceInfo, error) {
+	var d domain.DeviceInfo
+	if err := row.Scan(
+		&d.ID,
+		&d.UserID,
+		&d.Title,
+		&d.Name,
+		&d.Crop,
+		&d.UpdateCycle,
+		&d.Address.State,
+		&d.Address.City,
+		&d.CreatedAt,
+		&d.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (r *deviceRepository) CreateDevice(ctx context.Context, in *domain.DeviceInfo) error {
+	q := `
+		INSERT INTO device.device_info (user_id, title, name, crop_id, update_cycle_id, address_city_id)
+			SELECT @user_id, @title, @name, ref.crop_id, ref.update_cycle_id, ref.address_city_id
+				FROM (` + deviceReferenceSelect + `) ref
+			RETURNING id::text, created_at, updated_at;
+	`
+
+	if err := r.db.QueryRow(ctx, q, deviceArgs(in)).Scan(&in.ID, &in.CreatedAt, &in.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrDeviceInvalid
+		}
+		requestid.Logger(ctx, r.log).Error("device.r.CreateDevice() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *deviceRepository) GetDeviceByID(ctx context.Context, deviceID string) (*domain.DeviceInfo, error) {
+	// id 가 uuid 형식이 아니면 캐스팅 오류가 발생하므로 text 로 비교한다.
+	q := `SELECT ` + deviceInfoColumns + deviceInfoFrom + `WHERE d.id::text = $1;`
+
+	d, err := scanDeviceInfo(r.db.QueryRow(ctx, q, deviceID))
+	if err != nil {
+		err = translateError(err)
+		if !errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Error("device.r.GetDeviceByID() 오류", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func (r *deviceRepository) GetDeviceListByUserIDWithPage(ctx context.Context, userID string, page domain.Page) ([]*domain.DeviceInfo, int, error) {
+	q := `
+		SELECT ` + deviceInfoColumns + `, count(*) OVER ()` + deviceInfoFrom + `
+			WHERE d.user_id = $1
+			ORDER BY d.created_at DESC, d.id
+			LIMIT $2 OFFSET $3;
+	`
+
+	rows, err := r.db.Query(ctx, q, userID, page.Size, (page.Page-1)*page.Size)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.GetDeviceListByUserIDWithPage() 오류", zap.Error(err))
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	deviceList := make([]*domain.DeviceInfo, 0)
+	total := 0
+
+	for rows.Next() {
+		var d domain.DeviceInfo
+		if err := rows.Scan(
+			&d.ID,
+			&d.UserID,
+			&d.Title,
+			&d.Name,
+			&d.Crop,
+			&d.UpdateCycle,
+			&d.Address.State,
+			&d.Address.City,
+			&d.CreatedAt,
+			&d.UpdatedAt,
+			&total,
+		); err != nil {
+			requestid.Logger(ctx, r.log).Error("device.r.GetDeviceListByUserIDWithPage() 오류", zap.Error(err))
+			return nil, 0, err
+		}
+
+		deviceList = append(deviceList, &d)
+	}
+
+	if err := rows.Err(); err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.GetDeviceListByUserIDWithPage() 오류", zap.Error(err))
+		return nil, 0, err
+	}
+
+	return deviceList, total, nil
+}
+
+func (r *deviceRepository) UpdateDevice(ctx context.Context, in *domain.DeviceInfo) error {
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		// 장치가 없는 경우와 작물, 통신 주기, 주소가 없는 경우를 구분한다.
+		var id string
+		if err := tx.QueryRow(ctx,
+			`SELECT id::text FROM device.device_info WHERE id::text = @id AND user_id = @user_id FOR UPDATE;`,
+			deviceArgs(in),
+		).Scan(&id); err != nil {
+			return translateError(err)
+		}
+
+		q := `
+			UPDATE device.device_info d
+				SET title           = @title,
+					name            = @name,
+					crop_id         = ref.crop_id,
+					update_cycle_id = ref.update_cycle_id,
+					address_city_id = ref.address_city_id,
+					updated_at      = now()
+				FROM (` + deviceReferenceSelect + `) ref
+				WHERE d.id::text = @id
+				RETURNING d.created_at, d.updated_at;
+		`
+		if err := tx.QueryRow(ctx, q, deviceArgs(in)).Scan(&in.CreatedAt, &in.UpdatedAt); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrDeviceInvalid
+			}
+			return err
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, domain.ErrNotFound) && !errors.Is(err, domain.ErrDeviceInvalid) {
+		requestid.Logger(ctx, r.log).Error("device.r.UpdateDevice() 오류", zap.Error(err))
+	}
+
+	return err
+}
+
+// DeleteDevice
+//
+// 장치와 센서 데이터, 집계, 보관 등급을 하나의 트랜잭션으로 삭제합니다. 요청 스키마는 외래 키로 함께 삭제됩니다.
+func (r *deviceRepository) DeleteDevice(ctx context.Context, userID string, deviceID string) error {
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		var id string
+		if err := tx.QueryRow(ctx,
+			`DELETE FROM device.device_info WHERE id::text = $1 AND user_id = $2 RETURNING id::text;`,
+			deviceID, userID,
+		).Scan(&id); err != nil {
+			return translateError(err)
+		}
+
+		for _, table := range deviceDataTables {
+			if _, err := tx.Exec(ctx, `DELETE FROM `+table+` WHERE device_id = $1::uuid;`, id); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		requestid.Logger(ctx, r.log).Error("device.r.DeleteDevice() 오류", zap.Error(err))
+	}
+
+	return err
+}
+
+func (r *deviceRepository) GetDeviceRequestSchemaList(ctx context.Context, deviceID string) ([]*domain.DeviceRequestSchema, error) {
+	q := `
+		SELECT rs.id, rs.device_id::text, rs.key, s.title
+			FROM device.device_request_schema rs
+				JOIN device.sensor s ON s.id = rs.sensor_id
+			WHERE rs.device_id::text = $1
+			ORDER BY rs.id;
+	`
+
+	rows, err := r.db.Query(ctx, q, deviceID)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.GetDeviceRequestSchemaList() 오류", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	schemaList := make([]*domain.DeviceRequestSchema, 0)
+
+	for rows.Next() {
+		var rs domain.DeviceRequestSchema
+		if err := rows.Scan(
+			&rs.ID,
+			&rs.DeviceID,
+			&rs.Key,
+			&rs.Target,
+		); err != nil {
+			requestid.Logger(ctx, r.log).Error("device.r.GetDeviceRequestSchemaList() 오류", zap.Error(err))
+			return nil, err
+		}
+
+		schemaList = append(schemaList, &rs)
+	}
+
+	if err := rows.Err(); err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.GetDeviceRequestSchemaList() 오류", zap.Error(err))
+		return nil, err
+	}
+
+	return schemaList, nil
+}
+
+// PutDeviceRequestSchemaList
+//
+// 파생 센서는 수집하지 않으므로 연결할 수 없습니다.
+func (r *deviceRepository) PutDeviceRequestSchemaList(ctx context.Context, deviceID string, schemaList []*domain.DeviceRequestSchema) error {
+	keys := make([]string, 0, len(schemaList))
+	targets := make([]string, 0, len(schemaList))
+	for _, rs := range schemaList {
+		keys = append(keys, rs.Key)
+		targets = append(targets, rs.Target)
+	}
+
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM device.device_request_schema WHERE device_id::text = $1;`, deviceID); err != nil {
+			return err
+		}
+
+		tag, err := tx.Exec(ctx, `
+			INSERT INTO device.device_request_schema (device_id, key, sensor_id)
+				SELECT $1::uuid, x.key, s.id
+					FROM unnest($2::text[], $3::text[]) AS x(key, target)
+						JOIN device.sensor s ON s.title = x.target AND s.formula IS NULL;
+		`, deviceID, keys, targets)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() != int64(len(schemaList)) {
+			return domain.ErrDeviceInvalid
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, domain.ErrDeviceInvalid) {
+		requestid.Logger(ctx, r.log).Error("device.r.PutDeviceRequestSchemaList() 오류", zap.Error(err))
+	}
+
+	return err
+}
+
+func DeviceRepository(logger *zap.Logger, db *pgxpool.Pool) domain.DeviceRepository {
+	return &deviceRepository{
+		log: logger,
+		db:  db,
+	}
+}
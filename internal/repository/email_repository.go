@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type emailRepository struct {
+	log *zap.Logger
+	db  *pgxpool.Pool
+}
+
+func (r *emailRepository) CreateEmailToken(ctx context.Context, token *domain.EmailToken) error {
+	q := `
+		INSERT INTO auth.email_token (token_hash, purpose, user_id, email, expires_at)
+			VALUES ($1, $2, $3, $4, $5);
+	`
+	if _, err := r.db.Exec(ctx, q,
+		token.TokenHash,
+		token.Purpose,
+		token.UserID,
+		token.Email,
+		token.ExpiresAt,
+	); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.CreateEmailToken() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *emailRepository) GetEmailToken(ctx context.Context, tokenHash string, purpose domain.EmailTokenPurpose) (*domain.EmailToken, error) {
+	q := `
+		SELECT token_hash, purpose, user_id, email, expires_at
+			FROM auth.email_token
+			WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > now();
+	`
+
+	var t domain.EmailToken
+	if err := r.db.QueryRow(ctx, q, tokenHash, purpose).Scan(
+		&t.TokenHash,
+		&t.Purpose,
+		&t.UserID,
+		&t.Email,
+		&t.ExpiresAt,
+	); err != nil {
+		err = translateError(err)
+		if !errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Error("auth.r.GetEmailToken() 오류", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (r *emailRepository) UseEmailToken(ctx context.Context, tokenHash string, purpose domain.EmailTokenPurpose) (*domain.EmailToken, error) {
+	q := `
+		UPDATE auth.email_token SET used_at = now()
+			WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > now()
+			RETURNING token_hash, purpose, user_id, email, expires_at;
+	`
+
+	var t domain.EmailToken
+	if err := r.db.QueryRow(ctx, q, tokenHash, purpose).Scan(
+		&t.TokenHash,
+		&t.Purpose,
+		&t.UserID,
+		&t.Email,
+		&t.ExpiresAt,
+	); err != nil {
+		err = translateError(err)
+		if !errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Error("auth.r.UseEmailToken() 오류", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (r *emailRepository) DeleteUnusedEmailToken(ctx context.Context, userID string, purpose domain.EmailTokenPurpose) error {
+	q := `DELETE FROM auth.email_token WHERE user_id = $1 AND purpose = $2 AND used_at IS NULL;`
+	if _, err := r.db.Exec(ctx, q, userID, purpose); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.DeleteUnusedEmailToken() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *emailRepository) DeleteExpiredEmailToken(ctx context.Context) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM auth.email_token WHERE expires_at < now();`)
+	if err != nil {
+		r.log.Error("auth.r.DeleteExpiredEmailToken() 오류", zap.Error(err))
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *emailRepository) SetEmailVerified(ctx context.Context, userID string, email string) error {
+	q := `
+		INSERT INTO auth.email_verification (user_id, email)
+			VALUES ($1, $2)
+			ON CONFLICT (user_id) DO UPDATE SET email = excluded.email, verified_at = now();
+	`
+	if _, err := r.db.Exec(ctx, q, userID, email); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.SetEmailVerified() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *emailRepository) GetVerifiedEmail(ctx context.Context, userID string) (string, error) {
+	q := `SELECT email FROM auth.email_verification WHERE user_id = $1;`
+
+	var email string
+	if err := r.db.QueryRow(ctx, q, userID).Scan(&email); err != nil {
+		err = translateError(err)
+		if !errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Error("auth.r.GetVerifiedEmail() 오류", zap.Error(err))
+		}
+		return "", err
+	}
+
+	return email, nil
+}
+
+func EmailRepository(logger *zap.Logger, db *pgxpool.Pool) domain.EmailRepository {
+	return &emailRepository{
+		log: logger,
+		db:  db,
+	}
+}
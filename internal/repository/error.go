@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// translateError pgx 에러를 domain 에러로 변환합니다.
+//
+// 상위 계층이 저장소 구현에 의존하지 않도록 repository 밖으로 나가는 에러는 이 함수를 거쳐야 합니다.
+func translateError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %w", domain.ErrNotFound, err)
+	}
+	return err
+}
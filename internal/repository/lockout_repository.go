@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type lockoutRepository struct {
+	log *zap.Logger
+	db  *pgxpool.Pool
+}
+
+const signInFailureColumns = `kind, subject, failures, first_failed_at, last_failed_at, locked_until`
+
+func (r *lockoutRepository) GetSignInFailure(ctx context.Context, kind domain.SignInFailureKind, subject string) (*domain.SignInFailure, error) {
+	q := `SELECT ` + signInFailureColumns + ` FROM auth.sign_in_failure WHERE kind = $1 AND subject = $2;`
+
+	var f domain.SignInFailure
+	if err := r.db.QueryRow(ctx, q, kind, subject).Scan(
+		&f.Kind,
+		&f.Subject,
+		&f.Failures,
+		&f.FirstFailedAt,
+		&f.LastFailedAt,
+		&f.LockedUntil,
+	); err != nil {
+		err = translateError(err)
+		if !errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Error("auth.r.GetSignInFailure() 오류", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return &f, nil
+}
+
+// RecordSignInFailure
+//
+// 집계 구간(window)이 지났거나 잠금이 풀린 기록은 1회부터 다시 셉니다.
+// 동시 요청에도 원자적으로 동작하도록 한번의 UPSERT 로 처리합니다.
+func (r *lockoutRepository) RecordSignInFailure(ctx context.Context, kind domain.SignInFailureKind, subject string, maxFailures int, window time.Duration, lockout time.Duration) (*domain.SignInFailure, error) {
+	q := `
+		INSERT INTO auth.sign_in_failure AS f (` + signInFailureColumns + `)
+		VALUES ($1, $2, 1, now(), now(), CASE WHEN $3::INT <= 1 THEN now() + $5::INTERVAL END)
+		ON CONFLICT (kind, subject) DO UPDATE SET
+			failures        = CASE WHEN f.first_failed_at < now() - $4::INTERVAL OR f.locked_until <= now() THEN 1 ELSE f.failures + 1 END,
+			first_failed_at = CASE WHEN f.first_failed_at < now() - $4::INTERVAL OR f.locked_until <= now() THEN now() ELSE f.first_failed_at END,
+			last_failed_at  = now(),
+			locked_until    = CASE
+				WHEN f.locked_until > now() THEN f.locked_until
+				WHEN (CASE WHEN f.first_failed_at < now() - $4::INTERVAL OR f.locked_until <= now() THEN 1 ELSE f.failures + 1 END) >= $3::INT
+					THEN now() + $5::INTERVAL
+			END
+		RETURNING ` + signInFailureColumns + `;
+	`
+
+	var f domain.SignInFailure
+	if err := r.db.QueryRow(ctx, q,
+		kind,
+		subject,
+		maxFailures,
+		window,
+		lockout,
+	).Scan(
+		&f.Kind,
+		&f.Subject,
+		&f.Failures,
+		&f.FirstFailedAt,
+		&f.LastFailedAt,
+		&f.LockedUntil,
+	); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.RecordSignInFailure() 오류", zap.Error(err))
+		return nil, err
+	}
+
+	return &f, nil
+}
+
+func (r *lockoutRepository) DeleteSignInFailure(ctx context.Context, kind domain.SignInFailureKind, subject string) error {
+	q := `DELETE FROM auth.sign_in_failure WHERE kind = $1 AND subject = $2;`
+
+	tag, err := r.db.Exec(ctx, q, kind, subject)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.DeleteSignInFailure() 오류", zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *lockoutRepository) DeleteExpiredSignInFailure(ctx context.Context, window time.Duration) (int64, error) {
+	q := `
+		DELETE FROM auth.sign_in_failure
+			WHERE last_failed_at < now() - $1::INTERVAL
+				AND (locked_until IS NULL OR locked_until < now());
+	`
+
+	tag, err := r.db.Exec(ctx, q, window)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.DeleteExpiredSignInFailure() 오류", zap.Error(err))
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *lockoutRepository) GetSignInLockoutList(ctx context.Context) ([]*domain.SignInFailure, error) {
+	q := `
+		SELECT ` + signInFailureColumns + `
+			FROM auth.sign_in_failure
+			WHERE locked_until > now()
+			ORDER BY locked_until DESC;
+	`
+	rows, err := r.db.Query(ctx, q)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.GetSignInLockoutList() 오류", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	lockoutList := make([]*domain.SignInFailure, 0)
+
+	for rows.Next() {
+		var f domain.SignInFailure
+		if err := rows.Scan(
+			&f.Kind,
+			&f.Subject,
+			&f.Failures,
+			&f.FirstFailedAt,
+			&f.LastFailedAt,
+			&f.LockedUntil,
+		); err != nil {
+			requestid.Logger(ctx, r.log).Error("auth.r.GetSignInLockoutList() 오류", zap.Error(err))
+			return nil, err
+		}
+
+		lockoutList = append(lockoutList, &f)
+	}
+
+	if err := rows.Err(); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.GetSignInLockoutList() 오류", zap.Error(err))
+		return nil, err
+	}
+
+	return lockoutList, nil
+}
+
+func LockoutRepository(logger *zap.Logger, db *pgxpool.Pool) domain.LockoutRepository {
+	return &lockoutRepository{
+		log: logger,
+		db:  db,
+	}
+}
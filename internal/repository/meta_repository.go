@@ -3,8 +3,10 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 )
@@ -15,15 +17,15 @@ type metaRepository struct {
 }
 
 func (r *metaRepository) GetSensorList(ctx context.Context) ([]*domain.Sensor, error) {
-	q := `SELECT id, title, eng_title, description, unit, unit_description FROM device.sensor;`
+	q := `SELECT id, title, eng_title, description, unit, unit_description, formula, inputs FROM device.sensor;`
 	rows, err := r.db.Query(ctx, q)
 	if err != nil {
-		r.log.Error("device.r.GetSensorList() 오류", zap.Error(err))
+		requestid.Logger(ctx, r.log).Error("device.r.GetSensorList() 오류", zap.Error(err))
 		return nil, err
 	}
 	defer rows.Close()
 
-	var sensorList []*domain.Sensor
+	sensorList := make([]*domain.Sensor, 0)
 
 	for rows.Next() {
 		var s domain.Sensor
@@ -34,8 +36,10 @@ func (r *metaRepository) GetSensorList(ctx context.Context) ([]*domain.Sensor, e
 			&s.Desc,
 			&s.Unit,
 			&s.UnitDesc,
+			&s.Formula,
+			&s.Inputs,
 		); err != nil {
-			r.log.Error("device.r.GetSensorList() 오류", zap.Error(err))
+			requestid.Logger(ctx, r.log).Error("device.r.GetSensorList() 오류", zap.Error(err))
 			return nil, err
 		}
 
@@ -43,7 +47,7 @@ func (r *metaRepository) GetSensorList(ctx context.Context) ([]*domain.Sensor, e
 	}
 
 	if err = rows.Err(); err != nil {
-		r.log.Error("device.r.GetSensorList() 오류", zap.Error(err))
+		requestid.Logger(ctx, r.log).Error("device.r.GetSensorList() 오류", zap.Error(err))
 		return nil, err
 	}
 
@@ -53,12 +57,12 @@ func (r *metaRepository) GetSensorList(ctx context.Context) ([]*domain.Sensor, e
 func (r *metaRepository) GetSensorByParam(ctx context.Context, in *domain.Sensor) (*domain.Sensor, error) {
 
 	if in.ID == 0 && in.Title == "" {
-		r.log.Error("ID 혹은 Title은 필수 입니다")
-		return nil, errors.New("ID 혹은 Title은 필수 입니다")
+		requestid.Logger(ctx, r.log).Error("ID 혹은 Title은 필수 입니다")
+		return nil, fmt.Errorf("%w: ID 혹은 Title은 필수 입니다", domain.ErrInvalidParam)
 	}
 	var sensor domain.Sensor
 	q := `
-			SELECT id, title, eng_title, description, unit, unit_description 
+			SELECT id, title, eng_title, description, unit, unit_description, formula, inputs
 				FROM device.sensor 
 				WHERE 
 				    id = NULLIF($1, 0) 
@@ -74,8 +78,15 @@ func (r *metaRepository) GetSensorByParam(ctx context.Context, in *domain.Sensor
 		&sensor.Desc,
 		&sensor.Unit,
 		&sensor.UnitDesc,
+		&sensor.Formula,
+		&sensor.Inputs,
 	); err != nil {
-		r.log.Error("device.r.GetSensorByParam() 오류", zap.Error(err))
+		err = translateError(err)
+		if errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Info("device.r.GetSensorByParam() 데이터 없음", zap.Error(err))
+			return nil, err
+		}
+		requestid.Logger(ctx, r.log).Error("device.r.GetSensorByParam() 오류", zap.Error(err))
 		return nil, err
 	}
 
@@ -83,12 +94,12 @@ func (r *metaRepository) GetSensorByParam(ctx context.Context, in *domain.Sensor
 }
 
 func (r *metaRepository) GetCropList(ctx context.Context) ([]*domain.Crop, error) {
-	var cropList []*domain.Crop
+	cropList := make([]*domain.Crop, 0)
 
-	q := `SELECT id, title, description FROM device.crop`
+	q := `SELECT id, title, description, gdd_base, gdd_upper FROM device.crop`
 	rows, err := r.db.Query(ctx, q)
 	if err != nil {
-		r.log.Error("device.r.GetCropList() 오류", zap.Error(err))
+		requestid.Logger(ctx, r.log).Error("device.r.GetCropList() 오류", zap.Error(err))
 		return nil, err
 	}
 	defer rows.Close()
@@ -99,8 +110,10 @@ func (r *metaRepository) GetCropList(ctx context.Context) ([]*domain.Crop, error
 			&crop.ID,
 			&crop.Title,
 			&crop.Desc,
+			&crop.GDDBase,
+			&crop.GDDUpper,
 		); err != nil {
-			r.log.Error("device.r.GetCropList() 오류", zap.Error(err))
+			requestid.Logger(ctx, r.log).Error("device.r.GetCropList() 오류", zap.Error(err))
 			return nil, err
 		}
 
@@ -108,7 +121,7 @@ func (r *metaRepository) GetCropList(ctx context.Context) ([]*domain.Crop, error
 	}
 
 	if err := rows.Err(); err != nil {
-		r.log.Error("device.r.GetCropList() 오류", zap.Error(err))
+		requestid.Logger(ctx, r.log).Error("device.r.GetCropList() 오류", zap.Error(err))
 		return nil, err
 	}
 
@@ -118,7 +131,7 @@ func (r *metaRepository) GetCropList(ctx context.Context) ([]*domain.Crop, error
 func (r *metaRepository) GetCropByParam(ctx context.Context, in *domain.Crop) (*domain.Crop, error) {
 	var crop domain.Crop
 	q := `
-		SELECT id, title, description 
+		SELECT id, title, description, gdd_base, gdd_upper
 			FROM device.crop 
 			WHERE
 			    id = NULLIF($1, 0)
@@ -131,8 +144,15 @@ func (r *metaRepository) GetCropByParam(ctx context.Context, in *domain.Crop) (*
 		&crop.ID,
 		&crop.Title,
 		&crop.Desc,
+		&crop.GDDBase,
+		&crop.GDDUpper,
 	); err != nil {
-		r.log.Error("device.r.GetCropByParam() 오류", zap.Error(err))
+		err = translateError(err)
+		if errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Info("device.r.GetCropByParam() 데이터 없음", zap.Error(err))
+			return nil, err
+		}
+		requestid.Logger(ctx, r.log).Error("device.r.GetCropByParam() 오류", zap.Error(err))
 		return nil, err
 	}
 
@@ -140,11 +160,11 @@ func (r *metaRepository) GetCropByParam(ctx context.Context, in *domain.Crop) (*
 }
 
 func (r *metaRepository) GetUpdateCycleList(ctx context.Context) ([]*domain.UpdateCycle, error) {
-	var updateCycleList []*domain.UpdateCycle
+	updateCycleList := make([]*domain.UpdateCycle, 0)
 	q := `SELECT id, interval, description FROM device.update_cycle`
 	rows, err := r.db.Query(ctx, q)
 	if err != nil {
-		r.log.Error("device.r.GetUpdateCycleList() 오류", zap.Error(err))
+		requestid.Logger(ctx, r.log).Error("device.r.GetUpdateCycleList() 오류", zap.Error(err))
 		return nil, err
 	}
 	defer rows.Close()
@@ -156,7 +176,7 @@ func (r *metaRepository) GetUpdateCycleList(ctx context.Context) ([]*domain.Upda
 			&updateCycle.Interval,
 			&updateCycle.Desc,
 		); err != nil {
-			r.log.Error("device.r.GetUpdateCycleList() 오류", zap.Error(err))
+			requestid.Logger(ctx, r.log).Error("device.r.GetUpdateCycleList() 오류", zap.Error(err))
 			return nil, err
 		}
 
@@ -164,7 +184,7 @@ func (r *metaRepository) GetUpdateCycleList(ctx context.Context) ([]*domain.Upda
 	}
 
 	if err := rows.Err(); err != nil {
-		r.log.Error("device.r.GetUpdateCycleList() 오류", zap.Error(err))
+		requestid.Logger(ctx, r.log).Error("device.r.GetUpdateCycleList() 오류", zap.Error(err))
 		return nil, err
 	}
 
@@ -172,11 +192,11 @@ func (r *metaRepository) GetUpdateCycleList(ctx context.Context) ([]*domain.Upda
 }
 
 func (r *metaRepository) GetAddressStateList(ctx context.Context) ([]*domain.AddressState, error) {
-	var addressStateList []*domain.AddressState
+	addressStateList := make([]*domain.AddressState, 0)
 	q := `SELECT id,title FROM device.address_state`
 	rows, err := r.db.Query(ctx, q)
 	if err != nil {
-		r.log.Error("device.r.GetAddressStateList() 오류", zap.Error(err))
+		requestid.Logger(ctx, r.log).Error("device.r.GetAddressStateList() 오류", zap.Error(err))
 		return nil, err
 	}
 	defer rows.Close()
@@ -187,14 +207,14 @@ func (r *metaRepository) GetAddressStateList(ctx context.Context) ([]*domain.Add
 			&addressState.ID,
 			&addressState.Title,
 		); err != nil {
-			r.log.Error("device.r.GetAddressStateList() 오류", zap.Error(err))
+			requestid.Logger(ctx, r.log).Error("device.r.GetAddressStateList() 오류", zap.Error(err))
 			return nil, err
 		}
 		addressStateList = append(addressStateList, &addressState)
 	}
 
 	if err := rows.Err(); err != nil {
-		r.log.Error("device.r.GetAddressStateList() 오류", zap.Error(err))
+		requestid.Logger(ctx, r.log).Error("device.r.GetAddressStateList() 오류", zap.Error(err))
 		return nil, err
 	}
 
@@ -202,7 +222,7 @@ func (r *metaRepository) GetAddressStateList(ctx context.Context) ([]*domain.Add
 }
 
 func (r *metaRepository) GetAddressCityListByState(ctx context.Context, state string) ([]*domain.AddressCity, error) {
-	var addressCityList []*domain.AddressCity
+	addressCityList := make([]*domain.AddressCity, 0)
 	q := `
 		SELECT 
 		    c.id,
@@ -218,7 +238,7 @@ func (r *metaRepository) GetAddressCityListByState(ctx context.Context, state st
 	)
 
 	if err != nil {
-		r.log.Error("device.r.GetAddressCityListByState() 오류", zap.Error(err))
+		requestid.Logger(ctx, r.log).Error("device.r.GetAddressCityListByState() 오류", zap.Error(err))
 		return nil, err
 	}
 	defer rows.Close()
@@ -230,7 +250,7 @@ func (r *metaRepository) GetAddressCityListByState(ctx context.Context, state st
 			&addressCity.StateTitle,
 			&addressCity.Title,
 		); err != nil {
-			r.log.Error("device.r.GetAddressCityListByState() 오류", zap.Error(err))
+			requestid.Logger(ctx, r.log).Error("device.r.GetAddressCityListByState() 오류", zap.Error(err))
 			return nil, err
 		}
 
@@ -238,10 +258,23 @@ func (r *metaRepository) GetAddressCityListByState(ctx context.Context, state st
 	}
 
 	if err := rows.Err(); err != nil {
-		r.log.Error("device.r.GetAddressCityListByState() 오류", zap.Error(err))
+		requestid.Logger(ctx, r.log).Error("device.r.GetAddressCityListByState() 오류", zap.Error(err))
 		return nil, err
 	}
 
+	// 시/군/구가 없는 경우 도/특별시 자체가 존재하지 않는지 확인
+	if len(addressCityList) == 0 {
+		var exists bool
+		q := `SELECT EXISTS(SELECT 1 FROM device.address_state WHERE title = $1);`
+		if err := r.db.QueryRow(ctx, q, state).Scan(&exists); err != nil {
+			requestid.Logger(ctx, r.log).Error("device.r.GetAddressCityListByState() 오류", zap.Error(err))
+			return nil, err
+		}
+		if !exists {
+			return nil, fmt.Errorf("%w: state=%s", domain.ErrNotFound, state)
+		}
+	}
+
 	return addressCityList, nil
 }
 
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type oauthRepository struct {
+	log *zap.Logger
+	db  *pgxpool.Pool
+}
+
+func (r *oauthRepository) CreateOAuthState(ctx context.Context, state *domain.OAuthState) error {
+	q := `
+		INSERT INTO auth.oauth_state (state, provider, code_verifier, nonce, redirect_url, expires_at)
+			VALUES ($1, $2, $3, $4, $5, $6);
+	`
+	if _, err := r.db.Exec(ctx, q,
+		state.State,
+		state.Provider,
+		state.CodeVerifier,
+		state.Nonce,
+		state.RedirectURL,
+		state.ExpiresAt,
+	); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.CreateOAuthState() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *oauthRepository) TakeOAuthState(ctx context.Context, state string) (*domain.OAuthState, error) {
+	q := `
+		DELETE FROM auth.oauth_state
+			WHERE state = $1 AND expires_at > now()
+			RETURNING state, provider, code_verifier, nonce, redirect_url, expires_at;
+	`
+
+	var s domain.OAuthState
+	if err := r.db.QueryRow(ctx, q, state).Scan(
+		&s.State,
+		&s.Provider,
+		&s.CodeVerifier,
+		&s.Nonce,
+		&s.RedirectURL,
+		&s.ExpiresAt,
+	); err != nil {
+		err = translateError(err)
+		if !errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Error("auth.r.TakeOAuthState() 오류", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (r *oauthRepository) DeleteExpiredOAuthState(ctx context.Context) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM auth.oauth_state WHERE expires_at < now();`)
+	if err != nil {
+		r.log.Error("auth.r.DeleteExpiredOAuthState() 오류", zap.Error(err))
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *oauthRepository) GetOAuthIdentity(ctx context.Context, provider string, subject string) (*domain.OAuthIdentity, error) {
+	q := `
+		SELECT provider, subject, user_id, email, created_at, last_signed_in_at
+			FROM auth.oauth_identity
+			WHERE provider = $1 AND subject = $2;
+	`
+
+	var i domain.OAuthIdentity
+	if err := r.db.QueryRow(ctx, q, provider, subject).Scan(
+		&i.Provider,
+		&i.Subject,
+		&i.UserID,
+		&i.Email,
+		&i.CreatedAt,
+		&i.LastSignedInAt,
+	); err != nil {
+		err = translateError(err)
+		if !errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Error("auth.r.GetOAuthIdentity() 오류", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return &i, nil
+}
+
+func (r *oauthRepository) CreateOAuthIdentity(ctx context.Context, identity *domain.OAuthIdentity) error {
+	q := `
+		INSERT INTO auth.oauth_identity (provider, subject, user_id, email)
+			VALUES ($1, $2, $3, $4)
+			RETURNING created_at, last_signed_in_at;
+	`
+	if err := r.db.QueryRow(ctx, q,
+		identity.Provider,
+		identity.Subject,
+		identity.UserID,
+		identity.Email,
+	).Scan(
+		&identity.CreatedAt,
+		&identity.LastSignedInAt,
+	); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.CreateOAuthIdentity() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *oauthRepository) UpdateOAuthIdentitySignedIn(ctx context.Context, provider string, subject string) error {
+	q := `UPDATE auth.oauth_identity SET last_signed_in_at = now() WHERE provider = $1 AND subject = $2;`
+	if _, err := r.db.Exec(ctx, q, provider, subject); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.UpdateOAuthIdentitySignedIn() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *oauthRepository) GetOAuthCredential(ctx context.Context, userID string) ([]byte, error) {
+	q := `SELECT encrypted_password FROM auth.oauth_credential WHERE user_id = $1;`
+
+	var encrypted []byte
+	if err := r.db.QueryRow(ctx, q, userID).Scan(&encrypted); err != nil {
+		err = translateError(err)
+		if !errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Error("auth.r.GetOAuthCredential() 오류", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return encrypted, nil
+}
+
+func (r *oauthRepository) CreateOAuthCredential(ctx context.Context, userID string, encryptedPassword []byte) error {
+	q := `INSERT INTO auth.oauth_credential (user_id, encrypted_password) VALUES ($1, $2);`
+	if _, err := r.db.Exec(ctx, q, userID, encryptedPassword); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.CreateOAuthCredential() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *oauthRepository) UpdateOAuthCredential(ctx context.Context, userID string, encryptedPassword []byte) error {
+	q := `UPDATE auth.oauth_credential SET encrypted_password = $2 WHERE user_id = $1;`
+	tag, err := r.db.Exec(ctx, q, userID, encryptedPassword)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.UpdateOAuthCredential() 오류", zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func OAuthRepository(logger *zap.Logger, db *pgxpool.Pool) domain.OAuthRepository {
+	return &oauthRepository{
+		log: logger,
+		db:  db,
+	}
+}
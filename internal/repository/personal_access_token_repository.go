@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type personalAccessTokenRepository struct {
+	log *zap.Logger
+	db  *pgxpool.Pool
+}
+
+func (r *personalAccessTokenRepository) CreatePersonalAccessToken(ctx context.Context, token *domain.PersonalAccessToken, tokenHash string) error {
+	q := `
+		INSERT INTO auth.personal_access_token (user_id, name, token_hash, scopes, expires_at)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING id::text, created_at;
+	`
+	if err := r.db.QueryRow(ctx, q,
+		token.UserID,
+		token.Name,
+		tokenHash,
+		token.Scopes,
+		token.ExpiresAt,
+	).Scan(&token.ID, &token.CreatedAt); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.CreatePersonalAccessToken() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *personalAccessTokenRepository) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	q := `
+		SELECT id::text, user_id, name, scopes, created_at, expires_at, last_used_at
+			FROM auth.personal_access_token
+			WHERE token_hash = $1;
+	`
+
+	var t domain.PersonalAccessToken
+	if err := r.db.QueryRow(ctx, q, tokenHash).Scan(
+		&t.ID,
+		&t.UserID,
+		&t.Name,
+		&t.Scopes,
+		&t.CreatedAt,
+		&t.ExpiresAt,
+		&t.LastUsedAt,
+	); err != nil {
+		err = translateError(err)
+		if !errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Error("auth.r.GetPersonalAccessTokenByHash() 오류", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (r *personalAccessTokenRepository) GetPersonalAccessTokenList(ctx context.Context, userID string) ([]*domain.PersonalAccessToken, error) {
+	q := `
+		SELECT id::text, user_id, name, scopes, created_at, expires_at, last_used_at
+			FROM auth.personal_access_token
+			WHERE user_id = $1
+			ORDER BY created_at DESC;
+	`
+
+	rows, err := r.db.Query(ctx, q, userID)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.GetPersonalAccessTokenList() 오류", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokenList := make([]*domain.PersonalAccessToken, 0)
+
+	for rows.Next() {
+		var t domain.PersonalAccessToken
+		if err := rows.Scan(
+			&t.ID,
+			&t.UserID,
+			&t.Name,
+			&t.Scopes,
+			&t.CreatedAt,
+			&t.ExpiresAt,
+			&t.LastUsedAt,
+		); err != nil {
+			requestid.Logger(ctx, r.log).Error("auth.r.GetPersonalAccessTokenList() 오류", zap.Error(err))
+			return nil, err
+		}
+
+		tokenList = append(tokenList, &t)
+	}
+
+	if err := rows.Err(); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.GetPersonalAccessTokenList() 오류", zap.Error(err))
+		return nil, err
+	}
+
+	return tokenList, nil
+}
+
+func (r *personalAccessTokenRepository) DeletePersonalAccessToken(ctx context.Context, userID string, tokenID string) error {
+	// id 가 uuid 형식이 아니면 캐스팅 오류가 발생하므로 text 로 비교한다.
+	q := `DELETE FROM auth.personal_access_token WHERE user_id = $1 AND id::text = $2;`
+
+	tag, err := r.db.Exec(ctx, q, userID, tokenID)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.DeletePersonalAccessToken() 오류", zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *personalAccessTokenRepository) UpdatePersonalAccessTokenLastUsed(ctx context.Context, tokenID string) error {
+	q := `
+		UPDATE auth.personal_access_token SET last_used_at = now()
+			WHERE id = $1::uuid AND (last_used_at IS NULL OR last_used_at < now() - INTERVAL '1 minute');
+	`
+	if _, err := r.db.Exec(ctx, q, tokenID); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.UpdatePersonalAccessTokenLastUsed() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func PersonalAccessTokenRepository(logger *zap.Logger, db *pgxpool.Pool) domain.PersonalAccessTokenRepository {
+	return &personalAccessTokenRepository{
+		log: logger,
+		db:  db,
+	}
+}
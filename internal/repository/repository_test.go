@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/GDH-Project/api/internal/migration"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// testDB TEST_DATABASE_URL 이 설정된 경우에만 실행합니다. 마이그레이션을 모두 적용하므로 테스트 전용 DB 를 사용해야 합니다.
+func testDB(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL 이 설정되지 않았습니다")
+	}
+
+	db, err := pgxpool.New(context.Background(), url)
+	if err != nil {
+		t.Fatalf("pgxpool.New() error = %v", err)
+	}
+	t.Cleanup(db.Close)
+
+	m, err := migration.NewMigrator(zap.NewNop(), db)
+	if err != nil {
+		t.Fatalf("NewMigrator() error = %v", err)
+	}
+	if _, err := m.Up(context.Background(), 0); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	return db
+}
+
+// testDevice name 으로 센서, 작물, 통신 주기, 주소와 사용자 장치를 만들고 테스트가 끝나면 장치 데이터를 삭제합니다.
+func testDevice(t *testing.T, db *pgxpool.Pool, name string) (deviceID string, sensorID int) {
+	t.Helper()
+
+	q := `
+		WITH sensor AS (
+			INSERT INTO device.sensor (title, eng_title) VALUES (@name, @name) RETURNING id
+		), crop AS (
+			INSERT INTO device.crop (title) VALUES (@name) RETURNING id
+		), cycle AS (
+			INSERT INTO device.update_cycle (interval) VALUES ((SELECT COALESCE(max(interval), 0) + 1 FROM device.update_cycle)) RETURNING id
+		), state AS (
+			INSERT INTO device.address_state (title) VALUES (@name) RETURNING id
+		), city AS (
+			INSERT INTO device.address_city (address_state_id, title) SELECT id, @name FROM state RETURNING id
+		)
+		INSERT INTO device.device_info (user_id, title, crop_id, update_cycle_id, address_city_id)
+			SELECT @name, 'test', crop.id, cycle.id, city.id FROM crop, cycle, city
+			RETURNING id::text, (SELECT id FROM sensor);
+	`
+	if err := db.QueryRow(context.Background(), q, pgx.NamedArgs{"name": name}).Scan(&deviceID, &sensorID); err != nil {
+		t.Fatalf("장치 생성 error = %v", err)
+	}
+
+	t.Cleanup(func() {
+		for _, table := range append(deviceDataTables, "device.device_info") {
+			column := "device_id"
+			if table == "device.device_info" {
+				column = "id"
+			}
+			if _, err := db.Exec(context.Background(), `DELETE FROM `+table+` WHERE `+column+` = $1`, deviceID); err != nil {
+				t.Errorf("%s 정리 error = %v", table, err)
+			}
+		}
+	})
+	return deviceID, sensorID
+}
@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// retentionLockKey 여러 인스턴스가 동시에 정리하지 않도록 사용하는 잠금 키 입니다.
+const retentionLockKey int64 = 0x6764685f72657465 // "gdh_rete"
+
+// deviceTierCondition 장치 등급 조건, 등급이 지정되지 않은 장치는 default 입니다.
+const deviceTierCondition = `COALESCE((SELECT t.tier FROM device.device_retention_tier t WHERE t.device_id = d.device_id), 'default') = $1`
+
+// rollupPendingCondition 집계 대기 중인 구간이 cutoff 이전에 있는 장치 센서는 집계가 끝날 때까지 삭제하지 않습니다.
+const rollupPendingCondition = `NOT EXISTS (
+	SELECT 1 FROM device.rollup_pending p WHERE p.device_id = d.device_id AND p.sensor_id = d.sensor_id AND p.bucket < $2
+)`
+
+type retentionRepository struct {
+	log *zap.Logger
+	db  *pgxpool.Pool
+}
+
+func (r *retentionRepository) GetRetentionPolicyList(ctx context.Context) ([]*domain.RetentionPolicy, error) {
+	q := `SELECT tier, raw_days, hourly_days, daily_days, updated_at FROM device.retention_policy ORDER BY tier;`
+	rows, err := r.db.Query(ctx, q)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.GetRetentionPolicyList() 오류", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	policyList := make([]*domain.RetentionPolicy, 0)
+	for rows.Next() {
+		var p domain.RetentionPolicy
+		if err := rows.Scan(&p.Tier, &p.RawDays, &p.HourlyDays, &p.DailyDays, &p.UpdatedAt); err != nil {
+			requestid.Logger(ctx, r.log).Error("device.r.GetRetentionPolicyList() 오류", zap.Error(err))
+			return nil, err
+		}
+		policyList = append(policyList, &p)
+	}
+
+	if err := rows.Err(); err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.GetRetentionPolicyList() 오류", zap.Error(err))
+		return nil, err
+	}
+
+	return policyList, nil
+}
+
+func (r *retentionRepository) UpsertRetentionPolicy(ctx context.Context, policy *domain.RetentionPolicy) error {
+	q := `
+		INSERT INTO device.retention_policy (tier, raw_days, hourly_days, daily_days, updated_at)
+		VALUES ($1, $2, $3, $4, now())
+		ON CONFLICT (tier) DO UPDATE
+		SET raw_days = excluded.raw_days, hourly_days = excluded.hourly_days, daily_days = excluded.daily_days, updated_at = excluded.updated_at
+		RETURNING updated_at;
+	`
+	if err := r.db.QueryRow(ctx, q, policy.Tier, policy.RawDays, policy.HourlyDays, policy.DailyDays).Scan(&policy.UpdatedAt); err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.UpsertRetentionPolicy() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *retentionRepository) DeleteRetentionPolicy(ctx context.Context, tier string) error {
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		var inUse bool
+		if err := tx.QueryRow(ctx,
+			`SELECT EXISTS(SELECT 1 FROM device.device_retention_tier WHERE tier = $1);`,
+			tier,
+		).Scan(&inUse); err != nil {
+			return err
+		}
+		if inUse {
+			return domain.ErrRetentionPolicyInUse
+		}
+
+		tag, err := tx.Exec(ctx, `DELETE FROM device.retention_policy WHERE tier = $1;`, tier)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("%w: tier=%s", domain.ErrNotFound, tier)
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrRetentionPolicyInUse) {
+			return err
+		}
+		requestid.Logger(ctx, r.log).Error("device.r.DeleteRetentionPolicy() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *retentionRepository) SetDeviceRetentionTier(ctx context.Context, deviceID string, tier string) error {
+	if tier == domain.RetentionTierDefault {
+		if _, err := r.db.Exec(ctx, `DELETE FROM device.device_retention_tier WHERE device_id = $1;`, deviceID); err != nil {
+			requestid.Logger(ctx, r.log).Error("device.r.SetDeviceRetentionTier() 오류", zap.Error(err))
+			return err
+		}
+		return nil
+	}
+
+	q := `
+		INSERT INTO device.device_retention_tier (device_id, tier)
+		SELECT $1, tier FROM device.retention_policy WHERE tier = $2
+		ON CONFLICT (device_id) DO UPDATE SET tier = excluded.tier;
+	`
+	tag, err := r.db.Exec(ctx, q, deviceID, tier)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.SetDeviceRetentionTier() 오류", zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: tier=%s", domain.ErrNotFound, tier)
+	}
+
+	return nil
+}
+
+func (r *retentionRepository) CountExpiredReadings(ctx context.Context, tier string, data domain.RollupTier, cutoff time.Time) (int64, error) {
+	t, ok := rollupTables[data]
+	if !ok {
+		return 0, fmt.Errorf("%w: data=%s", domain.ErrInvalidParam, data)
+	}
+
+	var count int64
+	q := fmt.Sprintf(`SELECT count(*) FROM %s d WHERE d.%s < $2 AND %s;`, t.table, t.time, deviceTierCondition)
+	if err := r.db.QueryRow(ctx, q, tier, cutoff).Scan(&count); err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.CountExpiredReadings() 오류", zap.Error(err))
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// DeleteExpiredReadings
+//
+// 한 구문으로 limit 건만 삭제하여 잠금을 짧게 유지합니다.
+// 집계에 반영되지 않은 데이터를 삭제하면 집계가 사라지므로 집계 대기 중인 장치 센서는 다음 정리로 미룹니다.
+func (r *retentionRepository) DeleteExpiredReadings(ctx context.Context, tier string, data domain.RollupTier, cutoff time.Time, limit int) (int64, error) {
+	t, ok := rollupTables[data]
+	if !ok {
+		return 0, fmt.Errorf("%w: data=%s", domain.ErrInvalidParam, data)
+	}
+
+	q := fmt.Sprintf(`
+		DELETE FROM %[1]s
+		WHERE ctid IN (
+			SELECT d.ctid FROM %[1]s d
+			WHERE d.%[2]s < $2 AND %[3]s AND %[4]s
+			LIMIT $3
+		);
+	`, t.table, t.time, deviceTierCondition, rollupPendingCondition)
+	tag, err := r.db.Exec(ctx, q, tier, cutoff, limit)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.DeleteExpiredReadings() 오류", zap.Error(err))
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// TryRetentionLock
+//
+// 정리는 여러 구문으로 나누어 실행하므로 트랜잭션 잠금 대신 커넥션을 잡아두고 세션 잠금을 사용합니다.
+func (r *retentionRepository) TryRetentionLock(ctx context.Context) (func(), bool, error) {
+	conn, err := r.db.Acquire(ctx)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.TryRetentionLock() 오류", zap.Error(err))
+		return nil, false, err
+	}
+
+	var locked bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1);`, retentionLockKey).Scan(&locked); err != nil {
+		conn.Release()
+		requestid.Logger(ctx, r.log).Error("device.r.TryRetentionLock() 오류", zap.Error(err))
+		return nil, false, err
+	}
+	if !locked {
+		conn.Release()
+		return nil, false, nil
+	}
+
+	unlock := func() {
+		// ctx 가 취소되어도 잠금은 해제해야 한다.
+		if _, err := conn.Exec(context.Background(), `SELECT pg_advisory_unlock($1);`, retentionLockKey); err != nil {
+			r.log.Error("device.r.TryRetentionLock() 잠금 해제 실패", zap.Error(err))
+			// 잠금이 남지 않도록 커넥션을 닫는다.
+			_ = conn.Conn().Close(context.Background())
+		}
+		conn.Release()
+	}
+	return unlock, true, nil
+}
+
+func (r *retentionRepository) CreateRetentionRun(ctx context.Context, run *domain.RetentionRun) error {
+	q := `
+		INSERT INTO device.retention_run (started_at, finished_at, items, error)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id;
+	`
+	if err := r.db.QueryRow(ctx, q, run.StartedAt, run.FinishedAt, run.Items, run.Error).Scan(&run.ID); err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.CreateRetentionRun() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *retentionRepository) GetRetentionRunList(ctx context.Context, limit int) ([]*domain.RetentionRun, error) {
+	q := `SELECT id, started_at, finished_at, items, error FROM device.retention_run ORDER BY started_at DESC LIMIT $1;`
+	rows, err := r.db.Query(ctx, q, limit)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.GetRetentionRunList() 오류", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	runList := make([]*domain.RetentionRun, 0)
+	for rows.Next() {
+		var run domain.RetentionRun
+		if err := rows.Scan(&run.ID, &run.StartedAt, &run.FinishedAt, &run.Items, &run.Error); err != nil {
+			requestid.Logger(ctx, r.log).Error("device.r.GetRetentionRunList() 오류", zap.Error(err))
+			return nil, err
+		}
+		runList = append(runList, &run)
+	}
+
+	if err := rows.Err(); err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.GetRetentionRunList() 오류", zap.Error(err))
+		return nil, err
+	}
+
+	return runList, nil
+}
+
+func RetentionRepository(logger *zap.Logger, db *pgxpool.Pool) domain.RetentionRepository {
+	return &retentionRepository{
+		log: logger,
+		db:  db,
+	}
+}
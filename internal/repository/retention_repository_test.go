@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+func TestDeleteExpiredReadingsPendingRollup(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	deviceID, sensorID := testDevice(t, db, "retention-test-"+time.Now().Format("20060102150405.000000"))
+	r := RetentionRepository(zap.NewNop(), db)
+	rollup := RollupRepository(zap.NewNop(), db)
+
+	// 다른 장치의 데이터를 삭제하지 않도록 테스트 장치에만 등급을 지정한다.
+	tier := fmt.Sprintf("test-%d", time.Now().UnixNano())
+	if err := r.UpsertRetentionPolicy(ctx, &domain.RetentionPolicy{Tier: tier}); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.SetDeviceRetentionTier(ctx, deviceID, tier); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_, _ = db.Exec(context.Background(), `DELETE FROM device.device_retention_tier WHERE tier = $1`, tier)
+		_, _ = db.Exec(context.Background(), `DELETE FROM device.retention_policy WHERE tier = $1`, tier)
+	})
+
+	at := time.Date(2020, 1, 1, 10, 30, 0, 0, time.UTC)
+	if _, err := db.Exec(ctx, `INSERT INTO device.reading (device_id, sensor_id, time, value) VALUES ($1, $2, $3, 1)`, deviceID, sensorID, at); err != nil {
+		t.Fatal(err)
+	}
+
+	// 집계되기 전에는 삭제하지 않는다.
+	deleted, err := r.DeleteExpiredReadings(ctx, tier, domain.RollupTierRaw, time.Now(), 100)
+	if err != nil || deleted != 0 {
+		t.Fatalf("집계 전 DeleteExpiredReadings() = %d, %v, want 0", deleted, err)
+	}
+
+	if _, err := rollup.RefreshRollup(ctx, 100000); err != nil {
+		t.Fatalf("RefreshRollup() error = %v", err)
+	}
+	deleted, err = r.DeleteExpiredReadings(ctx, tier, domain.RollupTierRaw, time.Now(), 100)
+	if err != nil || deleted != 1 {
+		t.Fatalf("집계 후 DeleteExpiredReadings() = %d, %v, want 1", deleted, err)
+	}
+
+	var hourly int
+	if err := db.QueryRow(ctx, `SELECT count(*) FROM device.reading_hourly WHERE device_id = $1`, deviceID).Scan(&hourly); err != nil {
+		t.Fatal(err)
+	}
+	if hourly != 1 {
+		t.Errorf("원본 데이터 삭제 후 시간 단위 집계 = %d, want 1", hourly)
+	}
+}
@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// rollupLockKey 여러 인스턴스가 동시에 집계하지 않도록 사용하는 잠금 키 입니다.
+const rollupLockKey int64 = 0x6764685f726f6c6c // "gdh_roll"
+
+// rollupTables 집계 단위별 테이블, 원본 데이터는 value 컬럼 하나를 집계 컬럼처럼 사용한다.
+var rollupTables = map[domain.RollupTier]struct{ table, time, min, max, sum, count string }{
+	domain.RollupTierRaw:    {"device.reading", "time", "value", "value", "value", "1"},
+	domain.RollupTierHourly: {"device.reading_hourly", "bucket", "min", "max", "sum", "count"},
+	domain.RollupTierDaily:  {"device.reading_daily", "bucket", "min", "max", "sum", "count"},
+}
+
+type rollupRepository struct {
+	log *zap.Logger
+	db  *pgxpool.Pool
+}
+
+// RefreshRollup
+//
+// 시간 단위 집계는 원본 데이터로, 일 단위 집계는 시간 단위 집계로 다시 계산합니다.
+// 원본 데이터가 없는 구간은 집계도 삭제하며, 보관 기간 정리는 대기 중인 구간을 삭제하지 않으므로
+// 원본 데이터가 보관 기간이 지나 삭제되어도 일 단위 집계는 유지됩니다.
+func (r *rollupRepository) RefreshRollup(ctx context.Context, limit int) (int64, error) {
+	var refreshed int64
+
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		var locked bool
+		if err := tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock($1);`, rollupLockKey).Scan(&locked); err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+
+		q := `
+			DELETE FROM device.rollup_pending p
+			USING (
+				SELECT device_id, sensor_id, bucket
+				FROM device.rollup_pending
+				ORDER BY queued_at
+				LIMIT $1
+			) c
+			WHERE p.device_id = c.device_id AND p.sensor_id = c.sensor_id AND p.bucket = c.bucket
+			RETURNING p.device_id::TEXT, p.sensor_id, p.bucket;
+		`
+		rows, err := tx.Query(ctx, q, limit)
+		if err != nil {
+			return err
+		}
+		var (
+			deviceIDs []string
+			sensorIDs []int32
+			buckets   []time.Time
+		)
+		for rows.Next() {
+			var (
+				deviceID string
+				sensorID int32
+				bucket   time.Time
+			)
+			if err := rows.Scan(&deviceID, &sensorID, &bucket); err != nil {
+				rows.Close()
+				return err
+			}
+			deviceIDs = append(deviceIDs, deviceID)
+			sensorIDs = append(sensorIDs, sensorID)
+			buckets = append(buckets, bucket)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		if len(buckets) == 0 {
+			return nil
+		}
+
+		q = `
+			INSERT INTO device.reading_hourly (device_id, sensor_id, bucket, min, max, sum, count, updated_at)
+			SELECT r.device_id, r.sensor_id, c.bucket, min(r.value), max(r.value), sum(r.value), count(*), now()
+			FROM unnest($1::TEXT[], $2::INTEGER[], $3::TIMESTAMPTZ[]) AS c(device_id, sensor_id, bucket)
+			JOIN device.reading r
+				ON r.device_id = c.device_id::UUID
+				AND r.sensor_id = c.sensor_id
+				AND r.time >= c.bucket AND r.time < c.bucket + INTERVAL '1 hour'
+			GROUP BY r.device_id, r.sensor_id, c.bucket
+			ON CONFLICT (device_id, sensor_id, bucket) DO UPDATE
+			SET min = excluded.min, max = excluded.max, sum = excluded.sum, count = excluded.count, updated_at = excluded.updated_at;
+		`
+		if _, err := tx.Exec(ctx, q, deviceIDs, sensorIDs, buckets); err != nil {
+			return err
+		}
+
+		// 원본 데이터가 모두 삭제된 구간
+		q = `
+			DELETE FROM device.reading_hourly h
+			USING unnest($1::TEXT[], $2::INTEGER[], $3::TIMESTAMPTZ[]) AS c(device_id, sensor_id, bucket)
+			WHERE h.device_id = c.device_id::UUID AND h.sensor_id = c.sensor_id AND h.bucket = c.bucket
+				AND NOT EXISTS (
+					SELECT 1 FROM device.reading r
+					WHERE r.device_id = h.device_id AND r.sensor_id = h.sensor_id
+						AND r.time >= h.bucket AND r.time < h.bucket + INTERVAL '1 hour'
+				);
+		`
+		if _, err := tx.Exec(ctx, q, deviceIDs, sensorIDs, buckets); err != nil {
+			return err
+		}
+
+		q = `
+			INSERT INTO device.reading_daily (device_id, sensor_id, bucket, min, max, sum, count, updated_at)
+			SELECT h.device_id, h.sensor_id, d.bucket, min(h.min), max(h.max), sum(h.sum), sum(h.count), now()
+			FROM (
+				SELECT DISTINCT c.device_id::UUID AS device_id, c.sensor_id, date_trunc('day', c.bucket, 'Asia/Seoul') AS bucket
+				FROM unnest($1::TEXT[], $2::INTEGER[], $3::TIMESTAMPTZ[]) AS c(device_id, sensor_id, bucket)
+			) d
+			JOIN device.reading_hourly h
+				ON h.device_id = d.device_id
+				AND h.sensor_id = d.sensor_id
+				AND h.bucket >= d.bucket AND h.bucket < d.bucket + INTERVAL '24 hours'
+			GROUP BY h.device_id, h.sensor_id, d.bucket
+			ON CONFLICT (device_id, sensor_id, bucket) DO UPDATE
+			SET min = excluded.min, max = excluded.max, sum = excluded.sum, count = excluded.count, updated_at = excluded.updated_at;
+		`
+		if _, err := tx.Exec(ctx, q, deviceIDs, sensorIDs, buckets); err != nil {
+			return err
+		}
+
+		q = `
+			DELETE FROM device.reading_daily dd
+			USING (
+				SELECT DISTINCT c.device_id::UUID AS device_id, c.sensor_id, date_trunc('day', c.bucket, 'Asia/Seoul') AS bucket
+				FROM unnest($1::TEXT[], $2::INTEGER[], $3::TIMESTAMPTZ[]) AS c(device_id, sensor_id, bucket)
+			) d
+			WHERE dd.device_id = d.device_id AND dd.sensor_id = d.sensor_id AND dd.bucket = d.bucket
+				AND NOT EXISTS (
+					SELECT 1 FROM device.reading_hourly h
+					WHERE h.device_id = d.device_id AND h.sensor_id = d.sensor_id
+						AND h.bucket >= d.bucket AND h.bucket < d.bucket + INTERVAL '24 hours'
+				);
+		`
+		if _, err := tx.Exec(ctx, q, deviceIDs, sensorIDs, buckets); err != nil {
+			return err
+		}
+
+		refreshed = int64(len(buckets))
+		return nil
+	})
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.RefreshRollup() 오류", zap.Error(err))
+		return 0, err
+	}
+
+	return refreshed, nil
+}
+
+func (r *rollupRepository) QueueRollup(ctx context.Context, deviceID string, from, to time.Time) (int64, error) {
+	q := `
+		INSERT INTO device.rollup_pending (device_id, sensor_id, bucket)
+		SELECT DISTINCT device_id, sensor_id, date_trunc('hour', time, 'UTC')
+		FROM device.reading
+		WHERE time >= $1 AND time < $2
+			AND (NULLIF($3::TEXT, '') IS NULL OR device_id = NULLIF($3::TEXT, '')::UUID)
+		ON CONFLICT DO NOTHING;
+	`
+	tag, err := r.db.Exec(ctx, q, from, to, deviceID)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.QueueRollup() 오류", zap.Error(err))
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *rollupRepository) GetRollupStatus(ctx context.Context) (*domain.RollupStatus, error) {
+	var status domain.RollupStatus
+	q := `SELECT count(*), min(bucket), min(queued_at) FROM device.rollup_pending;`
+	if err := r.db.QueryRow(ctx, q).Scan(&status.Pending, &status.OldestPending, &status.OldestQueued); err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.GetRollupStatus() 오류", zap.Error(err))
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// GetReadingPointList
+//
+// 구간은 한국 표준시 자정을 기준으로 나눕니다.
+func (r *rollupRepository) GetReadingPointList(ctx context.Context, tier domain.RollupTier, in *domain.ReadingQuery) ([]*domain.ReadingPoint, error) {
+	t, ok := rollupTables[tier]
+	if !ok {
+		return nil, fmt.Errorf("%w: tier=%s", domain.ErrInvalidParam, tier)
+	}
+
+	q := fmt.Sprintf(`
+		SELECT
+			date_bin(make_interval(secs => $5), %[2]s, TIMESTAMPTZ '2000-01-03 00:00:00+09') AS t,
+			min(%[3]s),
+			max(%[4]s),
+			(sum(%[5]s) / sum(%[6]s))::DOUBLE PRECISION,
+			sum(%[6]s)::BIGINT
+		FROM %[1]s
+		WHERE device_id = $1 AND sensor_id = $2 AND %[2]s >= $3 AND %[2]s < $4
+		GROUP BY t
+		ORDER BY t;
+	`, t.table, t.time, t.min, t.max, t.sum, t.count)
+	rows, err := r.db.Query(ctx, q, in.DeviceID, in.SensorID, in.From, in.To, in.Resolution.Seconds())
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.GetReadingPointList() 오류", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	pointList := make([]*domain.ReadingPoint, 0)
+	for rows.Next() {
+		var p domain.ReadingPoint
+		if err := rows.Scan(&p.Time, &p.Min, &p.Max, &p.Avg, &p.Count); err != nil {
+			requestid.Logger(ctx, r.log).Error("device.r.GetReadingPointList() 오류", zap.Error(err))
+			return nil, err
+		}
+		pointList = append(pointList, &p)
+	}
+
+	if err := rows.Err(); err != nil {
+		requestid.Logger(ctx, r.log).Error("device.r.GetReadingPointList() 오류", zap.Error(err))
+		return nil, err
+	}
+
+	return pointList, nil
+}
+
+func RollupRepository(logger *zap.Logger, db *pgxpool.Pool) domain.RollupRepository {
+	return &rollupRepository{
+		log: logger,
+		db:  db,
+	}
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// countRollup 장치 센서의 시간, 일 단위 집계 수
+func countRollup(t *testing.T, db *pgxpool.Pool, deviceID string) (hourly, daily int) {
+	t.Helper()
+
+	q := `
+		SELECT (SELECT count(*) FROM device.reading_hourly WHERE device_id = $1),
+			(SELECT count(*) FROM device.reading_daily WHERE device_id = $1);
+	`
+	if err := db.QueryRow(context.Background(), q, deviceID).Scan(&hourly, &daily); err != nil {
+		t.Fatalf("집계 조회 error = %v", err)
+	}
+	return hourly, daily
+}
+
+func TestRefreshRollupDeletesEmptyBucket(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	deviceID, sensorID := testDevice(t, db, "rollup-test-"+time.Now().Format("20060102150405.000000"))
+	r := RollupRepository(zap.NewNop(), db)
+
+	at := time.Date(2020, 1, 1, 10, 30, 0, 0, time.UTC)
+	if _, err := db.Exec(ctx, `INSERT INTO device.reading (device_id, sensor_id, time, value) VALUES ($1, $2, $3, 1)`, deviceID, sensorID, at); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.RefreshRollup(ctx, 100000); err != nil {
+		t.Fatalf("RefreshRollup() error = %v", err)
+	}
+	if hourly, daily := countRollup(t, db, deviceID); hourly != 1 || daily != 1 {
+		t.Fatalf("집계 = %d, %d, want 1, 1", hourly, daily)
+	}
+
+	// 수정으로 집계 대기에 등록된 뒤 원본 데이터가 삭제된 구간
+	if _, err := db.Exec(ctx, `UPDATE device.reading SET value = 2 WHERE device_id = $1`, deviceID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(ctx, `DELETE FROM device.reading WHERE device_id = $1`, deviceID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.RefreshRollup(ctx, 100000); err != nil {
+		t.Fatalf("RefreshRollup() error = %v", err)
+	}
+	if hourly, daily := countRollup(t, db, deviceID); hourly != 0 || daily != 0 {
+		t.Errorf("원본 데이터가 없는 구간의 집계 = %d, %d, want 0, 0", hourly, daily)
+	}
+}
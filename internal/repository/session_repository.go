@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type sessionRepository struct {
+	log *zap.Logger
+	db  *pgxpool.Pool
+}
+
+func (r *sessionRepository) CreateSession(ctx context.Context, session *domain.Session, tokens []domain.SessionToken) error {
+	q := `
+		INSERT INTO auth.session (user_id, user_agent, client_ip, expires_at)
+			VALUES ($1, $2, $3, $4)
+			RETURNING id::text, created_at, last_active_at;
+	`
+
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx, q,
+			session.UserID,
+			session.UserAgent,
+			session.ClientIP,
+			session.ExpiresAt,
+		).Scan(&session.ID, &session.CreatedAt, &session.LastActiveAt); err != nil {
+			return err
+		}
+
+		return insertSessionTokens(ctx, tx, session.ID, tokens)
+	})
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.CreateSession() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) AddSessionTokens(ctx context.Context, sessionID string, tokens []domain.SessionToken, expiresAt time.Time) error {
+	q := `
+		UPDATE auth.session SET issued_at = date_trunc('second', now()), expires_at = GREATEST(expires_at, $2)
+			WHERE id = $1::uuid;
+	`
+
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, q, sessionID, expiresAt); err != nil {
+			return err
+		}
+
+		return insertSessionTokens(ctx, tx, sessionID, tokens)
+	})
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.AddSessionTokens() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func insertSessionTokens(ctx context.Context, tx pgx.Tx, sessionID string, tokens []domain.SessionToken) error {
+	q := `
+		INSERT INTO auth.session_token (token_hash, session_id, expires_at)
+			VALUES ($1, $2::uuid, $3)
+			ON CONFLICT (token_hash) DO NOTHING;
+	`
+	for _, t := range tokens {
+		if _, err := tx.Exec(ctx, q, t.TokenHash, sessionID, t.ExpiresAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) GetSessionByTokenHash(ctx context.Context, tokenHash string) (*domain.Session, error) {
+	q := `
+		SELECT s.id::text, s.user_id, s.user_agent, s.client_ip, s.created_at, s.last_active_at, s.expires_at, s.revoked_at
+			FROM auth.session_token t
+			JOIN auth.session s ON s.id = t.session_id
+			WHERE t.token_hash = $1;
+	`
+
+	var s domain.Session
+	if err := r.db.QueryRow(ctx, q, tokenHash).Scan(
+		&s.ID,
+		&s.UserID,
+		&s.UserAgent,
+		&s.ClientIP,
+		&s.CreatedAt,
+		&s.LastActiveAt,
+		&s.ExpiresAt,
+		&s.RevokedAt,
+	); err != nil {
+		err = translateError(err)
+		if !errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Error("auth.r.GetSessionByTokenHash() 오류", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// GetSessionList
+//
+// 전체 세션 폐기(auth.user_token_revocation) 시각 이전에 마지막으로 토큰이 발급된 세션은 사용할 수 없으므로 제외한다.
+func (r *sessionRepository) GetSessionList(ctx context.Context, userID string) ([]*domain.Session, error) {
+	q := `
+		SELECT s.id::text, s.user_id, s.user_agent, s.client_ip, s.created_at, s.last_active_at, s.expires_at, s.revoked_at
+			FROM auth.session s
+			LEFT JOIN auth.user_token_revocation r ON r.user_id = s.user_id
+			WHERE s.user_id = $1
+				AND s.revoked_at IS NULL
+				AND s.expires_at > now()
+				AND (r.revoked_before IS NULL OR s.issued_at >= r.revoked_before)
+			ORDER BY s.last_active_at DESC;
+	`
+
+	rows, err := r.db.Query(ctx, q, userID)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.GetSessionList() 오류", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessionList := make([]*domain.Session, 0)
+
+	for rows.Next() {
+		var s domain.Session
+		if err := rows.Scan(
+			&s.ID,
+			&s.UserID,
+			&s.UserAgent,
+			&s.ClientIP,
+			&s.CreatedAt,
+			&s.LastActiveAt,
+			&s.ExpiresAt,
+			&s.RevokedAt,
+		); err != nil {
+			requestid.Logger(ctx, r.log).Error("auth.r.GetSessionList() 오류", zap.Error(err))
+			return nil, err
+		}
+
+		sessionList = append(sessionList, &s)
+	}
+
+	if err := rows.Err(); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.GetSessionList() 오류", zap.Error(err))
+		return nil, err
+	}
+
+	return sessionList, nil
+}
+
+func (r *sessionRepository) RevokeSession(ctx context.Context, userID string, sessionID string) error {
+	// id 가 uuid 형식이 아니면 캐스팅 오류가 발생하므로 text 로 비교한다.
+	q := `
+		UPDATE auth.session SET revoked_at = now()
+			WHERE user_id = $1 AND id::text = $2 AND revoked_at IS NULL AND expires_at > now();
+	`
+
+	tag, err := r.db.Exec(ctx, q, userID, sessionID)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.RevokeSession() 오류", zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) RevokeUserSessions(ctx context.Context, userID string, exceptSessionID string) (int64, error) {
+	q := `
+		UPDATE auth.session SET revoked_at = now()
+			WHERE user_id = $1 AND id::text <> $2 AND revoked_at IS NULL AND expires_at > now();
+	`
+
+	tag, err := r.db.Exec(ctx, q, userID, exceptSessionID)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.RevokeUserSessions() 오류", zap.Error(err))
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *sessionRepository) UpdateSessionActivity(ctx context.Context, sessionID string, clientIP string, userAgent string) error {
+	q := `
+		UPDATE auth.session SET last_active_at = now(), client_ip = $2, user_agent = $3
+			WHERE id = $1::uuid AND (last_active_at < now() - INTERVAL '1 minute' OR client_ip <> $2 OR user_agent <> $3);
+	`
+	if _, err := r.db.Exec(ctx, q, sessionID, clientIP, userAgent); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.UpdateSessionActivity() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// DeleteExpiredSession
+//
+// 폐기된 세션도 만료 전까지는 토큰을 거절해야 하므로 만료된 이후에 삭제한다.
+func (r *sessionRepository) DeleteExpiredSession(ctx context.Context) (int64, error) {
+	if _, err := r.db.Exec(ctx, `DELETE FROM auth.session_token WHERE expires_at < now();`); err != nil {
+		r.log.Error("auth.r.DeleteExpiredSession() 오류", zap.Error(err))
+		return 0, err
+	}
+
+	tag, err := r.db.Exec(ctx, `DELETE FROM auth.session WHERE expires_at < now();`)
+	if err != nil {
+		r.log.Error("auth.r.DeleteExpiredSession() 오류", zap.Error(err))
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func SessionRepository(logger *zap.Logger, db *pgxpool.Pool) domain.SessionRepository {
+	return &sessionRepository{
+		log: logger,
+		db:  db,
+	}
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type tokenRevocationRepository struct {
+	log *zap.Logger
+	db  *pgxpool.Pool
+}
+
+func (r *tokenRevocationRepository) RevokeToken(ctx context.Context, tokenHash string, kind domain.TokenKind, userID string, expiresAt time.Time) error {
+	q := `
+		INSERT INTO auth.revoked_token (token_hash, kind, user_id, expires_at)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (token_hash) DO NOTHING;
+	`
+	if _, err := r.db.Exec(ctx, q, tokenHash, kind, userID, expiresAt); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.RevokeToken() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *tokenRevocationRepository) IsTokenRevoked(ctx context.Context, tokenHash string) (bool, error) {
+	q := `SELECT EXISTS (SELECT 1 FROM auth.revoked_token WHERE token_hash = $1 AND expires_at > now());`
+
+	var revoked bool
+	if err := r.db.QueryRow(ctx, q, tokenHash).Scan(&revoked); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.IsTokenRevoked() 오류", zap.Error(err))
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+func (r *tokenRevocationRepository) RevokeUserTokens(ctx context.Context, userID string, revokedBefore time.Time) error {
+	q := `
+		INSERT INTO auth.user_token_revocation (user_id, revoked_before)
+			VALUES ($1, $2)
+			ON CONFLICT (user_id) DO UPDATE SET revoked_before = GREATEST(auth.user_token_revocation.revoked_before, excluded.revoked_before);
+	`
+	if _, err := r.db.Exec(ctx, q, userID, revokedBefore); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.RevokeUserTokens() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *tokenRevocationRepository) GetUserTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	q := `SELECT revoked_before FROM auth.user_token_revocation WHERE user_id = $1;`
+
+	var revokedBefore time.Time
+	if err := r.db.QueryRow(ctx, q, userID).Scan(&revokedBefore); err != nil {
+		err = translateError(err)
+		if !errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Error("auth.r.GetUserTokensRevokedBefore() 오류", zap.Error(err))
+		}
+		return time.Time{}, err
+	}
+
+	return revokedBefore, nil
+}
+
+func (r *tokenRevocationRepository) DeleteExpiredRevokedToken(ctx context.Context) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM auth.revoked_token WHERE expires_at < now();`)
+	if err != nil {
+		r.log.Error("auth.r.DeleteExpiredRevokedToken() 오류", zap.Error(err))
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func TokenRevocationRepository(logger *zap.Logger, db *pgxpool.Pool) domain.TokenRevocationRepository {
+	return &tokenRevocationRepository{
+		log: logger,
+		db:  db,
+	}
+}
@@ -0,0 +1,283 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type twoFactorRepository struct {
+	log *zap.Logger
+	db  *pgxpool.Pool
+}
+
+func (r *twoFactorRepository) SaveTwoFactorSecret(ctx context.Context, userID string, encryptedSecret []byte) error {
+	q := `
+		INSERT INTO auth.two_factor (user_id, encrypted_secret)
+			VALUES ($1, $2)
+			ON CONFLICT (user_id) DO UPDATE SET encrypted_secret = excluded.encrypted_secret, last_used_step = 0, created_at = now()
+				WHERE auth.two_factor.enabled_at IS NULL;
+	`
+
+	tag, err := r.db.Exec(ctx, q, userID, encryptedSecret)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.SaveTwoFactorSecret() 오류", zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepository) GetTwoFactor(ctx context.Context, userID string) (*domain.TwoFactor, error) {
+	q := `SELECT user_id, encrypted_secret, last_used_step, created_at, enabled_at FROM auth.two_factor WHERE user_id = $1;`
+
+	var t domain.TwoFactor
+	if err := r.db.QueryRow(ctx, q, userID).Scan(
+		&t.UserID,
+		&t.EncryptedSecret,
+		&t.LastUsedStep,
+		&t.CreatedAt,
+		&t.EnabledAt,
+	); err != nil {
+		err = translateError(err)
+		if !errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Error("auth.r.GetTwoFactor() 오류", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (r *twoFactorRepository) EnableTwoFactor(ctx context.Context, userID string, step int64, recoveryCodeHashes []string) error {
+	q := `UPDATE auth.two_factor SET enabled_at = now(), last_used_step = $2 WHERE user_id = $1 AND enabled_at IS NULL;`
+
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, q, userID, step)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return domain.ErrTwoFactorAlreadyEnabled
+		}
+
+		return replaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes)
+	})
+	if err != nil {
+		if !errors.Is(err, domain.ErrTwoFactorAlreadyEnabled) {
+			requestid.Logger(ctx, r.log).Error("auth.r.EnableTwoFactor() 오류", zap.Error(err))
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepository) DeleteTwoFactor(ctx context.Context, userID string) error {
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM auth.two_factor_recovery_code WHERE user_id = $1;`, userID); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, `DELETE FROM auth.two_factor WHERE user_id = $1;`, userID)
+		return err
+	})
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.DeleteTwoFactor() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepository) UseTwoFactorStep(ctx context.Context, userID string, step int64) (bool, error) {
+	q := `UPDATE auth.two_factor SET last_used_step = $2 WHERE user_id = $1 AND last_used_step < $2;`
+
+	tag, err := r.db.Exec(ctx, q, userID, step)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.UseTwoFactorStep() 오류", zap.Error(err))
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *twoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		return replaceRecoveryCodes(ctx, tx, userID, codeHashes)
+	})
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.ReplaceRecoveryCodes() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func replaceRecoveryCodes(ctx context.Context, tx pgx.Tx, userID string, codeHashes []string) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM auth.two_factor_recovery_code WHERE user_id = $1;`, userID); err != nil {
+		return err
+	}
+
+	q := `
+		INSERT INTO auth.two_factor_recovery_code (user_id, code_hash)
+			SELECT $1, unnest($2::text[])
+			ON CONFLICT DO NOTHING;
+	`
+	_, err := tx.Exec(ctx, q, userID, codeHashes)
+	return err
+}
+
+func (r *twoFactorRepository) UseRecoveryCode(ctx context.Context, userID string, codeHash string) (bool, error) {
+	q := `
+		UPDATE auth.two_factor_recovery_code SET used_at = now()
+			WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL;
+	`
+
+	tag, err := r.db.Exec(ctx, q, userID, codeHash)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.UseRecoveryCode() 오류", zap.Error(err))
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *twoFactorRepository) CountRecoveryCodes(ctx context.Context, userID string) (int, error) {
+	q := `SELECT count(*) FROM auth.two_factor_recovery_code WHERE user_id = $1 AND used_at IS NULL;`
+
+	var count int
+	if err := r.db.QueryRow(ctx, q, userID).Scan(&count); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.CountRecoveryCodes() 오류", zap.Error(err))
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *twoFactorRepository) CreateTwoFactorChallenge(ctx context.Context, challengeHash string, userID string, encryptedToken []byte, expiresAt time.Time) error {
+	q := `
+		INSERT INTO auth.two_factor_challenge (challenge_hash, user_id, encrypted_token, expires_at)
+			VALUES ($1, $2, $3, $4);
+	`
+	if _, err := r.db.Exec(ctx, q, challengeHash, userID, encryptedToken, expiresAt); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.CreateTwoFactorChallenge() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepository) GetTwoFactorChallenge(ctx context.Context, challengeHash string) (string, []byte, error) {
+	q := `
+		SELECT user_id, encrypted_token FROM auth.two_factor_challenge
+			WHERE challenge_hash = $1 AND expires_at > now();
+	`
+
+	var userID string
+	var encryptedToken []byte
+	if err := r.db.QueryRow(ctx, q, challengeHash).Scan(&userID, &encryptedToken); err != nil {
+		err = translateError(err)
+		if !errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Error("auth.r.GetTwoFactorChallenge() 오류", zap.Error(err))
+		}
+		return "", nil, err
+	}
+
+	return userID, encryptedToken, nil
+}
+
+func (r *twoFactorRepository) TakeTwoFactorChallenge(ctx context.Context, challengeHash string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM auth.two_factor_challenge WHERE challenge_hash = $1 AND expires_at > now();`, challengeHash)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.TakeTwoFactorChallenge() 오류", zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepository) IncrementTwoFactorChallengeAttempts(ctx context.Context, challengeHash string) (int, error) {
+	q := `
+		UPDATE auth.two_factor_challenge SET attempts = attempts + 1
+			WHERE challenge_hash = $1 AND expires_at > now()
+			RETURNING attempts;
+	`
+
+	var attempts int
+	if err := r.db.QueryRow(ctx, q, challengeHash).Scan(&attempts); err != nil {
+		err = translateError(err)
+		if !errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Error("auth.r.IncrementTwoFactorChallengeAttempts() 오류", zap.Error(err))
+		}
+		return 0, err
+	}
+
+	return attempts, nil
+}
+
+func (r *twoFactorRepository) DeleteExpiredTwoFactorChallenge(ctx context.Context) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM auth.two_factor_challenge WHERE expires_at < now();`)
+	if err != nil {
+		r.log.Error("auth.r.DeleteExpiredTwoFactorChallenge() 오류", zap.Error(err))
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *twoFactorRepository) GetTwoFactorRequiredRoleList(ctx context.Context) ([]domain.UserRole, error) {
+	rows, err := r.db.Query(ctx, `SELECT role FROM auth.two_factor_required_role ORDER BY role;`)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.GetTwoFactorRequiredRoleList() 오류", zap.Error(err))
+		return nil, err
+	}
+
+	roles, err := pgx.CollectRows(rows, pgx.RowTo[domain.UserRole])
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.GetTwoFactorRequiredRoleList() 오류", zap.Error(err))
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (r *twoFactorRepository) SetTwoFactorRequiredRoleList(ctx context.Context, roles []domain.UserRole, updatedBy string) error {
+	q := `
+		INSERT INTO auth.two_factor_required_role (role, updated_by)
+			SELECT unnest($1::text[]), $2
+			ON CONFLICT (role) DO NOTHING;
+	`
+
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM auth.two_factor_required_role WHERE NOT (role = ANY($1::text[]));`, roles); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, q, roles, updatedBy)
+		return err
+	})
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.SetTwoFactorRequiredRoleList() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func TwoFactorRepository(logger *zap.Logger, db *pgxpool.Pool) domain.TwoFactorRepository {
+	return &twoFactorRepository{
+		log: logger,
+		db:  db,
+	}
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type userSuspensionRepository struct {
+	log *zap.Logger
+	db  *pgxpool.Pool
+}
+
+func (r *userSuspensionRepository) CreateUserSuspension(ctx context.Context, suspension *domain.UserSuspension) error {
+	q := `
+		INSERT INTO auth.user_suspension (user_id, reason, suspended_by)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (user_id) DO UPDATE SET reason = excluded.reason, suspended_by = excluded.suspended_by
+			RETURNING suspended_at;
+	`
+	if err := r.db.QueryRow(ctx, q,
+		suspension.UserID,
+		suspension.Reason,
+		suspension.SuspendedBy,
+	).Scan(&suspension.SuspendedAt); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.CreateUserSuspension() 오류", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *userSuspensionRepository) DeleteUserSuspension(ctx context.Context, userID string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM auth.user_suspension WHERE user_id = $1;`, userID)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.DeleteUserSuspension() 오류", zap.Error(err))
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *userSuspensionRepository) GetUserSuspension(ctx context.Context, userID string) (*domain.UserSuspension, error) {
+	q := `SELECT user_id, reason, suspended_by, suspended_at FROM auth.user_suspension WHERE user_id = $1;`
+
+	var s domain.UserSuspension
+	if err := r.db.QueryRow(ctx, q, userID).Scan(
+		&s.UserID,
+		&s.Reason,
+		&s.SuspendedBy,
+		&s.SuspendedAt,
+	); err != nil {
+		err = translateError(err)
+		if !errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, r.log).Error("auth.r.GetUserSuspension() 오류", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (r *userSuspensionRepository) GetUserSuspensionList(ctx context.Context) ([]*domain.UserSuspension, error) {
+	q := `SELECT user_id, reason, suspended_by, suspended_at FROM auth.user_suspension ORDER BY suspended_at DESC;`
+
+	rows, err := r.db.Query(ctx, q)
+	if err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.GetUserSuspensionList() 오류", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	suspensionList := make([]*domain.UserSuspension, 0)
+
+	for rows.Next() {
+		var s domain.UserSuspension
+		if err := rows.Scan(
+			&s.UserID,
+			&s.Reason,
+			&s.SuspendedBy,
+			&s.SuspendedAt,
+		); err != nil {
+			requestid.Logger(ctx, r.log).Error("auth.r.GetUserSuspensionList() 오류", zap.Error(err))
+			return nil, err
+		}
+
+		suspensionList = append(suspensionList, &s)
+	}
+
+	if err := rows.Err(); err != nil {
+		requestid.Logger(ctx, r.log).Error("auth.r.GetUserSuspensionList() 오류", zap.Error(err))
+		return nil, err
+	}
+
+	return suspensionList, nil
+}
+
+func UserSuspensionRepository(logger *zap.Logger, db *pgxpool.Pool) domain.UserSuspensionRepository {
+	return &userSuspensionRepository{
+		log: logger,
+		db:  db,
+	}
+}
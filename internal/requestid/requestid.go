@@ -0,0 +1,61 @@
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// Header 요청, 응답 헤더 이름
+	Header = "X-Request-ID"
+	// MetadataKey gRPC 메타 데이터 키
+	MetadataKey = "x-request-id"
+
+	maxLength = 128
+)
+
+type contextKey struct{}
+
+// New 새로운 요청 ID 를 생성합니다.
+func New() string {
+	return uuid.NewString()
+}
+
+// Valid 외부에서 받은 요청 ID 를 그대로 사용할 수 있는지 확인합니다.
+//
+// 로그와 헤더에 그대로 기록되므로 길이와 문자(영문, 숫자, - _ . :)를 제한한다.
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// NewContext 요청 ID 를 context 에 설정합니다.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext context 의 요청 ID 를 반환합니다. 없으면 빈 문자열 입니다.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Logger context 의 요청 ID 를 request_id 필드로 추가한 logger 를 반환합니다.
+func Logger(ctx context.Context, log *zap.Logger) *zap.Logger {
+	if id := FromContext(ctx); id != "" {
+		return log.With(zap.String("request_id", id))
+	}
+	return log
+}
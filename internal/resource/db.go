@@ -2,18 +2,49 @@ package resource
 
 import (
 	"context"
+	"time"
 
+	"github.com/exaring/otelpgx"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 )
 
-func InitDB(connectionString string, log *zap.Logger) *pgxpool.Pool {
-	config, err := pgxpool.ParseConfig(connectionString)
+// PoolConfig Postgres 커넥션 풀 설정 입니다. 0 인 값은 pgx 기본값을 사용합니다.
+type PoolConfig struct {
+	Url               string
+	MaxConns          int32
+	MinConns          int32
+	MaxConnLifetime   time.Duration
+	MaxConnIdleTime   time.Duration
+	HealthCheckPeriod time.Duration
+}
+
+func InitDB(cfg PoolConfig, log *zap.Logger) *pgxpool.Pool {
+	poolConfig, err := pgxpool.ParseConfig(cfg.Url)
 	if err != nil {
 		log.Fatal("failed to parse DB config", zap.Error(err))
 	}
+	// 쿼리 단위 span 생성
+	poolConfig.ConnConfig.Tracer = otelpgx.NewTracer()
+
+	// 커넥션 풀 설정 (0 인 경우 pgx 기본값 사용)
+	if cfg.MaxConns > 0 {
+		poolConfig.MaxConns = cfg.MaxConns
+	}
+	if cfg.MinConns > 0 {
+		poolConfig.MinConns = cfg.MinConns
+	}
+	if cfg.MaxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = cfg.MaxConnLifetime
+	}
+	if cfg.MaxConnIdleTime > 0 {
+		poolConfig.MaxConnIdleTime = cfg.MaxConnIdleTime
+	}
+	if cfg.HealthCheckPeriod > 0 {
+		poolConfig.HealthCheckPeriod = cfg.HealthCheckPeriod
+	}
 
-	pool, err := pgxpool.NewWithConfig(context.Background(), config)
+	pool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
 	if err != nil {
 		log.Fatal("failed to connect to DB", zap.Error(err))
 	}
@@ -22,7 +53,10 @@ func InitDB(connectionString string, log *zap.Logger) *pgxpool.Pool {
 		log.Fatal("failed to ping DB", zap.Error(err))
 	}
 
-	log.Info("Database connection pool initialized successfully")
+	log.Info("Database connection pool initialized successfully",
+		zap.Int32("max_conns", poolConfig.MaxConns),
+		zap.Int32("min_conns", poolConfig.MinConns),
+	)
 
 	return pool
 }
@@ -0,0 +1,138 @@
+package seed
+
+import (
+	"embed"
+	"fmt"
+	"slices"
+
+	"github.com/GDH-Project/api/internal/agro"
+	"github.com/goccy/go-yaml"
+)
+
+//go:embed data/*.yaml
+var dataFS embed.FS
+
+type sensorSeed struct {
+	Title           string   `yaml:"title"`
+	EngTitle        string   `yaml:"eng_title"`
+	Description     string   `yaml:"description"`
+	Unit            *string  `yaml:"unit"`
+	UnitDescription *string  `yaml:"unit_description"`
+	Formula         *string  `yaml:"formula"`
+	Inputs          []string `yaml:"inputs"`
+}
+
+type cropSeed struct {
+	Title       string   `yaml:"title"`
+	Description *string  `yaml:"description"`
+	GDDBase     *float64 `yaml:"gdd_base"`
+	GDDUpper    *float64 `yaml:"gdd_upper"`
+}
+
+type updateCycleSeed struct {
+	Interval    int     `yaml:"interval"`
+	Description *string `yaml:"description"`
+}
+
+type addressStateSeed struct {
+	Title  string   `yaml:"title"`
+	Cities []string `yaml:"cities"`
+}
+
+// Catalogue
+//
+// 바이너리에 포함된 기본 참조 데이터 입니다.
+type Catalogue struct {
+	Sensors      []*sensorSeed
+	Crops        []*cropSeed
+	UpdateCycles []*updateCycleSeed
+	Addresses    []*addressStateSeed
+}
+
+func loadCatalogue() (*Catalogue, error) {
+	var c Catalogue
+
+	for name, out := range map[string]any{
+		"data/sensor.yaml":       &c.Sensors,
+		"data/crop.yaml":         &c.Crops,
+		"data/update_cycle.yaml": &c.UpdateCycles,
+		"data/address.yaml":      &c.Addresses,
+	} {
+		b, err := dataFS.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.UnmarshalWithOptions(b, out, yaml.Strict()); err != nil {
+			return nil, fmt.Errorf("%s 해석 실패: %w", name, err)
+		}
+	}
+
+	if err := validateSensors(c.Sensors); err != nil {
+		return nil, fmt.Errorf("data/sensor.yaml 검증 실패: %w", err)
+	}
+	if err := validateCrops(c.Crops); err != nil {
+		return nil, fmt.Errorf("data/crop.yaml 검증 실패: %w", err)
+	}
+
+	return &c, nil
+}
+
+// validateSensors 파생 센서의 계산식과 입력 센서를 확인합니다.
+//
+// 입력 센서는 카탈로그에 있는 수집 센서여야 하며 계산식의 입력 순서, 단위와 같아야 합니다.
+func validateSensors(sensors []*sensorSeed) error {
+	byTitle := make(map[string]*sensorSeed, len(sensors))
+	for _, s := range sensors {
+		if _, ok := byTitle[s.Title]; ok {
+			return fmt.Errorf("중복된 센서 입니다 (%s)", s.Title)
+		}
+		byTitle[s.Title] = s
+	}
+
+	for _, s := range sensors {
+		if s.Formula == nil {
+			if len(s.Inputs) > 0 {
+				return fmt.Errorf("%s: formula 없이 inputs 를 사용할 수 없습니다", s.Title)
+			}
+			continue
+		}
+
+		formula, err := agro.LookupFormula(*s.Formula)
+		if err != nil {
+			return fmt.Errorf("%s: %w", s.Title, err)
+		}
+		if len(s.Inputs) != len(formula.Inputs) {
+			return fmt.Errorf("%s: %s 계산식은 입력 센서 %d개가 필요합니다", s.Title, formula.Name, len(formula.Inputs))
+		}
+		for i, title := range s.Inputs {
+			in, ok := byTitle[title]
+			switch {
+			case !ok:
+				return fmt.Errorf("%s: 존재하지 않는 입력 센서 입니다 (%s)", s.Title, title)
+			case in.Formula != nil:
+				return fmt.Errorf("%s: 파생 센서는 입력으로 사용할 수 없습니다 (%s)", s.Title, title)
+			case deref(in.Unit) != formula.Inputs[i].Unit:
+				return fmt.Errorf("%s: %s 의 단위가 %s 입력과 다릅니다 (%q, 필요 %q)", s.Title, title, formula.Inputs[i].Name, deref(in.Unit), formula.Inputs[i].Unit)
+			}
+		}
+		if slices.Contains(s.Inputs, s.Title) {
+			return fmt.Errorf("%s: 자기 자신을 입력으로 사용할 수 없습니다", s.Title)
+		}
+	}
+
+	return nil
+}
+
+// validateCrops 생육 도일 기준 온도와 상한 온도는 함께 있어야 하며 상한 온도가 더 높아야 합니다.
+func validateCrops(crops []*cropSeed) error {
+	for _, c := range crops {
+		if (c.GDDBase == nil) != (c.GDDUpper == nil) {
+			return fmt.Errorf("%s: gdd_base, gdd_upper 는 함께 설정해야 합니다", c.Title)
+		}
+		if c.GDDBase != nil && *c.GDDUpper <= *c.GDDBase {
+			return fmt.Errorf("%s: gdd_upper 는 gdd_base 보다 커야 합니다", c.Title)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,297 @@
+package seed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type Action string
+
+const (
+	ActionInsert Action = "insert"
+	ActionUpdate Action = "update"
+)
+
+// Change 참조 데이터 한 건의 변경 내역 입니다.
+type Change struct {
+	Table  string
+	Action Action
+	Key    string
+	Diff   []string // 변경되는 컬럼 "column: old -> new"
+}
+
+func (c *Change) String() string {
+	if c.Action == ActionInsert {
+		return fmt.Sprintf("+ %s %s", c.Table, c.Key)
+	}
+	return fmt.Sprintf("~ %s %s %v", c.Table, c.Key, c.Diff)
+}
+
+// Seeder
+//
+// 카탈로그와 DB 를 비교하여 없는 항목은 추가하고 다른 항목은 갱신합니다.
+// 카탈로그에 없는 DB 데이터는 삭제하지 않으므로 여러번 실행해도 안전합니다.
+type Seeder struct {
+	log       *zap.Logger
+	db        *pgxpool.Pool
+	catalogue *Catalogue
+}
+
+// Run 카탈로그를 동기화 합니다. dryRun 인 경우 변경 내역만 계산하고 트랜잭션을 롤백합니다.
+func (s *Seeder) Run(ctx context.Context, dryRun bool) ([]*Change, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var changes []*Change
+	for _, step := range []func(context.Context, pgx.Tx) ([]*Change, error){
+		s.seedSensors,
+		s.seedCrops,
+		s.seedUpdateCycles,
+		s.seedAddresses,
+	} {
+		c, err := step(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, c...)
+	}
+
+	if dryRun {
+		return changes, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	s.log.Info("참조 데이터 동기화 완료", zap.Int("changes", len(changes)))
+
+	return changes, nil
+}
+
+func (s *Seeder) seedSensors(ctx context.Context, tx pgx.Tx) ([]*Change, error) {
+	var changes []*Change
+
+	for _, in := range s.catalogue.Sensors {
+		inputs := in.Inputs
+		if inputs == nil {
+			inputs = []string{}
+		}
+
+		var cur sensorSeed
+		err := tx.QueryRow(ctx,
+			`SELECT title, eng_title, description, unit, unit_description, formula, inputs FROM device.sensor WHERE title = $1;`,
+			in.Title,
+		).Scan(&cur.Title, &cur.EngTitle, &cur.Description, &cur.Unit, &cur.UnitDescription, &cur.Formula, &cur.Inputs)
+
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			if _, err := tx.Exec(ctx,
+				`INSERT INTO device.sensor (title, eng_title, description, unit, unit_description, formula, inputs) VALUES ($1, $2, $3, $4, $5, $6, $7);`,
+				in.Title, in.EngTitle, in.Description, in.Unit, in.UnitDescription, in.Formula, inputs,
+			); err != nil {
+				return nil, fmt.Errorf("device.sensor %s 추가 실패: %w", in.Title, err)
+			}
+			changes = append(changes, &Change{Table: "device.sensor", Action: ActionInsert, Key: in.Title})
+		case err != nil:
+			return nil, err
+		default:
+			diff := diffFields(
+				field{"eng_title", cur.EngTitle, in.EngTitle},
+				field{"description", cur.Description, in.Description},
+				field{"unit", deref(cur.Unit), deref(in.Unit)},
+				field{"unit_description", deref(cur.UnitDescription), deref(in.UnitDescription)},
+				field{"formula", deref(cur.Formula), deref(in.Formula)},
+				field{"inputs", strings.Join(cur.Inputs, ","), strings.Join(inputs, ",")},
+			)
+			if len(diff) == 0 {
+				continue
+			}
+			if _, err := tx.Exec(ctx,
+				`UPDATE device.sensor SET eng_title = $2, description = $3, unit = $4, unit_description = $5, formula = $6, inputs = $7 WHERE title = $1;`,
+				in.Title, in.EngTitle, in.Description, in.Unit, in.UnitDescription, in.Formula, inputs,
+			); err != nil {
+				return nil, fmt.Errorf("device.sensor %s 갱신 실패: %w", in.Title, err)
+			}
+			changes = append(changes, &Change{Table: "device.sensor", Action: ActionUpdate, Key: in.Title, Diff: diff})
+		}
+	}
+
+	return changes, nil
+}
+
+func (s *Seeder) seedCrops(ctx context.Context, tx pgx.Tx) ([]*Change, error) {
+	var changes []*Change
+
+	for _, in := range s.catalogue.Crops {
+		var cur cropSeed
+		err := tx.QueryRow(ctx,
+			`SELECT title, description, gdd_base, gdd_upper FROM device.crop WHERE title = $1;`,
+			in.Title,
+		).Scan(&cur.Title, &cur.Description, &cur.GDDBase, &cur.GDDUpper)
+
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			if _, err := tx.Exec(ctx,
+				`INSERT INTO device.crop (title, description, gdd_base, gdd_upper) VALUES ($1, $2, $3, $4);`,
+				in.Title, in.Description, in.GDDBase, in.GDDUpper,
+			); err != nil {
+				return nil, fmt.Errorf("device.crop %s 추가 실패: %w", in.Title, err)
+			}
+			changes = append(changes, &Change{Table: "device.crop", Action: ActionInsert, Key: in.Title})
+		case err != nil:
+			return nil, err
+		default:
+			diff := diffFields(
+				field{"description", deref(cur.Description), deref(in.Description)},
+				field{"gdd_base", derefFloat(cur.GDDBase), derefFloat(in.GDDBase)},
+				field{"gdd_upper", derefFloat(cur.GDDUpper), derefFloat(in.GDDUpper)},
+			)
+			if len(diff) == 0 {
+				continue
+			}
+			if _, err := tx.Exec(ctx,
+				`UPDATE device.crop SET description = $2, gdd_base = $3, gdd_upper = $4 WHERE title = $1;`,
+				in.Title, in.Description, in.GDDBase, in.GDDUpper,
+			); err != nil {
+				return nil, fmt.Errorf("device.crop %s 갱신 실패: %w", in.Title, err)
+			}
+			changes = append(changes, &Change{Table: "device.crop", Action: ActionUpdate, Key: in.Title, Diff: diff})
+		}
+	}
+
+	return changes, nil
+}
+
+func (s *Seeder) seedUpdateCycles(ctx context.Context, tx pgx.Tx) ([]*Change, error) {
+	var changes []*Change
+
+	for _, in := range s.catalogue.UpdateCycles {
+		key := fmt.Sprintf("%d분", in.Interval)
+
+		var cur updateCycleSeed
+		err := tx.QueryRow(ctx,
+			`SELECT interval, description FROM device.update_cycle WHERE interval = $1;`,
+			in.Interval,
+		).Scan(&cur.Interval, &cur.Description)
+
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			if _, err := tx.Exec(ctx,
+				`INSERT INTO device.update_cycle (interval, description) VALUES ($1, $2);`,
+				in.Interval, in.Description,
+			); err != nil {
+				return nil, fmt.Errorf("device.update_cycle %s 추가 실패: %w", key, err)
+			}
+			changes = append(changes, &Change{Table: "device.update_cycle", Action: ActionInsert, Key: key})
+		case err != nil:
+			return nil, err
+		default:
+			diff := diffFields(
+				field{"description", deref(cur.Description), deref(in.Description)},
+			)
+			if len(diff) == 0 {
+				continue
+			}
+			if _, err := tx.Exec(ctx,
+				`UPDATE device.update_cycle SET description = $2 WHERE interval = $1;`,
+				in.Interval, in.Description,
+			); err != nil {
+				return nil, fmt.Errorf("device.update_cycle %s 갱신 실패: %w", key, err)
+			}
+			changes = append(changes, &Change{Table: "device.update_cycle", Action: ActionUpdate, Key: key, Diff: diff})
+		}
+	}
+
+	return changes, nil
+}
+
+func (s *Seeder) seedAddresses(ctx context.Context, tx pgx.Tx) ([]*Change, error) {
+	var changes []*Change
+
+	for _, in := range s.catalogue.Addresses {
+		var stateID int
+		err := tx.QueryRow(ctx,
+			`SELECT id FROM device.address_state WHERE title = $1;`,
+			in.Title,
+		).Scan(&stateID)
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			if err := tx.QueryRow(ctx,
+				`INSERT INTO device.address_state (title) VALUES ($1) RETURNING id;`,
+				in.Title,
+			).Scan(&stateID); err != nil {
+				return nil, fmt.Errorf("device.address_state %s 추가 실패: %w", in.Title, err)
+			}
+			changes = append(changes, &Change{Table: "device.address_state", Action: ActionInsert, Key: in.Title})
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, city := range in.Cities {
+			tag, err := tx.Exec(ctx,
+				`INSERT INTO device.address_city (address_state_id, title) VALUES ($1, $2) ON CONFLICT (address_state_id, title) DO NOTHING;`,
+				stateID, city,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("device.address_city %s %s 추가 실패: %w", in.Title, city, err)
+			}
+			if tag.RowsAffected() > 0 {
+				changes = append(changes, &Change{Table: "device.address_city", Action: ActionInsert, Key: in.Title + " " + city})
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+type field struct {
+	name     string
+	old, new string
+}
+
+func diffFields(fields ...field) []string {
+	var diff []string
+	for _, f := range fields {
+		if f.old != f.new {
+			diff = append(diff, fmt.Sprintf("%s: %q -> %q", f.name, f.old, f.new))
+		}
+	}
+	return diff
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefFloat(f *float64) string {
+	if f == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*f, 'f', -1, 64)
+}
+
+func NewSeeder(log *zap.Logger, db *pgxpool.Pool) (*Seeder, error) {
+	catalogue, err := loadCatalogue()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Seeder{
+		log:       log,
+		db:        db,
+		catalogue: catalogue,
+	}, nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/job"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+const (
+	// accountDeletionPendingTimeout 이 시간이 지나도 pending 인 작업은 사용자 서버에서 탈퇴 여부를 확인합니다.
+	accountDeletionPendingTimeout = 10 * time.Minute
+	// accountDeletionBatchSize 한번에 재시도하는 작업 수 입니다.
+	accountDeletionBatchSize = 20
+)
+
+type accountDeletionService struct {
+	log    *zap.Logger
+	r      domain.AccountDeletionRepository
+	client domain.UserClient
+	audit  domain.AuditService
+	policy domain.AccountDeletionPolicy
+}
+
+func (svc *accountDeletionService) RequestAccountDeletion(ctx context.Context, userID string, email string, mode domain.AccountDeletionMode) error {
+	return svc.r.CreateAccountDeletion(ctx, &domain.AccountDeletion{
+		UserID: userID,
+		Email:  email,
+		Mode:   mode,
+	})
+}
+
+func (svc *accountDeletionService) ConfirmAccountDeletion(ctx context.Context, userID string) error {
+	return svc.r.ConfirmAccountDeletion(ctx, userID)
+}
+
+func (svc *accountDeletionService) CancelAccountDeletion(ctx context.Context, userID string) error {
+	return svc.r.DeleteAccountDeletion(ctx, userID)
+}
+
+// CleanupAccountData
+//
+// 정리 결과는 성공, 실패 모두 감사 로그로 남기며 실패하면 재시도 시각을 기록합니다.
+func (svc *accountDeletionService) CleanupAccountData(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	deletion, err := svc.r.PurgeAccountData(ctx, userID)
+	if errors.Is(err, domain.ErrNotFound) {
+		// 이미 완료되었거나 다른 요청이 처리한 경우
+		return svc.r.GetAccountDeletion(ctx, userID)
+	}
+
+	event := &domain.AuditEvent{
+		Action:     domain.AuditActionUserDataCleanup,
+		TargetType: "user",
+		TargetID:   userID,
+		Result:     domain.AuditResultSuccess,
+	}
+	if err != nil {
+		event.Result = domain.AuditResultFailure
+		event.Reason = err.Error()
+		if failErr := svc.r.FailAccountDeletion(ctx, userID, err.Error(), svc.policy.RetryAfter); failErr != nil {
+			requestid.Logger(ctx, svc.log).Error("회원 탈퇴 데이터 정리 실패 기록 오류", zap.Error(failErr), zap.String("user_id", userID))
+		}
+	}
+	if auditErr := svc.audit.CreateAuditEvent(context.WithoutCancel(ctx), event); auditErr != nil {
+		requestid.Logger(ctx, svc.log).Error("감사 로그 기록 실패", zap.Error(auditErr),
+			zap.String("action", string(event.Action)),
+			zap.String("target_id", userID),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	requestid.Logger(ctx, svc.log).Info("회원 탈퇴 데이터 정리", zap.String("user_id", userID),
+		zap.String("mode", string(deletion.Mode)),
+		zap.Any("result", deletion.Result),
+	)
+	return deletion, nil
+}
+
+func (svc *accountDeletionService) GetAccountDeletion(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	return svc.r.GetAccountDeletion(ctx, userID)
+}
+
+func (svc *accountDeletionService) GetAccountDeletionList(ctx context.Context, status domain.AccountDeletionStatus) ([]*domain.AccountDeletion, error) {
+	return svc.r.GetAccountDeletionListByStatus(ctx, status, 100)
+}
+
+func (svc *accountDeletionService) DefaultMode() domain.AccountDeletionMode {
+	return svc.policy.DefaultMode
+}
+
+// resume
+//
+// 서버 종료 등으로 중단된 작업을 주기적으로 이어서 처리합니다.
+// pending 작업은 사용자 서버에서 탈퇴 여부를 확인하여 탈퇴되었으면 정리하고, 남아있으면 실패한 탈퇴로 보고 삭제합니다.
+func (svc *accountDeletionService) resume(ctx context.Context) error {
+	stale, err := svc.r.GetStaleAccountDeletionList(ctx, time.Now().Add(-accountDeletionPendingTimeout), accountDeletionBatchSize)
+	if err != nil {
+		return fmt.Errorf("중단된 회원 탈퇴 조회 실패: %w", err)
+	}
+	for _, d := range stale {
+		_, err := svc.client.GetUserInfoByUserID(ctx, d.UserID)
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			if err := svc.r.ConfirmAccountDeletion(ctx, d.UserID); err != nil {
+				svc.log.Warn("중단된 회원 탈퇴 확인 실패", zap.Error(err), zap.String("user_id", d.UserID))
+			}
+		case err == nil:
+			if err := svc.r.DeleteAccountDeletion(ctx, d.UserID); err != nil {
+				svc.log.Warn("실패한 회원 탈퇴 작업 삭제 실패", zap.Error(err), zap.String("user_id", d.UserID))
+			}
+		default:
+			svc.log.Warn("중단된 회원 탈퇴 사용자 조회 실패", zap.Error(err), zap.String("user_id", d.UserID))
+		}
+	}
+
+	due, err := svc.r.GetDueAccountDeletionList(ctx, accountDeletionBatchSize)
+	if err != nil {
+		return fmt.Errorf("회원 탈퇴 데이터 정리 대상 조회 실패: %w", err)
+	}
+	for _, d := range due {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if _, err := svc.CleanupAccountData(ctx, d.UserID); err != nil {
+			svc.log.Warn("회원 탈퇴 데이터 정리 재시도 실패", zap.Error(err), zap.String("user_id", d.UserID),
+				zap.Int("attempts", d.Attempts+1),
+			)
+		}
+	}
+
+	return nil
+}
+
+func NewAccountDeletionService(log *zap.Logger, accountDeletionRepository domain.AccountDeletionRepository, userClient domain.UserClient, auditService domain.AuditService, policy domain.AccountDeletionPolicy, jobs *job.Runner) domain.AccountDeletionService {
+	svc := &accountDeletionService{
+		log:    log,
+		r:      accountDeletionRepository,
+		client: userClient,
+		audit:  auditService,
+		policy: policy,
+	}
+	jobs.Every("account_deletion_resume", 5*time.Minute, svc.resume)
+
+	return svc
+}
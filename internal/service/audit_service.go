@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+type auditService struct {
+	log *zap.Logger
+	r   domain.AuditRepository
+}
+
+func (svc *auditService) CreateAuditEvent(ctx context.Context, event *domain.AuditEvent) error {
+	return svc.r.CreateAuditEvent(ctx, event)
+}
+
+func (svc *auditService) GetAuditEventListByFilterWithPage(ctx context.Context, filter *domain.AuditFilter, page domain.Page) ([]*domain.AuditEvent, int, error) {
+	return svc.r.GetAuditEventListByFilterWithPage(ctx, filter, page)
+}
+
+func NewAuditService(log *zap.Logger, auditRepository domain.AuditRepository) domain.AuditService {
+	return &auditService{
+		log: log,
+		r:   auditRepository,
+	}
+}
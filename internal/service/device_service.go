@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+type deviceService struct {
+	log *zap.Logger
+	r   domain.DeviceRepository
+}
+
+func (svc *deviceService) CreateDevice(ctx context.Context, in *domain.DeviceInfo) (*domain.DeviceInfo, error) {
+	if err := svc.r.CreateDevice(ctx, in); err != nil {
+		return nil, err
+	}
+	return in, nil
+}
+
+// GetDevice
+//
+// 다른 사용자의 장치는 존재 여부를 알 수 없도록 ErrNotFound 를 반환합니다.
+func (svc *deviceService) GetDevice(ctx context.Context, userID string, deviceID string) (*domain.DeviceInfo, error) {
+	d, err := svc.r.GetDeviceByID(ctx, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	if d.UserID != userID {
+		return nil, domain.ErrNotFound
+	}
+	return d, nil
+}
+
+func (svc *deviceService) GetDeviceListByUserIDWithPage(ctx context.Context, userID string, page domain.Page) ([]*domain.DeviceInfo, int, error) {
+	return svc.r.GetDeviceListByUserIDWithPage(ctx, userID, page)
+}
+
+func (svc *deviceService) UpdateDevice(ctx context.Context, in *domain.DeviceInfo) (*domain.DeviceInfo, error) {
+	if err := svc.r.UpdateDevice(ctx, in); err != nil {
+		return nil, err
+	}
+	return in, nil
+}
+
+func (svc *deviceService) DeleteDevice(ctx context.Context, userID string, deviceID string) error {
+	return svc.r.DeleteDevice(ctx, userID, deviceID)
+}
+
+func (svc *deviceService) GetDeviceRequestSchemaList(ctx context.Context, userID string, deviceID string) ([]*domain.DeviceRequestSchema, error) {
+	if _, err := svc.GetDevice(ctx, userID, deviceID); err != nil {
+		return nil, err
+	}
+	return svc.r.GetDeviceRequestSchemaList(ctx, deviceID)
+}
+
+// PutDeviceRequestSchemaList
+//
+// 같은 key 를 여러 센서에 연결할 수 없습니다.
+func (svc *deviceService) PutDeviceRequestSchemaList(ctx context.Context, userID string, deviceID string, schemaList []*domain.DeviceRequestSchema) ([]*domain.DeviceRequestSchema, error) {
+	keys := make(map[string]struct{}, len(schemaList))
+	for _, rs := range schemaList {
+		rs.Key = strings.TrimSpace(rs.Key)
+		if _, ok := keys[rs.Key]; ok {
+			return nil, fmt.Errorf("%w: key %q 가 중복되었습니다", domain.ErrDeviceInvalid, rs.Key)
+		}
+		keys[rs.Key] = struct{}{}
+	}
+
+	if _, err := svc.GetDevice(ctx, userID, deviceID); err != nil {
+		return nil, err
+	}
+	if err := svc.r.PutDeviceRequestSchemaList(ctx, deviceID, schemaList); err != nil {
+		if errors.Is(err, domain.ErrDeviceInvalid) {
+			return nil, fmt.Errorf("%w: 존재하지 않거나 수집할 수 없는 센서 입니다", domain.ErrDeviceInvalid)
+		}
+		return nil, err
+	}
+
+	return svc.r.GetDeviceRequestSchemaList(ctx, deviceID)
+}
+
+func NewDeviceService(log *zap.Logger, deviceRepository domain.DeviceRepository) domain.DeviceService {
+	return &deviceService{
+		log: log,
+		r:   deviceRepository,
+	}
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/job"
+	"go.uber.org/zap"
+)
+
+type emailService struct {
+	log    *zap.Logger
+	r      domain.EmailRepository
+	mailer domain.Mailer
+	policy domain.EmailPolicy
+}
+
+// IssueToken
+//
+// 토큰은 {id}.{만료 unix 시각}.{HMAC-SHA256 서명} 형식 입니다.
+// 서명으로 위조, 만료된 토큰을 DB 조회 없이 거절하고, 해시만 저장하여 일회용으로 사용합니다.
+func (svc *emailService) IssueToken(ctx context.Context, purpose domain.EmailTokenPurpose, userID string, email string) (string, error) {
+	ttl := svc.policy.VerifyTokenTTL
+	if purpose == domain.EmailTokenPurposeResetPassword {
+		ttl = svc.policy.ResetTokenTTL
+	}
+	expiresAt := time.Now().Add(ttl)
+
+	payload := rand.Text() + "." + strconv.FormatInt(expiresAt.Unix(), 10)
+	token := payload + "." + svc.sign(purpose, payload)
+
+	if err := svc.r.DeleteUnusedEmailToken(ctx, userID, purpose); err != nil {
+		return "", err
+	}
+	if err := svc.r.CreateEmailToken(ctx, &domain.EmailToken{
+		TokenHash: hashToken(token),
+		Purpose:   purpose,
+		UserID:    userID,
+		Email:     email,
+		ExpiresAt: expiresAt,
+	}); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (svc *emailService) CheckToken(ctx context.Context, purpose domain.EmailTokenPurpose, token string) (*domain.EmailToken, error) {
+	if !svc.verify(purpose, token) {
+		return nil, domain.ErrEmailTokenInvalid
+	}
+
+	return tokenResult(svc.r.GetEmailToken(ctx, hashToken(token), purpose))
+}
+
+func (svc *emailService) ConsumeToken(ctx context.Context, purpose domain.EmailTokenPurpose, token string) (*domain.EmailToken, error) {
+	if !svc.verify(purpose, token) {
+		return nil, domain.ErrEmailTokenInvalid
+	}
+
+	return tokenResult(svc.r.UseEmailToken(ctx, hashToken(token), purpose))
+}
+
+// verify 서명과 만료 시각을 확인합니다.
+func (svc *emailService) verify(purpose domain.EmailTokenPurpose, token string) bool {
+	i := strings.LastIndexByte(token, '.')
+	if i < 0 {
+		return false
+	}
+	payload, signature := token[:i], token[i+1:]
+	if !hmac.Equal([]byte(signature), []byte(svc.sign(purpose, payload))) {
+		return false
+	}
+
+	_, exp, _ := strings.Cut(payload, ".")
+	expiresAt, err := strconv.ParseInt(exp, 10, 64)
+	return err == nil && !time.Now().After(time.Unix(expiresAt, 0))
+}
+
+// tokenResult 저장된 토큰이 없으면 ErrEmailTokenInvalid 로 변환합니다.
+func tokenResult(t *domain.EmailToken, err error) (*domain.EmailToken, error) {
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, domain.ErrEmailTokenInvalid
+	}
+	return t, err
+}
+
+func (svc *emailService) sign(purpose domain.EmailTokenPurpose, payload string) string {
+	mac := hmac.New(sha256.New, svc.policy.TokenSecret)
+	mac.Write([]byte(string(purpose) + "." + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (svc *emailService) SendVerifyEmail(ctx context.Context, email string, token string) error {
+	return svc.mailer.Send(ctx, &domain.Mail{
+		To:      email,
+		Subject: "[GDH] 이메일 인증",
+		Body: fmt.Sprintf("아래 링크에서 이메일을 인증해주세요.\n\n%s\n\n링크는 %s 동안 한번만 사용할 수 있습니다.\n",
+			tokenLink(svc.policy.VerifyURL, token), formatTTL(svc.policy.VerifyTokenTTL)),
+	})
+}
+
+func (svc *emailService) SendResetPassword(ctx context.Context, email string, token string) error {
+	return svc.mailer.Send(ctx, &domain.Mail{
+		To:      email,
+		Subject: "[GDH] 비밀번호 재설정",
+		Body: fmt.Sprintf("아래 링크에서 비밀번호를 재설정해주세요.\n\n%s\n\n링크는 %s 동안 한번만 사용할 수 있습니다.\n요청하지 않았다면 이 메일을 무시해주세요.\n",
+			tokenLink(svc.policy.ResetPasswordURL, token), formatTTL(svc.policy.ResetTokenTTL)),
+	})
+}
+
+func (svc *emailService) SetEmailVerified(ctx context.Context, userID string, email string) error {
+	return svc.r.SetEmailVerified(ctx, userID, email)
+}
+
+func (svc *emailService) IsEmailVerified(ctx context.Context, userID string, email string) (bool, error) {
+	verified, err := svc.r.GetVerifiedEmail(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return strings.EqualFold(verified, email), nil
+}
+
+// cleanup 만료된 토큰을 삭제합니다.
+func (svc *emailService) cleanup(ctx context.Context) error {
+	deleted, err := svc.r.DeleteExpiredEmailToken(ctx)
+	if err != nil {
+		return err
+	}
+	svc.log.Debug("만료된 이메일 토큰 삭제", zap.Int64("deleted", deleted))
+
+	return nil
+}
+
+// tokenLink 링크에 token 쿼리를 추가합니다.
+func tokenLink(link string, token string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return link + "?token=" + url.QueryEscape(token)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
+// formatTTL 메일 본문에 표시할 유효 시간
+func formatTTL(d time.Duration) string {
+	if d >= time.Hour && d%time.Hour == 0 {
+		return fmt.Sprintf("%d시간", d/time.Hour)
+	}
+	return fmt.Sprintf("%d분", int(d.Minutes()))
+}
+
+func NewEmailService(log *zap.Logger, emailRepository domain.EmailRepository, mailer domain.Mailer, policy domain.EmailPolicy, jobs *job.Runner) domain.EmailService {
+	svc := &emailService{
+		log:    log,
+		r:      emailRepository,
+		mailer: mailer,
+		policy: policy,
+	}
+	jobs.Every("email_token_cleanup", time.Hour, svc.cleanup)
+
+	return svc
+}
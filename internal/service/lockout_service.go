@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/job"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+type lockoutService struct {
+	log    *zap.Logger
+	r      domain.LockoutRepository
+	policy domain.SignInPolicy
+}
+
+// CheckSignIn
+//
+// 저장소 오류가 발생한 경우 로그인을 막지 않습니다.
+func (svc *lockoutService) CheckSignIn(ctx context.Context, email string, clientIP string) error {
+	return svc.check(ctx, svc.subjects(email, clientIP))
+}
+
+func (svc *lockoutService) RecordSignInFailure(ctx context.Context, email string, clientIP string) error {
+	return svc.record(ctx, svc.subjects(email, clientIP))
+}
+
+func (svc *lockoutService) RecordSignInSuccess(ctx context.Context, email string) error {
+	err := svc.r.DeleteSignInFailure(ctx, domain.SignInFailureKindEmail, normalizeEmail(email))
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// CheckTwoFactor
+//
+// 2단계 인증 실패는 비밀번호를 알고 있는 경우에만 발생하므로 저장소 오류가 발생하면 시도를 막습니다.
+func (svc *lockoutService) CheckTwoFactor(ctx context.Context, userID string) error {
+	f, err := svc.r.GetSignInFailure(ctx, domain.SignInFailureKindTwoFactor, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if retryAfter := svc.retryAfter(f, time.Now()); retryAfter > 0 {
+		return &domain.SignInLockedError{RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+func (svc *lockoutService) RecordTwoFactorFailure(ctx context.Context, userID string) error {
+	return svc.record(ctx, map[domain.SignInFailureKind]string{domain.SignInFailureKindTwoFactor: userID})
+}
+
+func (svc *lockoutService) RecordTwoFactorSuccess(ctx context.Context, userID string) error {
+	err := svc.r.DeleteSignInFailure(ctx, domain.SignInFailureKindTwoFactor, userID)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (svc *lockoutService) GetSignInLockoutList(ctx context.Context) ([]*domain.SignInFailure, error) {
+	return svc.r.GetSignInLockoutList(ctx)
+}
+
+func (svc *lockoutService) ClearSignInLockout(ctx context.Context, kind domain.SignInFailureKind, subject string) error {
+	if kind == domain.SignInFailureKindEmail {
+		subject = normalizeEmail(subject)
+	}
+
+	if err := svc.r.DeleteSignInFailure(ctx, kind, subject); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx, svc.log).Info("로그인 잠금 해제",
+		zap.String("kind", string(kind)),
+		zap.String("subject", subject),
+	)
+	return nil
+}
+
+// check 대상 중 가장 긴 남은 지연, 잠금 시간으로 *domain.SignInLockedError 를 반환합니다.
+func (svc *lockoutService) check(ctx context.Context, subjects map[domain.SignInFailureKind]string) error {
+	now := time.Now()
+
+	var retryAfter time.Duration
+	for kind, subject := range subjects {
+		f, err := svc.r.GetSignInFailure(ctx, kind, subject)
+		if err != nil {
+			if !errors.Is(err, domain.ErrNotFound) {
+				requestid.Logger(ctx, svc.log).Warn("로그인 실패 기록 조회 실패", zap.Error(err))
+			}
+			continue
+		}
+
+		retryAfter = max(retryAfter, svc.retryAfter(f, now))
+	}
+
+	if retryAfter > 0 {
+		return &domain.SignInLockedError{RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// record 대상별 실패를 기록합니다.
+func (svc *lockoutService) record(ctx context.Context, subjects map[domain.SignInFailureKind]string) error {
+	var errs []error
+	for kind, subject := range subjects {
+		maxFailures := svc.maxFailures(kind)
+
+		f, err := svc.r.RecordSignInFailure(ctx, kind, subject, maxFailures, svc.policy.Window, svc.policy.LockoutDuration)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		// 임계치에 처음 도달한 경우에만 기록
+		if f.LockedUntil != nil && f.Failures == maxFailures {
+			requestid.Logger(ctx, svc.log).Warn("로그인 잠금",
+				zap.String("kind", string(f.Kind)),
+				zap.String("subject", f.Subject),
+				zap.Int("failures", f.Failures),
+				zap.Time("locked_until", *f.LockedUntil),
+			)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (svc *lockoutService) maxFailures(kind domain.SignInFailureKind) int {
+	switch kind {
+	case domain.SignInFailureKindIP:
+		return svc.policy.MaxIPFailures
+	case domain.SignInFailureKindTwoFactor:
+		return svc.policy.MaxTwoFactorFailures
+	}
+	return svc.policy.MaxEmailFailures
+}
+
+// subjects 집계 대상 목록, 값이 없는 대상은 제외합니다.
+func (svc *lockoutService) subjects(email string, clientIP string) map[domain.SignInFailureKind]string {
+	subjects := make(map[domain.SignInFailureKind]string, 2)
+	if email = normalizeEmail(email); email != "" {
+		subjects[domain.SignInFailureKindEmail] = email
+	}
+	if clientIP != "" {
+		subjects[domain.SignInFailureKindIP] = clientIP
+	}
+	return subjects
+}
+
+// retryAfter 잠금 중이면 남은 잠금 시간, 아니면 실패 횟수에 따른 남은 지연 시간을 반환합니다.
+func (svc *lockoutService) retryAfter(f *domain.SignInFailure, now time.Time) time.Duration {
+	if f.LockedUntil != nil {
+		// 잠금이 풀린 기록은 다음 실패부터 다시 센다.
+		return max(f.LockedUntil.Sub(now), 0)
+	}
+	if f.Failures <= 0 || now.Sub(f.FirstFailedAt) > svc.policy.Window {
+		return 0
+	}
+
+	return max(f.LastFailedAt.Add(svc.delay(f.Failures)).Sub(now), 0)
+}
+
+// delay Delay * 2^(failures-1), 최대 MaxDelay
+func (svc *lockoutService) delay(failures int) time.Duration {
+	d := svc.policy.Delay
+	for i := 1; i < failures && d < svc.policy.MaxDelay; i++ {
+		d *= 2
+	}
+	return min(d, svc.policy.MaxDelay)
+}
+
+// cleanup 집계 구간과 잠금이 모두 지난 기록을 삭제합니다.
+func (svc *lockoutService) cleanup(ctx context.Context) error {
+	deleted, err := svc.r.DeleteExpiredSignInFailure(ctx, svc.policy.Window)
+	if err != nil {
+		return err
+	}
+	svc.log.Debug("만료된 로그인 실패 기록 삭제", zap.Int64("deleted", deleted))
+
+	return nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func NewLockoutService(log *zap.Logger, lockoutRepository domain.LockoutRepository, policy domain.SignInPolicy, jobs *job.Runner) domain.LockoutService {
+	svc := &lockoutService{
+		log:    log,
+		r:      lockoutRepository,
+		policy: policy,
+	}
+	jobs.Every("sign_in_failure_cleanup", 10*time.Minute, svc.cleanup)
+
+	return svc
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/job"
+	"go.uber.org/zap"
+)
+
+// fakeLockoutRepository auth.sign_in_failure UPSERT 와 같은 규칙으로 동작하는 메모리 저장소 입니다.
+type fakeLockoutRepository struct {
+	now      func() time.Time
+	failures map[domain.SignInFailureKind]map[string]*domain.SignInFailure
+}
+
+func newFakeLockoutRepository(now func() time.Time) *fakeLockoutRepository {
+	return &fakeLockoutRepository{
+		now:      now,
+		failures: make(map[domain.SignInFailureKind]map[string]*domain.SignInFailure),
+	}
+}
+
+func (r *fakeLockoutRepository) GetSignInFailure(_ context.Context, kind domain.SignInFailureKind, subject string) (*domain.SignInFailure, error) {
+	f, ok := r.failures[kind][subject]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	copied := *f
+	return &copied, nil
+}
+
+func (r *fakeLockoutRepository) RecordSignInFailure(_ context.Context, kind domain.SignInFailureKind, subject string, maxFailures int, window time.Duration, lockout time.Duration) (*domain.SignInFailure, error) {
+	now := r.now()
+	if r.failures[kind] == nil {
+		r.failures[kind] = make(map[string]*domain.SignInFailure)
+	}
+
+	f, ok := r.failures[kind][subject]
+	if !ok {
+		f = &domain.SignInFailure{Kind: kind, Subject: subject, FirstFailedAt: now}
+		r.failures[kind][subject] = f
+	}
+	locked := f.LockedUntil != nil && f.LockedUntil.After(now)
+	if !locked && (f.FirstFailedAt.Before(now.Add(-window)) || f.LockedUntil != nil) {
+		f.Failures = 0
+		f.FirstFailedAt = now
+		f.LockedUntil = nil
+	}
+
+	f.Failures++
+	f.LastFailedAt = now
+	if !locked && f.Failures >= maxFailures {
+		until := now.Add(lockout)
+		f.LockedUntil = &until
+	}
+
+	copied := *f
+	return &copied, nil
+}
+
+func (r *fakeLockoutRepository) DeleteSignInFailure(_ context.Context, kind domain.SignInFailureKind, subject string) error {
+	if _, ok := r.failures[kind][subject]; !ok {
+		return domain.ErrNotFound
+	}
+	delete(r.failures[kind], subject)
+	return nil
+}
+
+func (r *fakeLockoutRepository) DeleteExpiredSignInFailure(context.Context, time.Duration) (int64, error) {
+	return 0, nil
+}
+
+func (r *fakeLockoutRepository) GetSignInLockoutList(context.Context) ([]*domain.SignInFailure, error) {
+	var list []*domain.SignInFailure
+	for _, failures := range r.failures {
+		for _, f := range failures {
+			if f.LockedUntil != nil {
+				list = append(list, f)
+			}
+		}
+	}
+	return list, nil
+}
+
+var testSignInPolicy = domain.SignInPolicy{
+	MaxEmailFailures: 3,
+	MaxIPFailures:    5,
+	Window:           15 * time.Minute,
+	LockoutDuration:  15 * time.Minute,
+	Delay:            time.Second,
+	MaxDelay:         4 * time.Second,
+
+	MaxTwoFactorFailures: 4,
+}
+
+func TestLockoutThreshold(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures []struct{ email, ip string }
+		email    string
+		ip       string
+		locked   bool
+	}{
+		{
+			name:     "이메일 임계치 미만",
+			failures: repeat(2, "a@example.com", "1.1.1.1"),
+			email:    "a@example.com", ip: "9.9.9.9",
+			locked: false,
+		},
+		{
+			name:     "이메일 임계치 도달",
+			failures: repeat(3, "a@example.com", "1.1.1.1"),
+			email:    "a@example.com", ip: "9.9.9.9",
+			locked: true,
+		},
+		{
+			name:     "이메일 대소문자, 공백 무시",
+			failures: append(repeat(2, "A@Example.com ", "1.1.1.1"), repeat(1, "a@example.com", "2.2.2.2")...),
+			email:    "a@example.com", ip: "9.9.9.9",
+			locked: true,
+		},
+		{
+			name: "IP 임계치 도달",
+			failures: append(append(repeat(2, "a@example.com", "1.1.1.1"), repeat(2, "b@example.com", "1.1.1.1")...),
+				repeat(1, "c@example.com", "1.1.1.1")...),
+			email: "d@example.com", ip: "1.1.1.1",
+			locked: true,
+		},
+		{
+			name:     "다른 이메일, 다른 IP",
+			failures: repeat(3, "a@example.com", "1.1.1.1"),
+			email:    "b@example.com", ip: "2.2.2.2",
+			locked: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+			svc := NewLockoutService(zap.NewNop(), newFakeLockoutRepository(func() time.Time { return now }), testSignInPolicy, job.NewRunner(zap.NewNop())).(*lockoutService)
+
+			for _, f := range tt.failures {
+				if err := svc.RecordSignInFailure(context.Background(), f.email, f.ip); err != nil {
+					t.Fatalf("RecordSignInFailure() error = %v", err)
+				}
+			}
+
+			var retryAfter time.Duration
+			for kind, subject := range svc.subjects(tt.email, tt.ip) {
+				f, err := svc.r.GetSignInFailure(context.Background(), kind, subject)
+				if err != nil {
+					continue
+				}
+				if f.LockedUntil != nil {
+					retryAfter = max(retryAfter, svc.retryAfter(f, now))
+				}
+			}
+			if locked := retryAfter > 0; locked != tt.locked {
+				t.Errorf("locked = %v, want %v", locked, tt.locked)
+			}
+			if tt.locked && retryAfter != testSignInPolicy.LockoutDuration {
+				t.Errorf("retryAfter = %s, want %s", retryAfter, testSignInPolicy.LockoutDuration)
+			}
+		})
+	}
+}
+
+func repeat(n int, email string, ip string) []struct{ email, ip string } {
+	failures := make([]struct{ email, ip string }, n)
+	for i := range failures {
+		failures[i] = struct{ email, ip string }{email, ip}
+	}
+	return failures
+}
+
+func TestLockoutRetryAfter(t *testing.T) {
+	svc := &lockoutService{policy: testSignInPolicy}
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	lockedUntil := now.Add(5 * time.Minute)
+	expired := now.Add(-time.Second)
+
+	tests := []struct {
+		name string
+		f    *domain.SignInFailure
+		want time.Duration
+	}{
+		{name: "잠금 중", f: &domain.SignInFailure{Failures: 3, LockedUntil: &lockedUntil}, want: 5 * time.Minute},
+		{name: "잠금 해제", f: &domain.SignInFailure{Failures: 3, LockedUntil: &expired}, want: 0},
+		{name: "1회 실패 직후", f: &domain.SignInFailure{Failures: 1, FirstFailedAt: now, LastFailedAt: now}, want: time.Second},
+		{name: "2회 실패 직후", f: &domain.SignInFailure{Failures: 2, FirstFailedAt: now, LastFailedAt: now}, want: 2 * time.Second},
+		{name: "최대 지연", f: &domain.SignInFailure{Failures: 10, FirstFailedAt: now, LastFailedAt: now}, want: 4 * time.Second},
+		{name: "지연 경과", f: &domain.SignInFailure{Failures: 2, FirstFailedAt: now.Add(-time.Minute), LastFailedAt: now.Add(-time.Minute)}, want: 0},
+		{name: "집계 구간 경과", f: &domain.SignInFailure{Failures: 2, FirstFailedAt: now.Add(-time.Hour), LastFailedAt: now}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := svc.retryAfter(tt.f, now); got != tt.want {
+				t.Errorf("retryAfter() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLockoutCheckSignIn(t *testing.T) {
+	repo := newFakeLockoutRepository(time.Now)
+	svc := NewLockoutService(zap.NewNop(), repo, testSignInPolicy, job.NewRunner(zap.NewNop()))
+	ctx := context.Background()
+
+	for range testSignInPolicy.MaxEmailFailures {
+		if err := svc.RecordSignInFailure(ctx, "a@example.com", "1.1.1.1"); err != nil {
+			t.Fatalf("RecordSignInFailure() error = %v", err)
+		}
+	}
+
+	err := svc.CheckSignIn(ctx, "a@example.com", "2.2.2.2")
+	var locked *domain.SignInLockedError
+	if !errors.As(err, &locked) {
+		t.Fatalf("CheckSignIn() error = %v, want *SignInLockedError", err)
+	}
+	if !errors.Is(err, domain.ErrSignInLocked) {
+		t.Errorf("CheckSignIn() error = %v, want ErrSignInLocked", err)
+	}
+
+	if err := svc.ClearSignInLockout(ctx, domain.SignInFailureKindEmail, "A@example.com"); err != nil {
+		t.Fatalf("ClearSignInLockout() error = %v", err)
+	}
+	// IP 기록은 남아있지만 임계치 미만이고 다른 IP 로 시도
+	if err := svc.CheckSignIn(ctx, "a@example.com", "2.2.2.2"); err != nil {
+		t.Errorf("CheckSignIn() after clear error = %v", err)
+	}
+}
+
+func TestLockoutTwoFactor(t *testing.T) {
+	now := time.Now()
+	repo := newFakeLockoutRepository(func() time.Time { return now })
+	svc := NewLockoutService(zap.NewNop(), repo, testSignInPolicy, job.NewRunner(zap.NewNop())).(*lockoutService)
+	ctx := context.Background()
+
+	for range testSignInPolicy.MaxTwoFactorFailures - 1 {
+		if err := svc.RecordTwoFactorFailure(ctx, "user"); err != nil {
+			t.Fatalf("RecordTwoFactorFailure() error = %v", err)
+		}
+	}
+	f, err := repo.GetSignInFailure(ctx, domain.SignInFailureKindTwoFactor, "user")
+	if err != nil || f.LockedUntil != nil {
+		t.Fatalf("임계치 전 기록 = %+v, %v", f, err)
+	}
+
+	// 비밀번호 로그인 성공은 2단계 인증 실패 기록을 초기화하지 않는다.
+	if err := svc.RecordSignInSuccess(ctx, "user"); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.RecordTwoFactorFailure(ctx, "user"); err != nil {
+		t.Fatal(err)
+	}
+	f, err = repo.GetSignInFailure(ctx, domain.SignInFailureKindTwoFactor, "user")
+	if err != nil || f.LockedUntil == nil || svc.retryAfter(f, now) != testSignInPolicy.LockoutDuration {
+		t.Fatalf("임계치 도달 기록 = %+v, %v", f, err)
+	}
+
+	var locked *domain.SignInLockedError
+	if err := svc.CheckTwoFactor(ctx, "user"); !errors.As(err, &locked) {
+		t.Errorf("CheckTwoFactor() error = %v, want *SignInLockedError", err)
+	}
+	if err := svc.CheckTwoFactor(ctx, "other"); err != nil {
+		t.Errorf("다른 사용자 CheckTwoFactor() error = %v", err)
+	}
+	if err := svc.CheckSignIn(ctx, "user", "1.1.1.1"); err != nil {
+		t.Errorf("CheckSignIn() error = %v, 2단계 인증 실패는 비밀번호 로그인 잠금에 포함하지 않는다", err)
+	}
+
+	if err := svc.ClearSignInLockout(ctx, domain.SignInFailureKindTwoFactor, "user"); err != nil {
+		t.Fatalf("ClearSignInLockout() error = %v", err)
+	}
+	if err := svc.CheckTwoFactor(ctx, "user"); err != nil {
+		t.Errorf("잠금 해제 후 CheckTwoFactor() error = %v", err)
+	}
+	if err := svc.RecordTwoFactorSuccess(ctx, "user"); err != nil {
+		t.Errorf("기록이 없을 때 RecordTwoFactorSuccess() error = %v", err)
+	}
+}
@@ -2,14 +2,20 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/GDH-Project/api/internal/agro"
 	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
 	"go.uber.org/zap"
 )
 
 type metaService struct {
-	log *zap.Logger
-	r   domain.MetaRepository
+	log    *zap.Logger
+	r      domain.MetaRepository
+	rollup domain.RollupRepository
 }
 
 func (svc *metaService) GetSensorList(ctx context.Context) ([]*domain.Sensor, error) {
@@ -21,6 +27,45 @@ func (svc *metaService) GetSensorByParam(ctx context.Context, in *domain.Sensor)
 	return svc.r.GetSensorByParam(ctx, in)
 }
 
+// DeriveSensorValues
+//
+// 파생 센서는 입력 센서 값이 모두 있고 범위 안인 경우에만 계산합니다.
+func (svc *metaService) DeriveSensorValues(ctx context.Context, values map[string]float64) (map[string]float64, error) {
+	sensorList, err := svc.r.GetSensorList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	derived := make(map[string]float64)
+	for _, s := range sensorList {
+		if !s.Derived() {
+			continue
+		}
+		formula, err := agro.LookupFormula(*s.Formula)
+		if err != nil {
+			requestid.Logger(ctx, svc.log).Warn("파생 센서 계산식 오류", zap.Error(err), zap.String("sensor", s.Title))
+			continue
+		}
+
+		in := make([]float64, 0, len(s.Inputs))
+		for _, title := range s.Inputs {
+			v, ok := values[title]
+			if !ok {
+				break
+			}
+			in = append(in, v)
+		}
+		if len(in) != len(s.Inputs) {
+			continue
+		}
+		if v, ok := formula.Calc(in...); ok {
+			derived[s.Title] = v
+		}
+	}
+
+	return derived, nil
+}
+
 func (svc *metaService) GetCropList(ctx context.Context) ([]*domain.Crop, error) {
 	return svc.r.GetCropList(ctx)
 }
@@ -29,6 +74,89 @@ func (svc *metaService) GetCropByParam(ctx context.Context, in *domain.Crop) (*d
 	return svc.r.GetCropByParam(ctx, in)
 }
 
+// GetGrowingDegreeDays
+//
+// 기준 온도, 상한 온도는 입력한 값을 우선 사용하고 없으면 작물의 값을 사용합니다.
+func (svc *metaService) GetGrowingDegreeDays(ctx context.Context, in *domain.GrowingDegreeDaysParam) (*domain.GrowingDegreeDays, error) {
+	crop, err := svc.r.GetCropByParam(ctx, &domain.Crop{Title: in.Crop})
+	if err != nil {
+		return nil, err
+	}
+
+	base, upper := crop.GDDBase, crop.GDDUpper
+	if in.Base != nil {
+		base = in.Base
+	}
+	if in.Upper != nil {
+		upper = in.Upper
+	}
+	if base == nil || upper == nil {
+		return nil, fmt.Errorf("%w: %s 작물에 기준 온도가 없어 base, upper 가 필요합니다", domain.ErrDegreeDayThreshold, crop.Title)
+	}
+	if *upper <= *base {
+		return nil, fmt.Errorf("%w: 상한 온도(%g)는 기준 온도(%g)보다 높아야 합니다", domain.ErrDegreeDayThreshold, *upper, *base)
+	}
+
+	now := time.Now()
+	readings, err := svc.temperatureReadings(ctx, in.DeviceID, in.PlantedOn, now)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &domain.GrowingDegreeDays{
+		Crop:  crop.Title,
+		Base:  *base,
+		Upper: *upper,
+		Days:  make([]*domain.DegreeDay, 0),
+	}
+	for _, d := range agro.GrowingDegreeDays(readings, in.PlantedOn, now, *base, *upper) {
+		dd := &domain.DegreeDay{
+			Date:       d.Date.Format(time.DateOnly),
+			Value:      d.Value,
+			Cumulative: d.Cumulative,
+		}
+		if d.Count > 0 {
+			dd.Min, dd.Max = &d.Min, &d.Max
+		}
+		out.Days = append(out.Days, dd)
+		out.Total = d.Cumulative
+	}
+
+	return out, nil
+}
+
+// temperatureReadings
+//
+// 일 단위 집계(device.reading_daily)의 한국 표준시 하루 단위 최저, 최고 기온을 가져옵니다. 기온 센서가 없으면 빈 결과 입니다.
+// 원본 데이터는 보관 기간이 지나면 삭제되므로 일 단위 집계를 사용하며, 집계 주기만큼 최근 데이터의 반영이 늦을 수 있습니다.
+func (svc *metaService) temperatureReadings(ctx context.Context, deviceID string, from, to time.Time) ([]agro.Reading, error) {
+	sensor, err := svc.r.GetSensorByParam(ctx, &domain.Sensor{Title: domain.SensorTitleTemperature})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, svc.log).Warn("기온 센서가 없어 생육 도일을 계산할 수 없습니다")
+			return []agro.Reading{}, nil
+		}
+		return nil, err
+	}
+
+	pointList, err := svc.rollup.GetReadingPointList(ctx, domain.RollupTierDaily, &domain.ReadingQuery{
+		DeviceID:   deviceID,
+		SensorID:   sensor.ID,
+		From:       from,
+		To:         to,
+		Resolution: 24 * time.Hour,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	readings := make([]agro.Reading, 0, len(pointList)*2)
+	for _, p := range pointList {
+		readings = append(readings, agro.Reading{Time: p.Time, Value: p.Min}, agro.Reading{Time: p.Time, Value: p.Max})
+	}
+	return readings, nil
+}
+
 func (svc *metaService) GetUpdateCycleList(ctx context.Context) ([]*domain.UpdateCycle, error) {
 	return svc.r.GetUpdateCycleList(ctx)
 }
@@ -41,9 +169,10 @@ func (svc *metaService) GetAddressCityListByState(ctx context.Context, state str
 	return svc.r.GetAddressCityListByState(ctx, state)
 }
 
-func NewMetaService(log *zap.Logger, metaRepository domain.MetaRepository) domain.MetaService {
+func NewMetaService(log *zap.Logger, metaRepository domain.MetaRepository, rollupRepository domain.RollupRepository) domain.MetaService {
 	return &metaService{
-		log: log,
-		r:   metaRepository,
+		log:    log,
+		r:      metaRepository,
+		rollup: rollupRepository,
 	}
 }
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GDH-Project/api/internal/agro"
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+type fakeMetaRepository struct {
+	domain.MetaRepository
+	sensors map[string]*domain.Sensor
+	crop    *domain.Crop
+}
+
+func (r *fakeMetaRepository) GetSensorByParam(_ context.Context, in *domain.Sensor) (*domain.Sensor, error) {
+	for _, s := range r.sensors {
+		if in.ID != 0 && s.ID == in.ID {
+			return s, nil
+		}
+	}
+	s, ok := r.sensors[in.Title]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return s, nil
+}
+
+func (r *fakeMetaRepository) GetCropByParam(context.Context, *domain.Crop) (*domain.Crop, error) {
+	return r.crop, nil
+}
+
+// fakeReadingRepository 원본 데이터 조회 조건을 기록하고 points 를 응답합니다.
+type fakeReadingRepository struct {
+	domain.RollupRepository
+	points []*domain.ReadingPoint
+	tier   domain.RollupTier
+	query  *domain.ReadingQuery
+}
+
+func (r *fakeReadingRepository) GetReadingPointList(_ context.Context, tier domain.RollupTier, q *domain.ReadingQuery) ([]*domain.ReadingPoint, error) {
+	r.tier, r.query = tier, q
+	return r.points, nil
+}
+
+func TestGetGrowingDegreeDays(t *testing.T) {
+	ctx := context.Background()
+	base, upper := 10.0, 30.0
+	now := time.Now().In(agro.Seoul)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, agro.Seoul)
+	plantedOn := today.AddDate(0, 0, -2)
+
+	metaRepo := &fakeMetaRepository{
+		sensors: map[string]*domain.Sensor{domain.SensorTitleTemperature: {ID: 7, Title: domain.SensorTitleTemperature}},
+		crop:    &domain.Crop{Title: "토마토", GDDBase: &base, GDDUpper: &upper},
+	}
+	readingRepo := &fakeReadingRepository{points: []*domain.ReadingPoint{
+		{Time: plantedOn, Min: 12, Max: 24},
+		{Time: today, Min: 5, Max: 40},
+	}}
+	svc := NewMetaService(zap.NewNop(), metaRepo, readingRepo)
+
+	gdd, err := svc.GetGrowingDegreeDays(ctx, &domain.GrowingDegreeDaysParam{Crop: "토마토", DeviceID: "device", PlantedOn: plantedOn})
+	if err != nil {
+		t.Fatalf("GetGrowingDegreeDays() error = %v", err)
+	}
+
+	// 원본 데이터가 삭제되어도 계산할 수 있도록 장치의 기온 센서 일 단위 집계를 조회한다.
+	if readingRepo.tier != domain.RollupTierDaily || readingRepo.query.DeviceID != "device" || readingRepo.query.SensorID != 7 ||
+		readingRepo.query.Resolution != 24*time.Hour || !readingRepo.query.From.Equal(plantedOn) {
+		t.Errorf("GetReadingPointList(%s, %+v)", readingRepo.tier, readingRepo.query)
+	}
+
+	want := []float64{8, 0, 10}
+	if len(gdd.Days) != len(want) {
+		t.Fatalf("Days = %d, want %d", len(gdd.Days), len(want))
+	}
+	for i, d := range gdd.Days {
+		if d.Value != want[i] {
+			t.Errorf("Days[%d] = %v, want %v", i, d.Value, want[i])
+		}
+	}
+	if gdd.Days[1].Min != nil {
+		t.Errorf("기온이 없는 날 Min = %v, want nil", *gdd.Days[1].Min)
+	}
+	if gdd.Total != 18 {
+		t.Errorf("Total = %v, want 18", gdd.Total)
+	}
+
+	// 기온 센서가 없으면 모든 날이 0 이다.
+	metaRepo.sensors = map[string]*domain.Sensor{}
+	gdd, err = svc.GetGrowingDegreeDays(ctx, &domain.GrowingDegreeDaysParam{Crop: "토마토", DeviceID: "device", PlantedOn: plantedOn})
+	if err != nil || gdd.Total != 0 || len(gdd.Days) != 3 {
+		t.Errorf("기온 센서 없음 GetGrowingDegreeDays() = %+v, %v", gdd, err)
+	}
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/job"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+type oauthService struct {
+	log       *zap.Logger
+	r         domain.OAuthRepository
+	providers map[string]domain.OAuthProvider
+	policy    domain.OAuthPolicy
+	aead      cipher.AEAD
+}
+
+func (svc *oauthService) ProviderList() []string {
+	list := make([]string, 0, len(svc.providers))
+	for name := range svc.providers {
+		list = append(list, name)
+	}
+	slices.Sort(list)
+
+	return list
+}
+
+func (svc *oauthService) AuthCodeURL(ctx context.Context, provider string, redirectURL string) (string, string, error) {
+	p, ok := svc.providers[provider]
+	if !ok {
+		return "", "", domain.ErrOAuthProviderNotFound
+	}
+	if !slices.Contains(svc.policy.RedirectURLs, redirectURL) {
+		return "", "", fmt.Errorf("%w: 허용되지 않은 redirect_uri", domain.ErrOAuthInvalidRequest)
+	}
+
+	state := &domain.OAuthState{
+		State:        rand.Text(),
+		Provider:     provider,
+		CodeVerifier: oauth2.GenerateVerifier(),
+		Nonce:        rand.Text(),
+		RedirectURL:  redirectURL,
+		ExpiresAt:    time.Now().Add(svc.policy.StateTTL),
+	}
+
+	url, err := p.AuthCodeURL(ctx, state)
+	if err != nil {
+		return "", "", err
+	}
+	if err := svc.r.CreateOAuthState(ctx, state); err != nil {
+		return "", "", err
+	}
+
+	return url, state.State, nil
+}
+
+// Exchange
+//
+// state 는 성공 여부와 관계없이 한번만 사용할 수 있습니다.
+func (svc *oauthService) Exchange(ctx context.Context, provider string, code string, state string) (*domain.OAuthUserInfo, error) {
+	p, ok := svc.providers[provider]
+	if !ok {
+		return nil, domain.ErrOAuthProviderNotFound
+	}
+
+	s, err := svc.r.TakeOAuthState(ctx, state)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("%w: state 가 없거나 만료됨", domain.ErrOAuthInvalidRequest)
+		}
+		return nil, err
+	}
+	if s.Provider != provider {
+		return nil, fmt.Errorf("%w: 제공자 불일치", domain.ErrOAuthInvalidRequest)
+	}
+
+	info, err := p.Exchange(ctx, s, code)
+	if err != nil {
+		requestid.Logger(ctx, svc.log).Info("소셜 로그인 인가 코드 교환 실패", zap.String("provider", provider), zap.Error(err))
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func (svc *oauthService) GetOAuthIdentity(ctx context.Context, provider string, subject string) (*domain.OAuthIdentity, error) {
+	return svc.r.GetOAuthIdentity(ctx, provider, subject)
+}
+
+func (svc *oauthService) LinkOAuthIdentity(ctx context.Context, identity *domain.OAuthIdentity) error {
+	return svc.r.CreateOAuthIdentity(ctx, identity)
+}
+
+func (svc *oauthService) TouchOAuthIdentity(ctx context.Context, provider string, subject string) error {
+	return svc.r.UpdateOAuthIdentitySignedIn(ctx, provider, subject)
+}
+
+func (svc *oauthService) NewCredential() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func (svc *oauthService) GetCredential(ctx context.Context, userID string) (string, error) {
+	encrypted, err := svc.r.GetOAuthCredential(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	nonceSize := svc.aead.NonceSize()
+	if len(encrypted) < nonceSize {
+		return "", errors.New("소셜 계정 비밀번호 형식 오류")
+	}
+	// user_id 를 추가 인증 데이터로 사용하여 다른 사용자의 값으로 바꿔치기 할 수 없도록 한다.
+	password, err := svc.aead.Open(nil, encrypted[:nonceSize], encrypted[nonceSize:], []byte(userID))
+	if err != nil {
+		requestid.Logger(ctx, svc.log).Error("소셜 계정 비밀번호 복호화 실패", zap.String("user_id", userID), zap.Error(err))
+		return "", err
+	}
+
+	return string(password), nil
+}
+
+func (svc *oauthService) SaveCredential(ctx context.Context, userID string, password string) error {
+	encrypted, err := svc.encrypt(userID, password)
+	if err != nil {
+		return err
+	}
+
+	return svc.r.CreateOAuthCredential(ctx, userID, encrypted)
+}
+
+// UpdateCredential
+//
+// 비밀번호 재설정 이후에도 소셜 로그인 할 수 있도록 저장된 비밀번호를 갱신합니다.
+func (svc *oauthService) UpdateCredential(ctx context.Context, userID string, password string) error {
+	encrypted, err := svc.encrypt(userID, password)
+	if err != nil {
+		return err
+	}
+
+	err = svc.r.UpdateOAuthCredential(ctx, userID, encrypted)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// encrypt user_id 를 추가 인증 데이터로 사용하여 비밀번호를 암호화합니다.
+func (svc *oauthService) encrypt(userID string, password string) ([]byte, error) {
+	nonce := make([]byte, svc.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return svc.aead.Seal(nonce, nonce, []byte(password), []byte(userID)), nil
+}
+
+// cleanup 만료된 state 를 삭제합니다.
+func (svc *oauthService) cleanup(ctx context.Context) error {
+	deleted, err := svc.r.DeleteExpiredOAuthState(ctx)
+	if err != nil {
+		return err
+	}
+	svc.log.Debug("만료된 소셜 로그인 state 삭제", zap.Int64("deleted", deleted))
+
+	return nil
+}
+
+func NewOAuthService(log *zap.Logger, oauthRepository domain.OAuthRepository, providers map[string]domain.OAuthProvider, policy domain.OAuthPolicy, jobs *job.Runner) (domain.OAuthService, error) {
+	block, err := aes.NewCipher(policy.CredentialKey)
+	if err != nil {
+		return nil, fmt.Errorf("소셜 계정 비밀번호 암호화 키 오류: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &oauthService{
+		log:       log,
+		r:         oauthRepository,
+		providers: providers,
+		policy:    policy,
+		aead:      aead,
+	}
+	jobs.Every("oauth_state_cleanup", 10*time.Minute, svc.cleanup)
+
+	return svc, nil
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/job"
+	"go.uber.org/zap"
+)
+
+// retentionTierPattern 장치 등급 이름 형식 입니다.
+var retentionTierPattern = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+
+// retentionDataTiers 정리 순서, 원본 데이터부터 삭제합니다.
+var retentionDataTiers = []domain.RollupTier{domain.RollupTierRaw, domain.RollupTierHourly, domain.RollupTierDaily}
+
+type retentionService struct {
+	log      *zap.Logger
+	r        domain.RetentionRepository
+	schedule domain.RetentionSchedule
+}
+
+func (svc *retentionService) GetRetentionPolicyList(ctx context.Context) ([]*domain.RetentionPolicy, error) {
+	return svc.r.GetRetentionPolicyList(ctx)
+}
+
+func (svc *retentionService) PutRetentionPolicy(ctx context.Context, policy *domain.RetentionPolicy) error {
+	if !retentionTierPattern.MatchString(policy.Tier) {
+		return fmt.Errorf("%w: 등급은 영문 소문자, 숫자, -_ 32자 이하여야 합니다", domain.ErrRetentionPolicyInvalid)
+	}
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	return svc.r.UpsertRetentionPolicy(ctx, policy)
+}
+
+func (svc *retentionService) DeleteRetentionPolicy(ctx context.Context, tier string) error {
+	if tier == domain.RetentionTierDefault {
+		return fmt.Errorf("%w: default 정책은 삭제할 수 없습니다", domain.ErrRetentionPolicyInvalid)
+	}
+	return svc.r.DeleteRetentionPolicy(ctx, tier)
+}
+
+func (svc *retentionService) SetDeviceRetentionTier(ctx context.Context, deviceID string, tier string) error {
+	return svc.r.SetDeviceRetentionTier(ctx, deviceID, tier)
+}
+
+// PreviewRetentionPolicy
+//
+// 저장하지 않은 정책도 미리 볼 수 있으며 실제 정리와 같은 기준 시각을 사용합니다.
+func (svc *retentionService) PreviewRetentionPolicy(ctx context.Context, policy *domain.RetentionPolicy) ([]*domain.RetentionItem, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	items := make([]*domain.RetentionItem, 0, len(retentionDataTiers))
+	for _, data := range retentionDataTiers {
+		days := policy.Days(data)
+		if days == nil {
+			continue
+		}
+		cutoff := retentionCutoff(now, *days)
+
+		count, err := svc.r.CountExpiredReadings(ctx, policy.Tier, data, cutoff)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, &domain.RetentionItem{Tier: policy.Tier, Data: data, Cutoff: cutoff, Rows: count})
+	}
+
+	return items, nil
+}
+
+func (svc *retentionService) GetRetentionRunList(ctx context.Context) ([]*domain.RetentionRun, error) {
+	return svc.r.GetRetentionRunList(ctx, 50)
+}
+
+// prune 모든 정책의 보관 기간이 지난 데이터를 나누어 삭제하고 결과를 기록합니다.
+func (svc *retentionService) prune(ctx context.Context) *domain.RetentionRun {
+	run := &domain.RetentionRun{
+		StartedAt: time.Now(),
+		Items:     make([]*domain.RetentionItem, 0),
+	}
+
+	err := func() error {
+		policyList, err := svc.r.GetRetentionPolicyList(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, policy := range policyList {
+			for _, data := range retentionDataTiers {
+				days := policy.Days(data)
+				if days == nil {
+					continue
+				}
+
+				item := &domain.RetentionItem{Tier: policy.Tier, Data: data, Cutoff: retentionCutoff(run.StartedAt, *days)}
+				run.Items = append(run.Items, item)
+				for {
+					deleted, err := svc.r.DeleteExpiredReadings(ctx, policy.Tier, data, item.Cutoff, svc.schedule.BatchSize)
+					if err != nil {
+						return fmt.Errorf("%s %s 삭제 실패: %w", policy.Tier, data, err)
+					}
+					item.Rows += deleted
+					if deleted < int64(svc.schedule.BatchSize) {
+						break
+					}
+
+					// 서버가 종료되면 기다리지 않고 중단한다.
+					timer := time.NewTimer(svc.schedule.BatchPause)
+					select {
+					case <-ctx.Done():
+						timer.Stop()
+						return ctx.Err()
+					case <-timer.C:
+					}
+				}
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		run.Error = err.Error()
+	}
+	run.FinishedAt = time.Now()
+
+	return run
+}
+
+// run 보관 기간 정리 후 결과를 기록합니다. 다른 인스턴스가 정리 중이면 실행하지 않습니다.
+func (svc *retentionService) run(ctx context.Context) error {
+	unlock, ok, err := svc.r.TryRetentionLock(ctx)
+	if err != nil {
+		return fmt.Errorf("보관 기간 정리 잠금 실패: %w", err)
+	}
+	if !ok {
+		svc.log.Debug("다른 인스턴스가 보관 기간 정리 중")
+		return nil
+	}
+	defer unlock()
+
+	run := svc.prune(ctx)
+
+	var total int64
+	for _, item := range run.Items {
+		total += item.Rows
+	}
+	if run.Error != "" {
+		svc.log.Warn("보관 기간 정리 중단", zap.String("error", run.Error), zap.Int64("deleted", total))
+	} else {
+		svc.log.Info("보관 기간 정리", zap.Int64("deleted", total), zap.Duration("elapsed", run.FinishedAt.Sub(run.StartedAt)))
+	}
+
+	// 종료 중에 중단되어도 삭제한 건수는 기록한다.
+	if err := svc.r.CreateRetentionRun(context.WithoutCancel(ctx), run); err != nil {
+		return fmt.Errorf("보관 기간 정리 결과 기록 실패: %w", err)
+	}
+
+	return nil
+}
+
+// retentionCutoff 보관 일수 이전 시각, 일 단위 집계와 맞도록 한국 표준시 자정으로 맞춥니다.
+func retentionCutoff(now time.Time, days int) time.Time {
+	t := now.In(rollupOrigin.Location()).AddDate(0, 0, -days)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func NewRetentionService(log *zap.Logger, retentionRepository domain.RetentionRepository, schedule domain.RetentionSchedule, jobs *job.Runner) domain.RetentionService {
+	svc := &retentionService{
+		log:      log,
+		r:        retentionRepository,
+		schedule: schedule,
+	}
+	jobs.Every("retention_prune", schedule.Interval, svc.run)
+
+	return svc
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/job"
+	"go.uber.org/zap"
+)
+
+func TestRetentionCutoff(t *testing.T) {
+	kst := rollupOrigin.Location()
+
+	tests := []struct {
+		name string
+		now  time.Time
+		days int
+		want time.Time
+	}{
+		{
+			name: "한국 표준시 자정으로 내림",
+			now:  time.Date(2026, 3, 10, 15, 30, 0, 0, kst),
+			days: 7,
+			want: time.Date(2026, 3, 3, 0, 0, 0, 0, kst),
+		},
+		{
+			name: "UTC 기준 전날이어도 한국 표준시 날짜 사용",
+			now:  time.Date(2026, 3, 9, 16, 0, 0, 0, time.UTC), // 3/10 01:00 KST
+			days: 1,
+			want: time.Date(2026, 3, 9, 0, 0, 0, 0, kst),
+		},
+		{
+			name: "월, 윤년 경계",
+			now:  time.Date(2028, 3, 1, 9, 0, 0, 0, kst),
+			days: 1,
+			want: time.Date(2028, 2, 29, 0, 0, 0, 0, kst),
+		},
+		{
+			name: "자정 정각",
+			now:  time.Date(2026, 3, 10, 0, 0, 0, 0, kst),
+			days: 30,
+			want: time.Date(2026, 2, 8, 0, 0, 0, 0, kst),
+		},
+		{
+			name: "0 일",
+			now:  time.Date(2026, 3, 10, 23, 59, 59, 0, kst),
+			days: 0,
+			want: time.Date(2026, 3, 10, 0, 0, 0, 0, kst),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retentionCutoff(tt.now, tt.days); !got.Equal(tt.want) {
+				t.Errorf("retentionCutoff() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeRetentionRepository 삭제할 때마다 limit 건을 삭제하고 잠금은 locked 가 false 일 때만 획득합니다.
+type fakeRetentionRepository struct {
+	domain.RetentionRepository
+	locked   bool
+	unlocked int
+	deletes  int
+	onDelete func()
+	runs     []*domain.RetentionRun
+}
+
+func (r *fakeRetentionRepository) TryRetentionLock(context.Context) (func(), bool, error) {
+	if r.locked {
+		return nil, false, nil
+	}
+	r.locked = true
+	return func() { r.locked = false; r.unlocked++ }, true, nil
+}
+
+func (r *fakeRetentionRepository) GetRetentionPolicyList(context.Context) ([]*domain.RetentionPolicy, error) {
+	days := 1
+	return []*domain.RetentionPolicy{{Tier: domain.RetentionTierDefault, RawDays: &days}}, nil
+}
+
+func (r *fakeRetentionRepository) DeleteExpiredReadings(_ context.Context, _ string, _ domain.RollupTier, _ time.Time, limit int) (int64, error) {
+	r.deletes++
+	if r.onDelete != nil {
+		r.onDelete()
+	}
+	return int64(limit), nil
+}
+
+func (r *fakeRetentionRepository) CreateRetentionRun(ctx context.Context, run *domain.RetentionRun) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	r.runs = append(r.runs, run)
+	return nil
+}
+
+func TestRetentionRunLocked(t *testing.T) {
+	repo := &fakeRetentionRepository{locked: true}
+	svc := NewRetentionService(zap.NewNop(), repo, domain.RetentionSchedule{BatchSize: 10}, job.NewRunner(zap.NewNop())).(*retentionService)
+
+	// 다른 인스턴스가 정리 중이면 삭제, 기록하지 않는다.
+	if err := svc.run(context.Background()); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+	if repo.deletes != 0 || len(repo.runs) != 0 {
+		t.Errorf("삭제 %d 번, 기록 %d 건, want 0, 0", repo.deletes, len(repo.runs))
+	}
+}
+
+func TestRetentionRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// 첫 삭제 후 대기 중에 종료된다.
+	repo := &fakeRetentionRepository{onDelete: cancel}
+	svc := NewRetentionService(zap.NewNop(), repo, domain.RetentionSchedule{BatchSize: 10, BatchPause: time.Hour}, job.NewRunner(zap.NewNop())).(*retentionService)
+
+	done := make(chan error, 1)
+	go func() { done <- svc.run(ctx) }()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("run() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("종료 후에도 삭제 사이 대기가 끝나지 않습니다")
+	}
+
+	if repo.deletes != 1 {
+		t.Errorf("삭제 = %d 번, want 1", repo.deletes)
+	}
+	if repo.unlocked != 1 {
+		t.Errorf("잠금 해제 = %d 번, want 1", repo.unlocked)
+	}
+	// 중단되어도 삭제한 건수와 사유를 기록한다.
+	if len(repo.runs) != 1 || repo.runs[0].Items[0].Rows != 10 || repo.runs[0].Error != context.Canceled.Error() {
+		t.Errorf("기록 = %+v", repo.runs)
+	}
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GDH-Project/api/internal/agro"
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/job"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+// rollupOrigin 조회 구간을 나누는 기준 시각, 한국 표준시 자정 입니다.
+var rollupOrigin = time.Date(2000, 1, 3, 0, 0, 0, 0, time.FixedZone("Asia/Seoul", 9*60*60))
+
+type rollupService struct {
+	log    *zap.Logger
+	r      domain.RollupRepository
+	meta   domain.MetaRepository
+	policy domain.RollupPolicy
+}
+
+func (svc *rollupService) BackfillRollup(ctx context.Context, deviceID string, from, to time.Time) (int64, error) {
+	if !from.Before(to) {
+		return 0, fmt.Errorf("%w: 시작 시각은 종료 시각보다 빨라야 합니다", domain.ErrRollupRange)
+	}
+	return svc.r.QueueRollup(ctx, deviceID, from, to)
+}
+
+func (svc *rollupService) GetRollupStatus(ctx context.Context) (*domain.RollupStatus, error) {
+	return svc.r.GetRollupStatus(ctx)
+}
+
+// GetReadingSeries
+//
+// 시작 시각은 해상도 구간의 시작으로 맞춥니다. 집계 단위를 사용하면 집계 주기만큼 최근 데이터의 반영이 늦을 수 있습니다.
+// 파생 센서는 저장하지 않으므로 입력 센서의 데이터로 계산합니다.
+func (svc *rollupService) GetReadingSeries(ctx context.Context, q *domain.ReadingQuery) (*domain.ReadingSeries, error) {
+	if q.Resolution < time.Minute || q.Resolution%time.Minute != 0 {
+		return nil, fmt.Errorf("%w: 해상도는 분 단위여야 합니다", domain.ErrRollupRange)
+	}
+	if !q.From.Before(q.To) {
+		return nil, fmt.Errorf("%w: 시작 시각은 종료 시각보다 빨라야 합니다", domain.ErrRollupRange)
+	}
+
+	in := *q
+	in.From = in.From.Add(-(in.From.Sub(rollupOrigin) % in.Resolution))
+	if in.From.After(q.From) {
+		in.From = in.From.Add(-in.Resolution)
+	}
+	if points := in.To.Sub(in.From) / in.Resolution; points > time.Duration(svc.policy.MaxPoints) {
+		return nil, fmt.Errorf("%w: 구간이 %d개를 넘습니다 (%d)", domain.ErrRollupRange, svc.policy.MaxPoints, points)
+	}
+
+	sensor, err := svc.meta.GetSensorByParam(ctx, &domain.Sensor{ID: in.SensorID})
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("%w: 존재하지 않는 센서 입니다", domain.ErrRollupRange)
+		}
+		return nil, err
+	}
+
+	tier := domain.SelectRollupTier(in.Resolution)
+	var pointList []*domain.ReadingPoint
+	if sensor.Derived() {
+		pointList, err = svc.derivedPointList(ctx, tier, &in, sensor)
+	} else {
+		pointList, err = svc.r.GetReadingPointList(ctx, tier, &in)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ReadingSeries{
+		DeviceID:   in.DeviceID,
+		SensorID:   in.SensorID,
+		Resolution: in.Resolution.String(),
+		Tier:       tier,
+		Derived:    sensor.Derived(),
+		Points:     pointList,
+	}, nil
+}
+
+// derivedPointList
+//
+// 구간별 입력 센서 평균으로 파생 센서 값을 계산합니다. 최솟값, 최댓값은 평균으로 계산할 수 없으므로 계산 값과 같습니다.
+// 입력 센서 중 하나라도 데이터가 없거나 범위를 벗어난 구간은 포함하지 않습니다.
+func (svc *rollupService) derivedPointList(ctx context.Context, tier domain.RollupTier, q *domain.ReadingQuery, sensor *domain.Sensor) ([]*domain.ReadingPoint, error) {
+	formula, err := agro.LookupFormula(*sensor.Formula)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make([]map[int64]*domain.ReadingPoint, 0, len(sensor.Inputs))
+	var base []*domain.ReadingPoint
+	for i, title := range sensor.Inputs {
+		input, err := svc.meta.GetSensorByParam(ctx, &domain.Sensor{Title: title})
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				requestid.Logger(ctx, svc.log).Warn("파생 센서의 입력 센서가 없습니다", zap.String("sensor", sensor.Title), zap.String("input", title))
+				return []*domain.ReadingPoint{}, nil
+			}
+			return nil, err
+		}
+
+		inputQuery := *q
+		inputQuery.SensorID = input.ID
+		pointList, err := svc.r.GetReadingPointList(ctx, tier, &inputQuery)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			base = pointList
+		}
+
+		byTime := make(map[int64]*domain.ReadingPoint, len(pointList))
+		for _, p := range pointList {
+			byTime[p.Time.Unix()] = p
+		}
+		inputs = append(inputs, byTime)
+	}
+
+	out := make([]*domain.ReadingPoint, 0, len(base))
+	for _, p := range base {
+		values := make([]float64, 0, len(inputs))
+		count := p.Count
+		for _, byTime := range inputs {
+			v, ok := byTime[p.Time.Unix()]
+			if !ok {
+				break
+			}
+			values = append(values, v.Avg)
+			count = min(count, v.Count)
+		}
+		if len(values) != len(inputs) {
+			continue
+		}
+
+		v, ok := formula.Calc(values...)
+		if !ok {
+			continue
+		}
+		out = append(out, &domain.ReadingPoint{Time: p.Time, Min: v, Max: v, Avg: v, Count: count})
+	}
+
+	return out, nil
+}
+
+// run 대기 중인 구간이 없을 때까지 집계합니다.
+func (svc *rollupService) run(ctx context.Context) error {
+	var total int64
+	defer func() {
+		if total > 0 {
+			svc.log.Debug("센서 데이터 집계", zap.Int64("buckets", total))
+		}
+	}()
+
+	for ctx.Err() == nil {
+		refreshed, err := svc.r.RefreshRollup(ctx, svc.policy.BatchSize)
+		if err != nil {
+			return err
+		}
+		total += refreshed
+		if refreshed < int64(svc.policy.BatchSize) {
+			return nil
+		}
+	}
+
+	return ctx.Err()
+}
+
+func NewRollupService(log *zap.Logger, rollupRepository domain.RollupRepository, metaRepository domain.MetaRepository, policy domain.RollupPolicy, jobs *job.Runner) domain.RollupService {
+	svc := &rollupService{
+		log:    log,
+		r:      rollupRepository,
+		meta:   metaRepository,
+		policy: policy,
+	}
+	jobs.Every("rollup_refresh", policy.Interval, svc.run)
+
+	return svc
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GDH-Project/api/internal/agro"
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/job"
+	"go.uber.org/zap"
+)
+
+// fakeSensorReadingRepository 센서별 구간 요약을 응답합니다.
+type fakeSensorReadingRepository struct {
+	domain.RollupRepository
+	points map[int][]*domain.ReadingPoint
+}
+
+func (r *fakeSensorReadingRepository) GetReadingPointList(_ context.Context, _ domain.RollupTier, q *domain.ReadingQuery) ([]*domain.ReadingPoint, error) {
+	return r.points[q.SensorID], nil
+}
+
+func TestGetReadingSeriesDerived(t *testing.T) {
+	ctx := context.Background()
+	vpd := "vpd"
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, agro.Seoul)
+
+	metaRepo := &fakeMetaRepository{sensors: map[string]*domain.Sensor{
+		"기온":    {ID: 1, Title: "기온"},
+		"상대습도":  {ID: 2, Title: "상대습도"},
+		"수증기압차": {ID: 3, Title: "수증기압차", Formula: &vpd, Inputs: []string{"기온", "상대습도"}},
+	}}
+	readingRepo := &fakeSensorReadingRepository{points: map[int][]*domain.ReadingPoint{
+		1: {
+			{Time: from, Min: 20, Max: 30, Avg: 25, Count: 60},
+			{Time: from.Add(time.Hour), Min: 20, Max: 30, Avg: 25, Count: 60},
+		},
+		// 두번째 구간은 상대습도가 없으므로 계산하지 않는다.
+		2: {{Time: from, Min: 50, Max: 70, Avg: 60, Count: 58}},
+	}}
+	svc := NewRollupService(zap.NewNop(), readingRepo, metaRepo, domain.RollupPolicy{MaxPoints: 100}, job.NewRunner(zap.NewNop()))
+
+	series, err := svc.GetReadingSeries(ctx, &domain.ReadingQuery{DeviceID: "device", SensorID: 3, From: from, To: from.Add(2 * time.Hour), Resolution: time.Hour})
+	if err != nil {
+		t.Fatalf("GetReadingSeries() error = %v", err)
+	}
+	if !series.Derived || len(series.Points) != 1 {
+		t.Fatalf("GetReadingSeries() = derived %v, %d 구간, want true, 1", series.Derived, len(series.Points))
+	}
+
+	formula, err := agro.LookupFormula(vpd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := formula.Calc(25, 60)
+	if p := series.Points[0]; p.Avg != want || p.Min != want || p.Max != want || p.Count != 58 {
+		t.Errorf("Points[0] = %+v, want %v (count 58)", p, want)
+	}
+
+	// 수집 센서는 그대로 조회한다.
+	series, err = svc.GetReadingSeries(ctx, &domain.ReadingQuery{DeviceID: "device", SensorID: 1, From: from, To: from.Add(2 * time.Hour), Resolution: time.Hour})
+	if err != nil {
+		t.Fatalf("GetReadingSeries() error = %v", err)
+	}
+	if series.Derived || len(series.Points) != 2 {
+		t.Errorf("GetReadingSeries() = derived %v, %d 구간, want false, 2", series.Derived, len(series.Points))
+	}
+
+	if _, err := svc.GetReadingSeries(ctx, &domain.ReadingQuery{DeviceID: "device", SensorID: 99, From: from, To: from.Add(time.Hour), Resolution: time.Hour}); !errors.Is(err, domain.ErrRollupRange) {
+		t.Errorf("존재하지 않는 센서 error = %v, want %v", err, domain.ErrRollupRange)
+	}
+}
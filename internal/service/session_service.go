@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/iplocation"
+	"github.com/GDH-Project/api/internal/job"
+	"github.com/GDH-Project/api/internal/requestid"
+	"github.com/GDH-Project/api/internal/useragent"
+	"go.uber.org/zap"
+)
+
+type sessionService struct {
+	log     *zap.Logger
+	r       domain.SessionRepository
+	locator *iplocation.Locator
+}
+
+// CreateSession
+//
+// IP, User-Agent 는 WithGrpcMeta 미들웨어가 context 에 설정한 값을 사용한다.
+func (svc *sessionService) CreateSession(ctx context.Context, userID string, token *domain.Token) error {
+	clientIP, _ := ctx.Value("client_ip").(string)
+	userAgent, _ := ctx.Value("user_agent").(string)
+
+	tokens, expiresAt := sessionTokens(token)
+	return svc.r.CreateSession(ctx, &domain.Session{
+		UserID:    userID,
+		UserAgent: userAgent,
+		ClientIP:  clientIP,
+		ExpiresAt: expiresAt,
+	}, tokens)
+}
+
+func (svc *sessionService) RefreshSession(ctx context.Context, userID string, refreshToken string, token *domain.Token) error {
+	session, err := svc.r.GetSessionByTokenHash(ctx, hashToken(refreshToken))
+	if err != nil {
+		// 세션 기능 이전에 발급된 토큰은 새로운 세션으로 기록한다.
+		if errors.Is(err, domain.ErrNotFound) {
+			return svc.CreateSession(ctx, userID, token)
+		}
+		return err
+	}
+	if session.RevokedAt != nil {
+		return domain.ErrTokenRevoked
+	}
+
+	tokens, expiresAt := sessionTokens(token)
+	return svc.r.AddSessionTokens(ctx, session.ID, tokens, expiresAt)
+}
+
+// CheckSession
+//
+// 세션 기능 이전에 발급되어 세션이 없는 토큰은 만료될 때까지 허용합니다.
+// 마지막 활동 시각은 참고용이므로 갱신에 실패해도 요청은 처리한다.
+func (svc *sessionService) CheckSession(ctx context.Context, token string) error {
+	session, err := svc.r.GetSessionByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if session.RevokedAt != nil {
+		return domain.ErrTokenRevoked
+	}
+
+	clientIP, _ := ctx.Value("client_ip").(string)
+	userAgent, _ := ctx.Value("user_agent").(string)
+	if err := svc.r.UpdateSessionActivity(ctx, session.ID, clientIP, userAgent); err != nil {
+		requestid.Logger(ctx, svc.log).Warn("세션 마지막 활동 시각 갱신 실패", zap.Error(err), zap.String("session_id", session.ID))
+	}
+
+	return nil
+}
+
+func (svc *sessionService) GetSessionList(ctx context.Context, userID string, currentToken string) ([]*domain.Session, error) {
+	sessionList, err := svc.r.GetSessionList(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentID, err := svc.sessionID(ctx, currentToken)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range sessionList {
+		s.Device = useragent.Describe(s.UserAgent)
+		s.Location = svc.locator.Lookup(s.ClientIP)
+		s.Current = s.ID == currentID
+	}
+
+	return sessionList, nil
+}
+
+func (svc *sessionService) RevokeSession(ctx context.Context, userID string, sessionID string) error {
+	return svc.r.RevokeSession(ctx, userID, sessionID)
+}
+
+func (svc *sessionService) RevokeSessionByToken(ctx context.Context, userID string, token string) error {
+	sessionID, err := svc.sessionID(ctx, token)
+	if err != nil || sessionID == "" {
+		return err
+	}
+
+	err = svc.r.RevokeSession(ctx, userID, sessionID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (svc *sessionService) CheckTokenOwner(ctx context.Context, userID string, token string) error {
+	session, err := svc.r.GetSessionByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if session.UserID != userID {
+		return domain.ErrInvalidParam
+	}
+
+	return nil
+}
+
+func (svc *sessionService) RevokeOtherSessions(ctx context.Context, userID string, currentToken string) (int64, error) {
+	currentID, err := svc.sessionID(ctx, currentToken)
+	if err != nil {
+		return 0, err
+	}
+
+	return svc.r.RevokeUserSessions(ctx, userID, currentID)
+}
+
+// sessionID 토큰이 발급된 세션 ID 를 반환합니다. 세션이 없는 토큰이면 빈 문자열을 반환합니다.
+func (svc *sessionService) sessionID(ctx context.Context, token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+
+	session, err := svc.r.GetSessionByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return session.ID, nil
+}
+
+// cleanup 만료된 세션을 삭제합니다.
+func (svc *sessionService) cleanup(ctx context.Context) error {
+	deleted, err := svc.r.DeleteExpiredSession(ctx)
+	if err != nil {
+		return err
+	}
+	svc.log.Debug("만료된 세션 삭제", zap.Int64("deleted", deleted))
+
+	return nil
+}
+
+// sessionTokens 발급된 토큰의 해시와 세션 만료 시각(가장 늦은 토큰 만료 시각)을 반환합니다.
+func sessionTokens(token *domain.Token) ([]domain.SessionToken, time.Time) {
+	var expiresAt time.Time
+	tokens := make([]domain.SessionToken, 0, 2)
+
+	for _, t := range []string{token.AccessToken, token.RefreshToken} {
+		if t == "" {
+			continue
+		}
+
+		exp := time.Now().Add(defaultRevocationTTL)
+		if claims, ok := parseTokenClaims(t); ok && claims.ExpiresAt > 0 {
+			exp = time.Unix(claims.ExpiresAt, 0)
+		}
+		if exp.After(expiresAt) {
+			expiresAt = exp
+		}
+
+		tokens = append(tokens, domain.SessionToken{TokenHash: hashToken(t), ExpiresAt: exp})
+	}
+
+	return tokens, expiresAt
+}
+
+// NewSessionService locator 가 nil 이면 사설망 주소의 위치만 표시합니다.
+func NewSessionService(log *zap.Logger, sessionRepository domain.SessionRepository, locator *iplocation.Locator, jobs *job.Runner) domain.SessionService {
+	if locator == nil {
+		locator = iplocation.New()
+	}
+
+	svc := &sessionService{
+		log:     log,
+		r:       sessionRepository,
+		locator: locator,
+	}
+	jobs.Every("session_cleanup", time.Hour, svc.cleanup)
+
+	return svc
+}
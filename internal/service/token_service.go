@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/job"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+// defaultRevocationTTL 만료 시각을 알 수 없는 토큰의 폐기 보관 기간 입니다.
+const defaultRevocationTTL = 30 * 24 * time.Hour
+
+type tokenRevocationService struct {
+	log *zap.Logger
+	r   domain.TokenRevocationRepository
+}
+
+func (svc *tokenRevocationService) RevokeToken(ctx context.Context, kind domain.TokenKind, token string, userID string) error {
+	expiresAt := time.Now().Add(defaultRevocationTTL)
+	if claims, ok := parseTokenClaims(token); ok && claims.ExpiresAt > 0 {
+		expiresAt = time.Unix(claims.ExpiresAt, 0)
+	}
+
+	return svc.r.RevokeToken(ctx, hashToken(token), kind, userID, expiresAt)
+}
+
+func (svc *tokenRevocationService) RevokeUserTokens(ctx context.Context, userID string) error {
+	revokedBefore := revocationCutoff(time.Now())
+	if err := svc.r.RevokeUserTokens(ctx, userID, revokedBefore); err != nil {
+		return err
+	}
+
+	// 폐기 직후 로그인으로 발급된 토큰의 iat 가 폐기 시각 이후가 되도록 다음 초까지 기다린다.
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(time.Until(revokedBefore)):
+		return nil
+	}
+}
+
+// revocationCutoff 토큰의 iat 는 초 단위 이므로 폐기 시각을 다음 초로 올림합니다.
+func revocationCutoff(now time.Time) time.Time {
+	cutoff := now.Truncate(time.Second)
+	if cutoff.Before(now) {
+		cutoff = cutoff.Add(time.Second)
+	}
+	return cutoff
+}
+
+func (svc *tokenRevocationService) CheckToken(ctx context.Context, token string, userID string) error {
+	revoked, err := svc.r.IsTokenRevoked(ctx, hashToken(token))
+	if err != nil {
+		return err
+	}
+	if revoked {
+		return domain.ErrTokenRevoked
+	}
+
+	if userID == "" {
+		return nil
+	}
+
+	revokedBefore, err := svc.r.GetUserTokensRevokedBefore(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	// 인증 서버가 iat 를 항상 넣는다는 보장이 없으므로 발급 시각을 알 수 없는 토큰은 폐기하지 않는다.
+	claims, ok := parseTokenClaims(token)
+	if !ok || claims.IssuedAt == 0 {
+		requestid.Logger(ctx, svc.log).Warn("발급 시각을 알 수 없는 토큰", zap.String("user_id", userID))
+		return nil
+	}
+	if issuedBeforeRevocation(claims.IssuedAt, revokedBefore) {
+		return domain.ErrTokenRevoked
+	}
+
+	return nil
+}
+
+// issuedBeforeRevocation iat 가 전체 세션 폐기 시각 이전이면 true 를 반환합니다.
+func issuedBeforeRevocation(issuedAt int64, revokedBefore time.Time) bool {
+	return time.Unix(issuedAt, 0).Before(revokedBefore)
+}
+
+// cleanup 만료된 폐기 토큰을 삭제합니다.
+func (svc *tokenRevocationService) cleanup(ctx context.Context) error {
+	deleted, err := svc.r.DeleteExpiredRevokedToken(ctx)
+	if err != nil {
+		return err
+	}
+	svc.log.Debug("만료된 폐기 토큰 삭제", zap.Int64("deleted", deleted))
+
+	return nil
+}
+
+type tokenClaims struct {
+	IssuedAt  int64 `json:"iat"`
+	ExpiresAt int64 `json:"exp"`
+}
+
+// parseTokenClaims JWT payload 의 iat, exp 를 읽습니다.
+//
+// 서명은 인증 서버에서 검증하므로 여기서는 검증하지 않는다. 검증된 토큰에만 사용해야 한다.
+func parseTokenClaims(token string) (*tokenClaims, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, false
+	}
+
+	var claims tokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, false
+	}
+
+	return &claims, true
+}
+
+// hashToken 토큰 원문은 저장하지 않는다.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func NewTokenRevocationService(log *zap.Logger, tokenRevocationRepository domain.TokenRevocationRepository, jobs *job.Runner) domain.TokenRevocationService {
+	svc := &tokenRevocationService{
+		log: log,
+		r:   tokenRevocationRepository,
+	}
+	jobs.Every("revoked_token_cleanup", time.Hour, svc.cleanup)
+
+	return svc
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/job"
+	"go.uber.org/zap"
+)
+
+// fakeTokenRevocationRepository 전체 세션 폐기 시각만 저장합니다.
+type fakeTokenRevocationRepository struct {
+	domain.TokenRevocationRepository
+	revoked       map[string]bool
+	revokedBefore map[string]time.Time
+}
+
+func newFakeTokenRevocationRepository() *fakeTokenRevocationRepository {
+	return &fakeTokenRevocationRepository{
+		revoked:       make(map[string]bool),
+		revokedBefore: make(map[string]time.Time),
+	}
+}
+
+func (r *fakeTokenRevocationRepository) RevokeToken(_ context.Context, tokenHash string, _ domain.TokenKind, _ string, _ time.Time) error {
+	r.revoked[tokenHash] = true
+	return nil
+}
+
+func (r *fakeTokenRevocationRepository) IsTokenRevoked(_ context.Context, tokenHash string) (bool, error) {
+	return r.revoked[tokenHash], nil
+}
+
+func (r *fakeTokenRevocationRepository) RevokeUserTokens(_ context.Context, userID string, revokedBefore time.Time) error {
+	if revokedBefore.After(r.revokedBefore[userID]) {
+		r.revokedBefore[userID] = revokedBefore
+	}
+	return nil
+}
+
+func (r *fakeTokenRevocationRepository) GetUserTokensRevokedBefore(_ context.Context, userID string) (time.Time, error) {
+	t, ok := r.revokedBefore[userID]
+	if !ok {
+		return time.Time{}, domain.ErrNotFound
+	}
+	return t, nil
+}
+
+// testToken iat 만 있는 서명되지 않은 JWT 를 만듭니다.
+func testToken(issuedAt time.Time) string {
+	payload := fmt.Sprintf(`{"iat":%d,"exp":%d}`, issuedAt.Unix(), issuedAt.Add(time.Hour).Unix())
+	return "e30." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".sig"
+}
+
+func TestRevocationCutoff(t *testing.T) {
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{name: "초 중간", now: time.Date(2026, 3, 10, 12, 0, 0, 500_000_000, time.UTC), want: time.Date(2026, 3, 10, 12, 0, 1, 0, time.UTC)},
+		{name: "정각", now: time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC), want: time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := revocationCutoff(tt.now); !got.Equal(tt.want) {
+				t.Errorf("revocationCutoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssuedBeforeRevocation(t *testing.T) {
+	revokedBefore := time.Date(2026, 3, 10, 12, 0, 1, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		issuedAt time.Time
+		want     bool
+	}{
+		{name: "폐기와 같은 초", issuedAt: revokedBefore.Add(-time.Second), want: true},
+		{name: "폐기 시각", issuedAt: revokedBefore, want: false},
+		{name: "다음 초", issuedAt: revokedBefore.Add(time.Second), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := issuedBeforeRevocation(tt.issuedAt.Unix(), revokedBefore); got != tt.want {
+				t.Errorf("issuedBeforeRevocation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckToken(t *testing.T) {
+	const userID = "user"
+	ctx := context.Background()
+
+	repo := newFakeTokenRevocationRepository()
+	svc := NewTokenRevocationService(zap.NewNop(), repo, job.NewRunner(zap.NewNop())).(*tokenRevocationService)
+
+	beforeSignOut := testToken(time.Now().Add(-time.Second))
+	if err := svc.RevokeUserTokens(ctx, userID); err != nil {
+		t.Fatalf("RevokeUserTokens() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		token  string
+		userID string
+		want   error
+	}{
+		{name: "전체 세션 폐기 이전 발급", token: beforeSignOut, userID: userID, want: domain.ErrTokenRevoked},
+		{name: "전체 세션 폐기 직후 발급", token: testToken(time.Now()), userID: userID, want: nil},
+		{name: "발급 시각을 알 수 없는 토큰", token: "opaque", userID: userID, want: nil},
+		{name: "다른 사용자", token: beforeSignOut, userID: "other", want: nil},
+		{name: "사용자 확인 생략", token: beforeSignOut, userID: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := svc.CheckToken(ctx, tt.token, tt.userID); !errors.Is(err, tt.want) {
+				t.Errorf("CheckToken() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	t.Run("폐기된 토큰", func(t *testing.T) {
+		token := testToken(time.Now().Add(2 * time.Second))
+		if err := svc.RevokeToken(ctx, domain.TokenKindAccess, token, userID); err != nil {
+			t.Fatalf("RevokeToken() error = %v", err)
+		}
+		if err := svc.CheckToken(ctx, token, ""); !errors.Is(err, domain.ErrTokenRevoked) {
+			t.Errorf("CheckToken() error = %v, want %v", err, domain.ErrTokenRevoked)
+		}
+	})
+}
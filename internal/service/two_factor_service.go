@@ -0,0 +1,408 @@
+package service
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/job"
+	"github.com/GDH-Project/api/internal/totp"
+	"go.uber.org/zap"
+)
+
+// recoveryCodeCount 한번에 발급하는 복구 코드 수 입니다.
+const recoveryCodeCount = 10
+
+type twoFactorService struct {
+	log    *zap.Logger
+	r      domain.TwoFactorRepository
+	policy domain.TwoFactorPolicy
+	aead   cipher.AEAD
+}
+
+func (svc *twoFactorService) BeginEnrollment(ctx context.Context, userID string, account string) (*domain.TwoFactorEnrollment, error) {
+	secret := totp.NewSecret()
+	encrypted, err := svc.seal(secret, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.r.SaveTwoFactorSecret(ctx, userID, encrypted); err != nil {
+		return nil, err
+	}
+
+	return &domain.TwoFactorEnrollment{
+		Secret:          totp.EncodeSecret(secret),
+		ProvisioningURI: totp.URI(svc.policy.Issuer, account, secret),
+	}, nil
+}
+
+func (svc *twoFactorService) ConfirmEnrollment(ctx context.Context, userID string, code string) ([]string, error) {
+	tf, err := svc.r.GetTwoFactor(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrTwoFactorNotEnabled
+		}
+		return nil, err
+	}
+	if tf.EnabledAt != nil {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := svc.open(tf.EncryptedSecret, userID)
+	if err != nil {
+		return nil, err
+	}
+	step, ok := totp.Validate(secret, strings.TrimSpace(code), time.Now())
+	if !ok {
+		return nil, domain.ErrTwoFactorInvalidCode
+	}
+
+	codes, hashes := newRecoveryCodes()
+	if err := svc.r.EnableTwoFactor(ctx, userID, step, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func (svc *twoFactorService) Disable(ctx context.Context, userID string, code string) error {
+	tf, err := svc.enabled(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := svc.verifyCode(ctx, tf, code, true); err != nil {
+		return err
+	}
+
+	return svc.r.DeleteTwoFactor(ctx, userID)
+}
+
+func (svc *twoFactorService) RegenerateRecoveryCodes(ctx context.Context, userID string, code string) ([]string, error) {
+	tf, err := svc.enabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	// 복구 코드를 모두 잃어버린 경우를 대비해 OTP 앱의 코드만 허용한다.
+	if err := svc.verifyCode(ctx, tf, code, false); err != nil {
+		return nil, err
+	}
+
+	codes, hashes := newRecoveryCodes()
+	if err := svc.r.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func (svc *twoFactorService) GetStatus(ctx context.Context, userID string, role domain.UserRole) (*domain.TwoFactorStatus, error) {
+	var status domain.TwoFactorStatus
+
+	roles, err := svc.r.GetTwoFactorRequiredRoleList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status.Required = slices.Contains(roles, role)
+
+	tf, err := svc.r.GetTwoFactor(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return &status, nil
+		}
+		return nil, err
+	}
+	if tf.EnabledAt == nil {
+		return &status, nil
+	}
+
+	status.Enabled = true
+	status.EnabledAt = tf.EnabledAt
+	if status.RecoveryCodesRemaining, err = svc.r.CountRecoveryCodes(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+func (svc *twoFactorService) IsEnabled(ctx context.Context, userID string) (bool, error) {
+	_, err := svc.enabled(ctx, userID)
+	if errors.Is(err, domain.ErrTwoFactorNotEnabled) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// IssueChallenge
+//
+// 발급된 토큰은 user_id 를 추가 인증 데이터로 암호화해서 보관하고 challenge 원문은 해시만 저장합니다.
+func (svc *twoFactorService) IssueChallenge(ctx context.Context, userID string, token *domain.Token) (*domain.TwoFactorChallenge, error) {
+	plain, err := json.Marshal(token)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := svc.seal(plain, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := &domain.TwoFactorChallenge{
+		UserID:         userID,
+		ChallengeToken: rand.Text(),
+		ExpiresAt:      time.Now().Add(svc.policy.ChallengeTTL),
+	}
+	if err := svc.r.CreateTwoFactorChallenge(ctx, hashToken(challenge.ChallengeToken), userID, encrypted, challenge.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	return challenge, nil
+}
+
+func (svc *twoFactorService) GetChallengeUserID(ctx context.Context, challengeToken string) (string, error) {
+	userID, _, err := svc.r.GetTwoFactorChallenge(ctx, hashToken(challengeToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return "", domain.ErrTwoFactorChallengeInvalid
+		}
+		return "", err
+	}
+
+	return userID, nil
+}
+
+func (svc *twoFactorService) VerifyChallenge(ctx context.Context, challengeToken string, code string) (*domain.TwoFactorChallenge, error) {
+	challengeHash := hashToken(challengeToken)
+
+	userID, encrypted, err := svc.r.GetTwoFactorChallenge(ctx, challengeHash)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrTwoFactorChallengeInvalid
+		}
+		return nil, err
+	}
+
+	plain, err := svc.open(encrypted, userID)
+	if err != nil {
+		return nil, err
+	}
+	var token domain.Token
+	if err := json.Unmarshal(plain, &token); err != nil {
+		return nil, err
+	}
+	challenge := &domain.TwoFactorChallenge{UserID: userID, Token: &token}
+
+	tf, err := svc.enabled(ctx, userID)
+	if errors.Is(err, domain.ErrTwoFactorNotEnabled) {
+		// 로그인 이후 2단계 인증이 해제된 경우 다시 로그인 하도록 한다.
+		_ = svc.r.TakeTwoFactorChallenge(ctx, challengeHash)
+		return challenge, domain.ErrTwoFactorChallengeInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.verifyCode(ctx, tf, code, true); err != nil {
+		if !errors.Is(err, domain.ErrTwoFactorInvalidCode) {
+			return nil, err
+		}
+
+		attempts, incErr := svc.r.IncrementTwoFactorChallengeAttempts(ctx, challengeHash)
+		if incErr == nil && attempts >= svc.policy.MaxAttempts {
+			_ = svc.r.TakeTwoFactorChallenge(ctx, challengeHash)
+			return challenge, fmt.Errorf("%w: %w", domain.ErrTwoFactorChallengeInvalid, err)
+		}
+		return nil, err
+	}
+
+	// 동시에 같은 challenge 로 인증한 경우 먼저 삭제한 요청만 토큰을 받는다.
+	if err := svc.r.TakeTwoFactorChallenge(ctx, challengeHash); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrTwoFactorChallengeInvalid
+		}
+		return nil, err
+	}
+
+	return challenge, nil
+}
+
+func (svc *twoFactorService) GetRequiredRoleList(ctx context.Context) ([]domain.UserRole, error) {
+	return svc.r.GetTwoFactorRequiredRoleList(ctx)
+}
+
+func (svc *twoFactorService) SetRequiredRoleList(ctx context.Context, roles []domain.UserRole, adminID string) error {
+	// nil 은 NULL 로 전달되어 기존 권한이 삭제되지 않으므로 빈 slice 를 사용한다.
+	list := make([]domain.UserRole, 0, len(roles))
+	for _, role := range roles {
+		if !slices.Contains(list, role) {
+			list = append(list, role)
+		}
+	}
+
+	return svc.r.SetTwoFactorRequiredRoleList(ctx, list, adminID)
+}
+
+func (svc *twoFactorService) CheckRole(ctx context.Context, userID string, role domain.UserRole) error {
+	roles, err := svc.r.GetTwoFactorRequiredRoleList(ctx)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(roles, role) {
+		return nil
+	}
+
+	enabled, err := svc.IsEnabled(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return domain.ErrTwoFactorRequired
+	}
+
+	return nil
+}
+
+// enabled 사용중인 2단계 인증 정보를 조회합니다. 없거나 등록 확인 전이면 ErrTwoFactorNotEnabled 를 반환합니다.
+func (svc *twoFactorService) enabled(ctx context.Context, userID string) (*domain.TwoFactor, error) {
+	tf, err := svc.r.GetTwoFactor(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrTwoFactorNotEnabled
+		}
+		return nil, err
+	}
+	if tf.EnabledAt == nil {
+		return nil, domain.ErrTwoFactorNotEnabled
+	}
+
+	return tf, nil
+}
+
+// verifyCode
+//
+// 6자리 숫자는 OTP 앱의 코드로, 그 외는 복구 코드로 확인합니다.
+// OTP 코드는 마지막으로 사용한 구간 이후의 코드만, 복구 코드는 한번만 사용할 수 있습니다.
+func (svc *twoFactorService) verifyCode(ctx context.Context, tf *domain.TwoFactor, code string, allowRecoveryCode bool) error {
+	code = strings.TrimSpace(code)
+
+	if len(code) == totp.Digits && strings.Trim(code, "0123456789") == "" {
+		secret, err := svc.open(tf.EncryptedSecret, tf.UserID)
+		if err != nil {
+			return err
+		}
+		step, ok := totp.Validate(secret, code, time.Now())
+		if !ok || step <= tf.LastUsedStep {
+			return domain.ErrTwoFactorInvalidCode
+		}
+
+		used, err := svc.r.UseTwoFactorStep(ctx, tf.UserID, step)
+		if err != nil {
+			return err
+		}
+		if !used {
+			return domain.ErrTwoFactorInvalidCode
+		}
+		return nil
+	}
+
+	if !allowRecoveryCode {
+		return domain.ErrTwoFactorInvalidCode
+	}
+
+	used, err := svc.r.UseRecoveryCode(ctx, tf.UserID, hashToken(normalizeRecoveryCode(code)))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return domain.ErrTwoFactorInvalidCode
+	}
+
+	return nil
+}
+
+// seal user_id 를 추가 인증 데이터로 사용하여 다른 사용자의 값으로 바꿔치기 할 수 없도록 한다.
+func (svc *twoFactorService) seal(plain []byte, userID string) ([]byte, error) {
+	nonce := make([]byte, svc.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return svc.aead.Seal(nonce, nonce, plain, []byte(userID)), nil
+}
+
+func (svc *twoFactorService) open(encrypted []byte, userID string) ([]byte, error) {
+	nonceSize := svc.aead.NonceSize()
+	if len(encrypted) < nonceSize {
+		return nil, errors.New("2단계 인증 암호문 형식 오류")
+	}
+
+	plain, err := svc.aead.Open(nil, encrypted[:nonceSize], encrypted[nonceSize:], []byte(userID))
+	if err != nil {
+		svc.log.Error("2단계 인증 복호화 실패", zap.String("user_id", userID), zap.Error(err))
+		return nil, err
+	}
+
+	return plain, nil
+}
+
+// cleanup 만료된 challenge 를 삭제합니다.
+func (svc *twoFactorService) cleanup(ctx context.Context) error {
+	deleted, err := svc.r.DeleteExpiredTwoFactorChallenge(ctx)
+	if err != nil {
+		return err
+	}
+	svc.log.Debug("만료된 2단계 인증 요청 삭제", zap.Int64("deleted", deleted))
+
+	return nil
+}
+
+// newRecoveryCodes XXXXX-XXXXX 형식의 복구 코드와 해시를 생성합니다.
+func newRecoveryCodes() ([]string, []string) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+
+	for range recoveryCodeCount {
+		text := rand.Text()
+		code := text[:5] + "-" + text[5:10]
+
+		codes = append(codes, code)
+		hashes = append(hashes, hashToken(normalizeRecoveryCode(code)))
+	}
+
+	return codes, hashes
+}
+
+// normalizeRecoveryCode 대소문자, 구분자(-, 공백) 차이는 무시한다.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+func NewTwoFactorService(log *zap.Logger, twoFactorRepository domain.TwoFactorRepository, policy domain.TwoFactorPolicy, jobs *job.Runner) (domain.TwoFactorService, error) {
+	block, err := aes.NewCipher(policy.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("2단계 인증 암호화 키 오류: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &twoFactorService{
+		log:    log,
+		r:      twoFactorRepository,
+		policy: policy,
+		aead:   aead,
+	}
+	jobs.Every("two_factor_challenge_cleanup", 10*time.Minute, svc.cleanup)
+
+	return svc, nil
+}
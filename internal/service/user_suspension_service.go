@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+type userSuspensionService struct {
+	log *zap.Logger
+	r   domain.UserSuspensionRepository
+}
+
+func (svc *userSuspensionService) CreateUserSuspension(ctx context.Context, suspension *domain.UserSuspension) error {
+	return svc.r.CreateUserSuspension(ctx, suspension)
+}
+
+func (svc *userSuspensionService) DeleteUserSuspension(ctx context.Context, userID string) error {
+	return svc.r.DeleteUserSuspension(ctx, userID)
+}
+
+func (svc *userSuspensionService) GetUserSuspension(ctx context.Context, userID string) (*domain.UserSuspension, error) {
+	return svc.r.GetUserSuspension(ctx, userID)
+}
+
+func (svc *userSuspensionService) GetUserSuspensionList(ctx context.Context) ([]*domain.UserSuspension, error) {
+	return svc.r.GetUserSuspensionList(ctx)
+}
+
+// CheckUser
+//
+// 저장소 오류가 발생하면 정지 여부를 알 수 없으므로 오류를 반환합니다.
+func (svc *userSuspensionService) CheckUser(ctx context.Context, userID string) error {
+	_, err := svc.r.GetUserSuspension(ctx, userID)
+	if err == nil {
+		return domain.ErrUserSuspended
+	}
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+
+	return err
+}
+
+func NewUserSuspensionService(log *zap.Logger, userSuspensionRepository domain.UserSuspensionRepository) domain.UserSuspensionService {
+	return &userSuspensionService{
+		log: log,
+		r:   userSuspensionRepository,
+	}
+}
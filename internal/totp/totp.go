@@ -0,0 +1,102 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// RFC 6238 기본값 입니다. 대부분의 OTP 앱이 이 값만 지원한다.
+const (
+	Digits = 6
+	// modulo 10^Digits
+	modulo = 1_000_000
+	Period = 30 * time.Second
+
+	// Skew 시계 오차를 고려하여 앞뒤로 허용하는 구간 수 입니다.
+	Skew = 1
+	// SecretSize secret 길이 입니다. (RFC 4226 권장 160 bit)
+	SecretSize = 20
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewSecret 새로운 secret 을 생성합니다.
+func NewSecret() []byte {
+	secret := make([]byte, SecretSize)
+	_, _ = rand.Read(secret)
+	return secret
+}
+
+// EncodeSecret OTP 앱에 입력하는 base32 형식으로 변환합니다.
+func EncodeSecret(secret []byte) string {
+	return encoding.EncodeToString(secret)
+}
+
+// URI
+//
+// OTP 앱 등록용 otpauth URI 를 생성합니다. QR 코드로 변환해서 사용합니다.
+//
+//	otpauth://totp/GDH:example@example.com?secret=...&issuer=GDH
+func URI(issuer string, account string, secret []byte) string {
+	v := url.Values{}
+	v.Set("secret", EncodeSecret(secret))
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(Digits))
+	v.Set("period", fmt.Sprint(int(Period.Seconds())))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// Step t 의 시간 구간 입니다.
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period.Seconds())
+}
+
+// Code step 구간의 코드를 생성합니다.
+func Code(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// RFC 4226 dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", Digits, value%modulo)
+}
+
+// Validate
+//
+// t 기준 앞뒤 Skew 구간 안의 코드인지 확인하고 일치한 구간을 반환합니다.
+// 같은 코드를 다시 사용할 수 없도록 반환된 구간을 저장해서 이전 구간의 코드는 거절해야 한다.
+func Validate(secret []byte, code string, t time.Time) (int64, bool) {
+	if len(code) != Digits {
+		return 0, false
+	}
+
+	current := Step(t)
+	for step := current - Skew; step <= current+Skew; step++ {
+		if subtle.ConstantTimeCompare([]byte(Code(secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,91 @@
+package totp
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfc6238Secret RFC 6238 Appendix B 의 SHA1 secret 입니다.
+var rfc6238Secret = []byte("12345678901234567890")
+
+// TestCodeRFC6238 RFC 6238 Appendix B 의 SHA1 테스트 벡터, 8자리 값의 마지막 6자리를 비교합니다.
+func TestCodeRFC6238(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string // 8자리 값: 94287082, 07081804, 14050471, 89005924, 69279037, 65353130
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		t.Run(time.Unix(tt.unix, 0).UTC().Format(time.RFC3339), func(t *testing.T) {
+			if got := Code(rfc6238Secret, Step(time.Unix(tt.unix, 0))); got != tt.want {
+				t.Errorf("Code() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	step := Step(now)
+
+	tests := []struct {
+		name     string
+		code     string
+		wantStep int64
+		wantOK   bool
+	}{
+		{name: "현재 구간", code: Code(rfc6238Secret, step), wantStep: step, wantOK: true},
+		{name: "이전 구간", code: Code(rfc6238Secret, step-1), wantStep: step - 1, wantOK: true},
+		{name: "다음 구간", code: Code(rfc6238Secret, step+1), wantStep: step + 1, wantOK: true},
+		{name: "허용 범위 밖", code: Code(rfc6238Secret, step-2), wantOK: false},
+		{name: "자릿수 오류", code: "12345", wantOK: false},
+		{name: "빈 값", code: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStep, ok := Validate(rfc6238Secret, tt.code, now)
+			if ok != tt.wantOK {
+				t.Fatalf("Validate() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && gotStep != tt.wantStep {
+				t.Errorf("Validate() step = %d, want %d", gotStep, tt.wantStep)
+			}
+		})
+	}
+}
+
+func TestURI(t *testing.T) {
+	u, err := url.Parse(URI("GDH", "example@example.com", rfc6238Secret))
+	if err != nil {
+		t.Fatalf("url.Parse() error = %v", err)
+	}
+
+	if u.Scheme != "otpauth" || u.Host != "totp" {
+		t.Errorf("URI = %s, want otpauth://totp/...", u)
+	}
+	if u.Path != "/GDH:example@example.com" {
+		t.Errorf("Path = %s", u.Path)
+	}
+	q := u.Query()
+	if q.Get("secret") != "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" {
+		t.Errorf("secret = %s", q.Get("secret"))
+	}
+	for key, want := range map[string]string{"issuer": "GDH", "algorithm": "SHA1", "digits": "6", "period": "30"} {
+		if got := q.Get(key); got != want {
+			t.Errorf("%s = %s, want %s", key, got, want)
+		}
+	}
+	if strings.Contains(q.Get("secret"), "=") {
+		t.Errorf("secret 에 padding 이 포함되었습니다 (%s)", q.Get("secret"))
+	}
+}
@@ -0,0 +1,23 @@
+package tracing
+
+import (
+	"github.com/danielgtaylor/huma/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HumaMiddleware
+//
+// otelgin 이 생성한 HTTP span 의 이름을 huma OperationID 로 변경합니다.
+// api.UseMiddleware() 로 등록해야 합니다.
+func HumaMiddleware() func(ctx huma.Context, next func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		span := trace.SpanFromContext(ctx.Context())
+		if op := ctx.Operation(); op != nil && op.OperationID != "" {
+			span.SetName(op.OperationID)
+			span.SetAttributes(attribute.String("huma.operation_id", op.OperationID))
+		}
+
+		next(ctx)
+	}
+}
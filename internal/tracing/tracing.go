@@ -0,0 +1,88 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.uber.org/zap"
+)
+
+const ServiceName = "gdh-api"
+
+type Exporter string
+
+const (
+	ExporterNone   Exporter = "none"
+	ExporterStdout Exporter = "stdout"
+	ExporterOTLP   Exporter = "otlp"
+)
+
+// Config
+//
+// 트레이싱 설정 입니다.
+// OTLP 수신 주소 등은 표준 환경변수(OTEL_EXPORTER_OTLP_ENDPOINT 등)를 따릅니다.
+type Config struct {
+	Exporter    Exporter
+	SampleRatio float64
+	Version     string
+}
+
+// Init
+//
+// 전역 TracerProvider 와 W3C TraceContext propagator 를 설정합니다.
+// 반환된 함수는 서버 종료 시 호출하여 남은 span 을 전송해야 합니다.
+func Init(ctx context.Context, log *zap.Logger, cfg Config) (func(context.Context) error, error) {
+	// gRPC metadata 로 traceparent 를 전달하기 위해 exporter 와 관계없이 설정
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	switch cfg.Exporter {
+	case ExporterNone, "":
+		log.Info("트레이싱 비활성화")
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterOTLP:
+		exporter, err = otlptracegrpc.New(ctx)
+	default:
+		return nil, fmt.Errorf("지원하지 않는 trace exporter 입니다: %s", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("trace exporter 생성 실패: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(ServiceName),
+		semconv.ServiceVersion(cfg.Version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("trace resource 생성 실패: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+
+	log.Info("트레이싱 활성화",
+		zap.String("exporter", string(cfg.Exporter)),
+		zap.Float64("sample_ratio", cfg.SampleRatio),
+	)
+
+	return tp.Shutdown, nil
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+type accountDeletionUseCase struct {
+	accountDeletionService domain.AccountDeletionService
+	tokenService           domain.TokenRevocationService
+	userUseCase            domain.UserUseCase
+	log                    *zap.Logger
+}
+
+// DeleteAccount
+//
+// 사용자 서버의 탈퇴 전에 정리 작업을 기록하고, 탈퇴가 확인되면 모든 토큰을 폐기한 뒤 데이터를 정리합니다.
+// 탈퇴 이후의 오류는 응답하지 않고 기록만 하며, 정리되지 않은 작업은 백그라운드에서 재시도 합니다.
+func (uc *accountDeletionUseCase) DeleteAccount(ctx context.Context, userID string, password string, mode domain.AccountDeletionMode) (*domain.AccountDeletion, error) {
+	if mode == "" {
+		mode = uc.accountDeletionService.DefaultMode()
+	}
+
+	user, err := uc.userUseCase.GetUserInfoByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.accountDeletionService.RequestAccountDeletion(ctx, userID, user.Email, mode); err != nil {
+		return nil, err
+	}
+
+	if err := uc.userUseCase.DeleteUser(ctx, userID, password); err != nil {
+		if cancelErr := uc.accountDeletionService.CancelAccountDeletion(ctx, userID); cancelErr != nil {
+			requestid.Logger(ctx, uc.log).Warn("adc.DeleteAccount() 작업 취소 실패", zap.Error(cancelErr), zap.String("user_id", userID))
+		}
+		return nil, err
+	}
+
+	pending := &domain.AccountDeletion{
+		UserID: userID,
+		Mode:   mode,
+		Status: domain.AccountDeletionStatusPending,
+	}
+	if err := uc.accountDeletionService.ConfirmAccountDeletion(ctx, userID); err != nil {
+		requestid.Logger(ctx, uc.log).Error("adc.DeleteAccount() 탈퇴 확인 기록 실패", zap.Error(err), zap.String("user_id", userID))
+		return pending, nil
+	}
+
+	if err := uc.tokenService.RevokeUserTokens(ctx, userID); err != nil {
+		requestid.Logger(ctx, uc.log).Warn("adc.DeleteAccount() 토큰 폐기 실패", zap.Error(err), zap.String("user_id", userID))
+	}
+
+	deletion, err := uc.accountDeletionService.CleanupAccountData(ctx, userID)
+	if err != nil {
+		requestid.Logger(ctx, uc.log).Warn("adc.DeleteAccount() 데이터 정리 실패, 재시도 예정", zap.Error(err), zap.String("user_id", userID))
+		pending.Status = domain.AccountDeletionStatusConfirmed
+		return pending, nil
+	}
+
+	return deletion, nil
+}
+
+func (uc *accountDeletionUseCase) GetAccountDeletion(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	return uc.accountDeletionService.GetAccountDeletion(ctx, userID)
+}
+
+func (uc *accountDeletionUseCase) GetAccountDeletionList(ctx context.Context, status domain.AccountDeletionStatus) ([]*domain.AccountDeletion, error) {
+	return uc.accountDeletionService.GetAccountDeletionList(ctx, status)
+}
+
+// RetryAccountDeletion 탈퇴가 확인된 작업만 재시도하며, 그 외 상태는 그대로 반환합니다.
+func (uc *accountDeletionUseCase) RetryAccountDeletion(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	deletion, err := uc.accountDeletionService.GetAccountDeletion(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if deletion.Status != domain.AccountDeletionStatusConfirmed {
+		return deletion, nil
+	}
+
+	return uc.accountDeletionService.CleanupAccountData(ctx, userID)
+}
+
+func NewAccountDeletionUseCase(logger *zap.Logger, accountDeletionService domain.AccountDeletionService, tokenService domain.TokenRevocationService, userUseCase domain.UserUseCase) domain.AccountDeletionUseCase {
+	return &accountDeletionUseCase{
+		accountDeletionService: accountDeletionService,
+		tokenService:           tokenService,
+		userUseCase:            userUseCase,
+		log:                    logger,
+	}
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+type adminUseCase struct {
+	suspensionService domain.UserSuspensionService
+	tokenService      domain.TokenRevocationService
+	userUseCase       domain.UserUseCase
+	emailUseCase      domain.EmailUseCase
+	deviceService     domain.DeviceService
+	log               *zap.Logger
+}
+
+func (uc *adminUseCase) GetUser(ctx context.Context, userID string) (*domain.AdminUser, error) {
+	user, err := uc.userUseCase.GetUserInfoByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.adminUser(ctx, user)
+}
+
+func (uc *adminUseCase) GetUserByEmail(ctx context.Context, email string) (*domain.AdminUser, error) {
+	user, err := uc.userUseCase.GetUserInfoByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.adminUser(ctx, user)
+}
+
+func (uc *adminUseCase) adminUser(ctx context.Context, user *domain.User) (*domain.AdminUser, error) {
+	u := &domain.AdminUser{
+		ID:    user.ID,
+		Name:  user.Name,
+		Email: user.Email,
+		Role:  user.Role,
+	}
+
+	suspension, err := uc.suspensionService.GetUserSuspension(ctx, user.ID)
+	switch {
+	case err == nil:
+		u.Suspension = suspension
+	case !errors.Is(err, domain.ErrNotFound):
+		return nil, err
+	}
+
+	if uc.emailUseCase != nil {
+		verified, err := uc.emailUseCase.IsEmailVerified(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		u.EmailVerified = &verified
+	}
+
+	return u, nil
+}
+
+// SuspendUser
+//
+// 정지 즉시 사용중인 토큰을 사용할 수 없도록 사용자의 모든 세션을 폐기합니다.
+func (uc *adminUseCase) SuspendUser(ctx context.Context, userID string, reason string, adminID string) error {
+	if _, err := uc.userUseCase.GetUserInfoByUserID(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := uc.suspensionService.CreateUserSuspension(ctx, &domain.UserSuspension{
+		UserID:      userID,
+		Reason:      reason,
+		SuspendedBy: adminID,
+	}); err != nil {
+		return err
+	}
+
+	if err := uc.tokenService.RevokeUserTokens(ctx, userID); err != nil {
+		requestid.Logger(ctx, uc.log).Error("계정 정지 후 세션 폐기 실패", zap.Error(err), zap.String("user_id", userID))
+		return err
+	}
+
+	requestid.Logger(ctx, uc.log).Info("계정 정지", zap.String("user_id", userID), zap.String("admin_id", adminID))
+	return nil
+}
+
+func (uc *adminUseCase) ReactivateUser(ctx context.Context, userID string) error {
+	if err := uc.suspensionService.DeleteUserSuspension(ctx, userID); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx, uc.log).Info("계정 정지 해제", zap.String("user_id", userID))
+	return nil
+}
+
+func (uc *adminUseCase) GetSuspendedUserList(ctx context.Context) ([]*domain.UserSuspension, error) {
+	return uc.suspensionService.GetUserSuspensionList(ctx)
+}
+
+// GetUserDeviceListWithPage
+//
+// 탈퇴한 사용자와 구분할 수 있도록 사용자 서버에서 사용자를 먼저 확인합니다.
+func (uc *adminUseCase) GetUserDeviceListWithPage(ctx context.Context, userID string, page domain.Page) ([]*domain.DeviceInfo, int, error) {
+	if _, err := uc.userUseCase.GetUserInfoByUserID(ctx, userID); err != nil {
+		return nil, 0, err
+	}
+
+	return uc.deviceService.GetDeviceListByUserIDWithPage(ctx, userID, page)
+}
+
+// NewAdminUseCase emailUseCase 가 nil 이면 이메일 인증 여부를 조회하지 않습니다.
+func NewAdminUseCase(logger *zap.Logger, suspensionService domain.UserSuspensionService, tokenService domain.TokenRevocationService, userUseCase domain.UserUseCase, emailUseCase domain.EmailUseCase, deviceService domain.DeviceService) domain.AdminUseCase {
+	return &adminUseCase{
+		suspensionService: suspensionService,
+		tokenService:      tokenService,
+		userUseCase:       userUseCase,
+		emailUseCase:      emailUseCase,
+		deviceService:     deviceService,
+		log:               logger,
+	}
+}
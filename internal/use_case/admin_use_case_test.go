@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// fakeAdminDeviceService 사용자 ID 별 장치 목록을 응답합니다.
+type fakeAdminDeviceService struct {
+	domain.DeviceService
+	devices map[string][]*domain.DeviceInfo
+}
+
+func (s *fakeAdminDeviceService) GetDeviceListByUserIDWithPage(_ context.Context, userID string, _ domain.Page) ([]*domain.DeviceInfo, int, error) {
+	return s.devices[userID], len(s.devices[userID]), nil
+}
+
+func TestGetUserDeviceListWithPage(t *testing.T) {
+	devices := &fakeAdminDeviceService{devices: map[string][]*domain.DeviceInfo{
+		"user":    {{UserID: "user", ID: "device"}},
+		"deleted": {{UserID: "deleted", ID: "orphan"}},
+	}}
+	users := &fakeResetUserUseCase{user: &domain.User{ID: "user", Email: "a@example.com"}}
+	uc := NewAdminUseCase(zap.NewNop(), nil, nil, users, nil, devices)
+
+	deviceList, total, err := uc.GetUserDeviceListWithPage(context.Background(), "user", domain.Page{Page: 1, Size: 20})
+	if err != nil || total != 1 || len(deviceList) != 1 || deviceList[0].ID != "device" {
+		t.Errorf("GetUserDeviceListWithPage() = %v, %d, %v", deviceList, total, err)
+	}
+
+	// 사용자 서버에 없는 사용자는 장치가 남아있어도 조회하지 않는다.
+	if _, _, err := uc.GetUserDeviceListWithPage(context.Background(), "deleted", domain.Page{Page: 1, Size: 20}); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("GetUserDeviceListWithPage() error = %v, want %v", err, domain.ErrNotFound)
+	}
+}
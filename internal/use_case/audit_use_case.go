@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+type auditUseCase struct {
+	log *zap.Logger
+	svc domain.AuditService
+}
+
+func (uc *auditUseCase) CreateAuditEvent(ctx context.Context, event *domain.AuditEvent) error {
+	return uc.svc.CreateAuditEvent(ctx, event)
+}
+
+func (uc *auditUseCase) GetAuditEventListByFilterWithPage(ctx context.Context, filter *domain.AuditFilter, page domain.Page) ([]*domain.AuditEvent, int, error) {
+	return uc.svc.GetAuditEventListByFilterWithPage(ctx, filter, page)
+}
+
+func NewAuditUseCase(log *zap.Logger, svc domain.AuditService) domain.AuditUseCase {
+	return &auditUseCase{
+		log: log,
+		svc: svc,
+	}
+}
@@ -2,43 +2,246 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
 	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
 	"go.uber.org/zap"
 )
 
 type authUseCase struct {
-	authService domain.AuthService
-	log         *zap.Logger
+	authService       domain.AuthService
+	lockoutService    domain.LockoutService
+	tokenService      domain.TokenRevocationService
+	suspensionService domain.UserSuspensionService
+	sessionService    domain.SessionService
+	twoFactorService  domain.TwoFactorService
+	log               *zap.Logger
 }
 
+// Login
+// 이메일, IP 별 실패 횟수에 따라 로그인을 지연하거나 잠급니다.
+// IP 는 WithGrpcMeta 미들웨어가 context 에 설정한 client_ip 를 사용한다.
+// 정지된 계정은 발급된 토큰을 폐기하고 ErrUserSuspended 를 반환합니다.
+// 발급된 토큰은 로그인 세션으로 기록합니다.
+// 2단계 인증을 사용하는 사용자는 토큰 대신 *domain.TwoFactorRequiredError 를 반환하며,
+// 발급된 토큰은 CompleteTwoFactorSignIn 으로 인증 코드를 확인한 이후에 전달합니다.
 func (uc *authUseCase) Login(ctx context.Context, email string, password string) (*domain.Token, error) {
+	clientIP, _ := ctx.Value("client_ip").(string)
+
+	if err := uc.lockoutService.CheckSignIn(ctx, email, clientIP); err != nil {
+		requestid.Logger(ctx, uc.log).Info("auc.Login() 잠김", zap.Error(err),
+			zap.String("email", email),
+			zap.String("client_ip", clientIP),
+		)
+		return nil, err
+	}
+
 	token, err := uc.authService.Login(ctx, email, password)
 	if err != nil {
-		uc.log.Debug("auc.Login() 실패", zap.Error(err),
+		requestid.Logger(ctx, uc.log).Debug("auc.Login() 실패", zap.Error(err),
+			zap.String("email", email),
+		)
+		// 인증 서버 연결 오류는 실패 횟수에 포함하지 않는다.
+		if !errors.Is(err, domain.ErrUnavailable) {
+			if recordErr := uc.lockoutService.RecordSignInFailure(ctx, email, clientIP); recordErr != nil {
+				requestid.Logger(ctx, uc.log).Warn("auc.Login() 실패 기록 오류", zap.Error(recordErr))
+			}
+		}
+		return nil, err
+	}
+
+	if err := uc.lockoutService.RecordSignInSuccess(ctx, email); err != nil {
+		requestid.Logger(ctx, uc.log).Warn("auc.Login() 실패 기록 초기화 오류", zap.Error(err))
+	}
+
+	// 인증 서버는 정지 여부를 알지 못하므로 발급된 토큰으로 사용자를 확인한다.
+	user, err := uc.authService.Validate(ctx, token.AccessToken)
+	if err == nil {
+		err = uc.suspensionService.CheckUser(ctx, user.ID)
+	}
+	var challenge *domain.TwoFactorChallenge
+	if err == nil && uc.twoFactorService != nil {
+		var enabled bool
+		enabled, err = uc.twoFactorService.IsEnabled(ctx, user.ID)
+		if err == nil && enabled {
+			// 2단계 인증이 잠긴 동안에는 challenge 를 새로 발급하지 않는다.
+			err = uc.lockoutService.CheckTwoFactor(ctx, user.ID)
+		}
+		if err == nil && enabled {
+			challenge, err = uc.twoFactorService.IssueChallenge(ctx, user.ID, token)
+		}
+	}
+	if err == nil && challenge == nil {
+		err = uc.sessionService.CreateSession(ctx, user.ID, token)
+	}
+	if err != nil {
+		requestid.Logger(ctx, uc.log).Info("auc.Login() 정지 확인, 세션 생성 실패", zap.Error(err),
 			zap.String("email", email),
 		)
+		uc.revokeIssuedToken(ctx, "auc.Login()", token)
 		return nil, err
 	}
+
+	if challenge != nil {
+		requestid.Logger(ctx, uc.log).Debug("auc.Login() 2단계 인증 필요", zap.String("user_id", user.ID))
+		return nil, &domain.TwoFactorRequiredError{Challenge: challenge}
+	}
+
 	return token, nil
 }
 
+// CompleteTwoFactorSignIn
+// 인증 코드가 맞으면 challenge 에 보관된 토큰을 로그인 세션으로 기록하고 반환합니다.
+// 시도 횟수를 초과하거나 challenge 발급 이후 계정이 정지된 경우 보관된 토큰을 폐기합니다.
+// 틀린 인증 코드는 challenge 와 관계없이 사용자별로 기록하며, 잠긴 동안에는 코드를 확인하지 않고 *domain.SignInLockedError 를 반환합니다.
+func (uc *authUseCase) CompleteTwoFactorSignIn(ctx context.Context, challengeToken string, code string) (*domain.Token, error) {
+	if uc.twoFactorService == nil {
+		return nil, domain.ErrTwoFactorChallengeInvalid
+	}
+
+	userID, err := uc.twoFactorService.GetChallengeUserID(ctx, challengeToken)
+	if err == nil {
+		err = uc.lockoutService.CheckTwoFactor(ctx, userID)
+	}
+	if err != nil {
+		requestid.Logger(ctx, uc.log).Info("auc.CompleteTwoFactorSignIn() 실패", zap.Error(err), zap.String("user_id", userID))
+		return nil, err
+	}
+
+	challenge, err := uc.twoFactorService.VerifyChallenge(ctx, challengeToken, code)
+	if err != nil {
+		requestid.Logger(ctx, uc.log).Info("auc.CompleteTwoFactorSignIn() 실패", zap.Error(err), zap.String("user_id", userID))
+		if errors.Is(err, domain.ErrTwoFactorInvalidCode) {
+			if recordErr := uc.lockoutService.RecordTwoFactorFailure(ctx, userID); recordErr != nil {
+				requestid.Logger(ctx, uc.log).Warn("auc.CompleteTwoFactorSignIn() 실패 기록 오류", zap.Error(recordErr))
+			}
+		}
+		if challenge != nil {
+			uc.revokeIssuedToken(ctx, "auc.CompleteTwoFactorSignIn()", challenge.Token)
+		}
+		return nil, err
+	}
+
+	if err := uc.lockoutService.RecordTwoFactorSuccess(ctx, userID); err != nil {
+		requestid.Logger(ctx, uc.log).Warn("auc.CompleteTwoFactorSignIn() 실패 기록 초기화 오류", zap.Error(err))
+	}
+
+	err = uc.suspensionService.CheckUser(ctx, challenge.UserID)
+	if err == nil {
+		err = uc.sessionService.CreateSession(ctx, challenge.UserID, challenge.Token)
+	}
+	if err != nil {
+		requestid.Logger(ctx, uc.log).Info("auc.CompleteTwoFactorSignIn() 정지 확인, 세션 생성 실패", zap.Error(err),
+			zap.String("user_id", challenge.UserID),
+		)
+		uc.revokeIssuedToken(ctx, "auc.CompleteTwoFactorSignIn()", challenge.Token)
+		return nil, err
+	}
+
+	return challenge.Token, nil
+}
+
+// revokeIssuedToken 로그인 중 발급되었지만 전달하지 않는 토큰을 폐기합니다. 실패는 기록만 한다.
+func (uc *authUseCase) revokeIssuedToken(ctx context.Context, method string, token *domain.Token) {
+	if revokeErr := uc.tokenService.RevokeToken(ctx, domain.TokenKindRefresh, token.RefreshToken, ""); revokeErr != nil {
+		requestid.Logger(ctx, uc.log).Warn(method+" 발급 토큰 폐기 실패", zap.Error(revokeErr))
+	}
+	if logoutErr := uc.authService.Logout(ctx, token.AccessToken); logoutErr != nil {
+		requestid.Logger(ctx, uc.log).Warn(method+" 발급 토큰 폐기 실패", zap.Error(logoutErr))
+	}
+}
+
+// RefreshToken
+// 폐기된 refresh token 이나 폐기된 세션, 전체 세션 폐기 이전에 발급된 refresh token 으로는 재발급 할 수 없다.
+// 재발급된 토큰은 같은 세션으로 기록합니다.
 func (uc *authUseCase) RefreshToken(ctx context.Context, refreshToken string) (*domain.Token, error) {
+	err := uc.tokenService.CheckToken(ctx, refreshToken, "")
+	if err == nil {
+		err = uc.sessionService.CheckSession(ctx, refreshToken)
+	}
+	if err != nil {
+		requestid.Logger(ctx, uc.log).Info("auc.RefreshToken() 폐기된 토큰", zap.Error(err))
+		return nil, err
+	}
+
 	token, err := uc.authService.RefreshToken(ctx, refreshToken)
 	if err != nil {
-		uc.log.Debug("auc.RefreshToken() 실패", zap.Error(err))
+		requestid.Logger(ctx, uc.log).Debug("auc.RefreshToken() 실패", zap.Error(err))
+		return nil, err
+	}
+
+	// refresh token 만으로는 사용자를 알 수 없으므로 새로 발급된 access token 으로 확인한다.
+	user, err := uc.authService.Validate(ctx, token.AccessToken)
+	if err == nil {
+		err = uc.tokenService.CheckToken(ctx, refreshToken, user.ID)
+	}
+	if err == nil {
+		err = uc.suspensionService.CheckUser(ctx, user.ID)
+	}
+	if err == nil {
+		err = uc.sessionService.RefreshSession(ctx, user.ID, refreshToken, token)
+	}
+	if err != nil {
+		requestid.Logger(ctx, uc.log).Info("auc.RefreshToken() 폐기된 세션", zap.Error(err))
+		if logoutErr := uc.authService.Logout(ctx, token.AccessToken); logoutErr != nil {
+			requestid.Logger(ctx, uc.log).Warn("auc.RefreshToken() 재발급 토큰 폐기 실패", zap.Error(logoutErr))
+		}
 		return nil, err
 	}
+
 	return token, nil
 }
 
+// Logout
+// 인증 서버의 로그아웃과 별개로 access token 을 폐기 목록에 추가하여 즉시 사용할 수 없게 한다.
+// 폐기 목록에 추가된 이후의 인증 서버 오류는 기록만 한다.
 func (uc *authUseCase) Logout(ctx context.Context, accessToken string) error {
+	userID, _ := ctx.Value("user_id").(string)
+	if err := uc.tokenService.RevokeToken(ctx, domain.TokenKindAccess, accessToken, userID); err != nil {
+		requestid.Logger(ctx, uc.log).Error("auc.Logout() 토큰 폐기 실패", zap.Error(err))
+		return err
+	}
+
 	err := uc.authService.Logout(ctx, accessToken)
 	if err != nil {
-		uc.log.Debug("auc.Logout() 실패", zap.Error(err))
+		requestid.Logger(ctx, uc.log).Warn("auc.Logout() 실패", zap.Error(err))
+	}
+
+	return nil
+}
+
+func (uc *authUseCase) SignOut(ctx context.Context, userID string, accessToken string, refreshToken string, allSessions bool) error {
+	// 다른 사용자의 refresh token 은 폐기하지 않는다.
+	if refreshToken != "" {
+		if err := uc.sessionService.CheckTokenOwner(ctx, userID, refreshToken); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.Logout(ctx, accessToken); err != nil {
+		return err
+	}
+
+	if err := uc.sessionService.RevokeSessionByToken(ctx, userID, accessToken); err != nil {
+		requestid.Logger(ctx, uc.log).Error("auc.SignOut() 세션 폐기 실패", zap.Error(err))
 		return err
 	}
 
+	if refreshToken != "" {
+		if err := uc.tokenService.RevokeToken(ctx, domain.TokenKindRefresh, refreshToken, userID); err != nil {
+			requestid.Logger(ctx, uc.log).Error("auc.SignOut() refresh token 폐기 실패", zap.Error(err))
+			return err
+		}
+	}
+
+	if allSessions {
+		if err := uc.tokenService.RevokeUserTokens(ctx, userID); err != nil {
+			requestid.Logger(ctx, uc.log).Error("auc.SignOut() 전체 세션 폐기 실패", zap.Error(err))
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -49,20 +252,47 @@ func (uc *authUseCase) Validate(ctx context.Context, accessToken string) (*domai
 	// Validate로는 유저의 ID만 존재한다.
 	user, err := uc.authService.Validate(ctx, accessToken)
 	if err != nil {
-		uc.log.Debug("auc.Validate() 실패", zap.Error(err),
+		requestid.Logger(ctx, uc.log).Debug("auc.Validate() 실패", zap.Error(err),
 			zap.String("msg", "accessToken이 유효하지 않습니다."),
 		)
 		return nil, err
 	}
 
+	if err := uc.tokenService.CheckToken(ctx, accessToken, user.ID); err != nil {
+		requestid.Logger(ctx, uc.log).Info("auc.Validate() 폐기된 토큰", zap.Error(err),
+			zap.String("user_id", user.ID),
+		)
+		return nil, err
+	}
+
+	if err := uc.sessionService.CheckSession(ctx, accessToken); err != nil {
+		requestid.Logger(ctx, uc.log).Info("auc.Validate() 폐기된 세션", zap.Error(err),
+			zap.String("user_id", user.ID),
+		)
+		return nil, err
+	}
+
 	// user 정보를 채워야 하는지는 확인 필요
 
 	return user, nil
 }
 
-func NewAuthService(logger *zap.Logger, authService domain.AuthService) domain.AuthUseCase {
+func (uc *authUseCase) GetSignInLockoutList(ctx context.Context) ([]*domain.SignInFailure, error) {
+	return uc.lockoutService.GetSignInLockoutList(ctx)
+}
+
+func (uc *authUseCase) ClearSignInLockout(ctx context.Context, kind domain.SignInFailureKind, subject string) error {
+	return uc.lockoutService.ClearSignInLockout(ctx, kind, subject)
+}
+
+func NewAuthService(logger *zap.Logger, authService domain.AuthService, lockoutService domain.LockoutService, tokenService domain.TokenRevocationService, suspensionService domain.UserSuspensionService, sessionService domain.SessionService, twoFactorService domain.TwoFactorService) domain.AuthUseCase {
 	return &authUseCase{
-		authService: authService,
-		log:         logger,
+		authService:       authService,
+		lockoutService:    lockoutService,
+		tokenService:      tokenService,
+		suspensionService: suspensionService,
+		sessionService:    sessionService,
+		twoFactorService:  twoFactorService,
+		log:               logger,
 	}
 }
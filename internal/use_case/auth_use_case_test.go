@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// fakeTwoFactorLockoutService 사용자별 2단계 인증 실패가 maxFailures 에 도달하면 잠급니다.
+type fakeTwoFactorLockoutService struct {
+	domain.LockoutService
+	maxFailures int
+	failures    map[string]int
+}
+
+func (s *fakeTwoFactorLockoutService) CheckTwoFactor(_ context.Context, userID string) error {
+	if s.failures[userID] >= s.maxFailures {
+		return &domain.SignInLockedError{RetryAfter: time.Minute}
+	}
+	return nil
+}
+
+func (s *fakeTwoFactorLockoutService) RecordTwoFactorFailure(_ context.Context, userID string) error {
+	s.failures[userID]++
+	return nil
+}
+
+func (s *fakeTwoFactorLockoutService) RecordTwoFactorSuccess(_ context.Context, userID string) error {
+	delete(s.failures, userID)
+	return nil
+}
+
+// fakeChallengeService 모든 challenge 가 user 의 것이고 "123456" 만 올바른 인증 코드 입니다.
+type fakeChallengeService struct {
+	domain.TwoFactorService
+	verified int
+}
+
+func (s *fakeChallengeService) GetChallengeUserID(context.Context, string) (string, error) {
+	return "user", nil
+}
+
+func (s *fakeChallengeService) VerifyChallenge(_ context.Context, _ string, code string) (*domain.TwoFactorChallenge, error) {
+	s.verified++
+	if code != "123456" {
+		return nil, domain.ErrTwoFactorInvalidCode
+	}
+	return &domain.TwoFactorChallenge{UserID: "user", Token: &domain.Token{AccessToken: "access"}}, nil
+}
+
+type fakeAuthSuspensionService struct {
+	domain.UserSuspensionService
+}
+
+func (s *fakeAuthSuspensionService) CheckUser(context.Context, string) error {
+	return nil
+}
+
+type fakeAuthSessionService struct {
+	domain.SessionService
+}
+
+func (s *fakeAuthSessionService) CreateSession(context.Context, string, *domain.Token) error {
+	return nil
+}
+
+func TestCompleteTwoFactorSignInLockout(t *testing.T) {
+	ctx := context.Background()
+	lockout := &fakeTwoFactorLockoutService{maxFailures: 3, failures: make(map[string]int)}
+	twoFactor := &fakeChallengeService{}
+	uc := NewAuthService(zap.NewNop(), nil, lockout, nil, &fakeAuthSuspensionService{}, &fakeAuthSessionService{}, twoFactor)
+
+	// 로그인을 반복해서 challenge 를 새로 받아도 실패 횟수는 사용자별로 누적된다.
+	for _, challenge := range []string{"a", "b", "c"} {
+		if _, err := uc.CompleteTwoFactorSignIn(ctx, challenge, "000000"); !errors.Is(err, domain.ErrTwoFactorInvalidCode) {
+			t.Fatalf("CompleteTwoFactorSignIn(%q) error = %v, want %v", challenge, err, domain.ErrTwoFactorInvalidCode)
+		}
+	}
+
+	// 잠긴 동안에는 올바른 코드도 확인하지 않는다.
+	_, err := uc.CompleteTwoFactorSignIn(ctx, "d", "123456")
+	var locked *domain.SignInLockedError
+	if !errors.As(err, &locked) {
+		t.Fatalf("CompleteTwoFactorSignIn() error = %v, want *SignInLockedError", err)
+	}
+	if twoFactor.verified != 3 {
+		t.Errorf("인증 코드 확인 = %d, want 3", twoFactor.verified)
+	}
+
+	// 잠금이 풀린 뒤 성공하면 실패 기록을 초기화한다.
+	lockout.failures["user"] = 2
+	token, err := uc.CompleteTwoFactorSignIn(ctx, "e", "123456")
+	if err != nil || token.AccessToken != "access" {
+		t.Fatalf("CompleteTwoFactorSignIn() = %v, %v", token, err)
+	}
+	if lockout.failures["user"] != 0 {
+		t.Errorf("성공 후 실패 횟수 = %d, want 0", lockout.failures["user"])
+	}
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+type deviceUseCase struct {
+	log           *zap.Logger
+	deviceService domain.DeviceService
+}
+
+func (uc *deviceUseCase) CreateDevice(ctx context.Context, in *domain.DeviceInfo) (*domain.DeviceInfo, error) {
+	d, err := uc.deviceService.CreateDevice(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	requestid.Logger(ctx, uc.log).Info("장치 등록", zap.String("user_id", d.UserID), zap.String("device_id", d.ID))
+	return d, nil
+}
+
+func (uc *deviceUseCase) GetDevice(ctx context.Context, userID string, deviceID string) (*domain.DeviceInfo, error) {
+	return uc.deviceService.GetDevice(ctx, userID, deviceID)
+}
+
+func (uc *deviceUseCase) GetDeviceListByUserIDWithPage(ctx context.Context, userID string, page domain.Page) ([]*domain.DeviceInfo, int, error) {
+	return uc.deviceService.GetDeviceListByUserIDWithPage(ctx, userID, page)
+}
+
+func (uc *deviceUseCase) UpdateDevice(ctx context.Context, in *domain.DeviceInfo) (*domain.DeviceInfo, error) {
+	return uc.deviceService.UpdateDevice(ctx, in)
+}
+
+func (uc *deviceUseCase) DeleteDevice(ctx context.Context, userID string, deviceID string) error {
+	if err := uc.deviceService.DeleteDevice(ctx, userID, deviceID); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx, uc.log).Info("장치 삭제", zap.String("user_id", userID), zap.String("device_id", deviceID))
+	return nil
+}
+
+func (uc *deviceUseCase) GetDeviceRequestSchemaList(ctx context.Context, userID string, deviceID string) ([]*domain.DeviceRequestSchema, error) {
+	return uc.deviceService.GetDeviceRequestSchemaList(ctx, userID, deviceID)
+}
+
+func (uc *deviceUseCase) PutDeviceRequestSchemaList(ctx context.Context, userID string, deviceID string, schemaList []*domain.DeviceRequestSchema) ([]*domain.DeviceRequestSchema, error) {
+	return uc.deviceService.PutDeviceRequestSchemaList(ctx, userID, deviceID, schemaList)
+}
+
+func NewDeviceUseCase(logger *zap.Logger, deviceService domain.DeviceService) domain.DeviceUseCase {
+	return &deviceUseCase{
+		log:           logger,
+		deviceService: deviceService,
+	}
+}
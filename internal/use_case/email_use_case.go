@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+type emailUseCase struct {
+	svc          domain.EmailService
+	userUseCase  domain.UserUseCase
+	tokenService domain.TokenRevocationService
+	oauthService domain.OAuthService
+	log          *zap.Logger
+}
+
+func (uc *emailUseCase) SendEmailVerification(ctx context.Context, userID string) error {
+	user, err := uc.userUseCase.GetUserInfoByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	verified, err := uc.svc.IsEmailVerified(ctx, user.ID, user.Email)
+	if err != nil {
+		return err
+	}
+	if verified {
+		return domain.ErrEmailAlreadyVerified
+	}
+
+	token, err := uc.svc.IssueToken(ctx, domain.EmailTokenPurposeVerifyEmail, user.ID, user.Email)
+	if err != nil {
+		return err
+	}
+
+	return uc.svc.SendVerifyEmail(ctx, user.Email, token)
+}
+
+// VerifyEmail
+//
+// 토큰 발급 이후 이메일이 변경된 경우 인증하지 않습니다.
+func (uc *emailUseCase) VerifyEmail(ctx context.Context, token string) (string, error) {
+	t, err := uc.svc.ConsumeToken(ctx, domain.EmailTokenPurposeVerifyEmail, token)
+	if err != nil {
+		return "", err
+	}
+
+	user, err := uc.userUseCase.GetUserInfoByUserID(ctx, t.UserID)
+	if err != nil {
+		return "", err
+	}
+	if !strings.EqualFold(user.Email, t.Email) {
+		return "", domain.ErrEmailTokenInvalid
+	}
+
+	if err := uc.svc.SetEmailVerified(ctx, user.ID, user.Email); err != nil {
+		return "", err
+	}
+
+	return user.ID, nil
+}
+
+func (uc *emailUseCase) IsEmailVerified(ctx context.Context, userID string) (bool, error) {
+	user, err := uc.userUseCase.GetUserInfoByUserID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return uc.svc.IsEmailVerified(ctx, user.ID, user.Email)
+}
+
+func (uc *emailUseCase) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := uc.userUseCase.GetUserInfoByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			requestid.Logger(ctx, uc.log).Info("비밀번호 재설정 요청, 가입되지 않은 이메일", zap.String("email", email))
+			return nil
+		}
+		return err
+	}
+
+	token, err := uc.svc.IssueToken(ctx, domain.EmailTokenPurposeResetPassword, user.ID, user.Email)
+	if err != nil {
+		return err
+	}
+
+	return uc.svc.SendResetPassword(ctx, user.Email, token)
+}
+
+// ResetPassword
+//
+// 메일을 받은 것으로 이메일 소유가 확인되므로 이메일도 인증 처리합니다.
+// 비밀번호 변경은 사용자 서버에서 처리하므로 하나의 트랜잭션으로 묶을 수 없어, 변경에 실패하면 같은 링크로 다시 시도할 수 있도록
+// 변경에 성공한 이후 토큰을 사용 처리합니다.
+func (uc *emailUseCase) ResetPassword(ctx context.Context, token string, password string) (string, error) {
+	t, err := uc.svc.CheckToken(ctx, domain.EmailTokenPurposeResetPassword, token)
+	if err != nil {
+		return "", err
+	}
+
+	user, err := uc.userUseCase.GetUserInfoByUserID(ctx, t.UserID)
+	if err != nil {
+		return "", err
+	}
+	if !strings.EqualFold(user.Email, t.Email) {
+		return "", domain.ErrEmailTokenInvalid
+	}
+
+	if _, err := uc.userUseCase.UpdateUser(ctx, &domain.User{
+		ID:       user.ID,
+		Password: password,
+	}); err != nil {
+		return "", err
+	}
+
+	// 동시에 같은 토큰으로 재설정한 경우에도 비밀번호는 변경되었으므로 기록만 한다.
+	if _, err := uc.svc.ConsumeToken(ctx, domain.EmailTokenPurposeResetPassword, token); err != nil {
+		requestid.Logger(ctx, uc.log).Warn("비밀번호 재설정 토큰 사용 처리 실패", zap.Error(err), zap.String("user_id", user.ID))
+	}
+
+	// 소셜 전용 계정은 저장된 비밀번호로 로그인하므로 함께 변경한다.
+	if uc.oauthService != nil {
+		if err := uc.oauthService.UpdateCredential(ctx, user.ID, password); err != nil {
+			requestid.Logger(ctx, uc.log).Error("비밀번호 재설정 후 소셜 계정 비밀번호 변경 실패", zap.Error(err), zap.String("user_id", user.ID))
+		}
+	}
+
+	// 비밀번호가 유출되었을 수 있으므로 기존 세션을 모두 폐기한다.
+	if err := uc.tokenService.RevokeUserTokens(ctx, user.ID); err != nil {
+		requestid.Logger(ctx, uc.log).Error("비밀번호 재설정 후 세션 폐기 실패", zap.Error(err), zap.String("user_id", user.ID))
+	}
+	if err := uc.svc.SetEmailVerified(ctx, user.ID, user.Email); err != nil {
+		requestid.Logger(ctx, uc.log).Warn("비밀번호 재설정 후 이메일 인증 실패", zap.Error(err), zap.String("user_id", user.ID))
+	}
+
+	return user.ID, nil
+}
+
+// NewEmailUseCase oauthService 가 nil 이면 비밀번호 재설정시 소셜 전용 계정의 비밀번호를 변경하지 않습니다.
+func NewEmailUseCase(logger *zap.Logger, svc domain.EmailService, userUseCase domain.UserUseCase, tokenService domain.TokenRevocationService, oauthService domain.OAuthService) domain.EmailUseCase {
+	return &emailUseCase{
+		svc:          svc,
+		userUseCase:  userUseCase,
+		tokenService: tokenService,
+		oauthService: oauthService,
+		log:          logger,
+	}
+}
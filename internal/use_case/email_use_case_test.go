@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/job"
+	"github.com/GDH-Project/api/internal/service"
+	"go.uber.org/zap"
+)
+
+// fakeEmailRepository auth.email_token, auth.email_verification 을 메모리에 저장합니다.
+type fakeEmailRepository struct {
+	tokens   map[string]*domain.EmailToken
+	used     map[string]bool
+	verified map[string]string
+}
+
+func newFakeEmailRepository() *fakeEmailRepository {
+	return &fakeEmailRepository{
+		tokens:   make(map[string]*domain.EmailToken),
+		used:     make(map[string]bool),
+		verified: make(map[string]string),
+	}
+}
+
+func (r *fakeEmailRepository) CreateEmailToken(_ context.Context, token *domain.EmailToken) error {
+	r.tokens[token.TokenHash] = token
+	return nil
+}
+
+func (r *fakeEmailRepository) GetEmailToken(_ context.Context, tokenHash string, purpose domain.EmailTokenPurpose) (*domain.EmailToken, error) {
+	t, ok := r.tokens[tokenHash]
+	if !ok || r.used[tokenHash] || t.Purpose != purpose || time.Now().After(t.ExpiresAt) {
+		return nil, domain.ErrNotFound
+	}
+	return t, nil
+}
+
+func (r *fakeEmailRepository) UseEmailToken(ctx context.Context, tokenHash string, purpose domain.EmailTokenPurpose) (*domain.EmailToken, error) {
+	t, err := r.GetEmailToken(ctx, tokenHash, purpose)
+	if err != nil {
+		return nil, err
+	}
+	r.used[tokenHash] = true
+	return t, nil
+}
+
+func (r *fakeEmailRepository) DeleteUnusedEmailToken(_ context.Context, userID string, purpose domain.EmailTokenPurpose) error {
+	for hash, t := range r.tokens {
+		if t.UserID == userID && t.Purpose == purpose && !r.used[hash] {
+			delete(r.tokens, hash)
+		}
+	}
+	return nil
+}
+
+func (r *fakeEmailRepository) DeleteExpiredEmailToken(context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (r *fakeEmailRepository) SetEmailVerified(_ context.Context, userID string, email string) error {
+	r.verified[userID] = email
+	return nil
+}
+
+func (r *fakeEmailRepository) GetVerifiedEmail(_ context.Context, userID string) (string, error) {
+	email, ok := r.verified[userID]
+	if !ok {
+		return "", domain.ErrNotFound
+	}
+	return email, nil
+}
+
+// fakeMailer 발송한 메일을 보관합니다.
+type fakeMailer struct {
+	sent []*domain.Mail
+}
+
+func (m *fakeMailer) Send(_ context.Context, mail *domain.Mail) error {
+	m.sent = append(m.sent, mail)
+	return nil
+}
+
+// fakeResetUserUseCase failUpdate 가 true 이면 비밀번호 변경에 실패합니다.
+type fakeResetUserUseCase struct {
+	domain.UserUseCase
+	user       *domain.User
+	failUpdate bool
+	passwords  []string
+}
+
+func (u *fakeResetUserUseCase) GetUserInfoByEmail(_ context.Context, email string) (*domain.User, error) {
+	if email != u.user.Email {
+		return nil, domain.ErrNotFound
+	}
+	return u.user, nil
+}
+
+func (u *fakeResetUserUseCase) GetUserInfoByUserID(_ context.Context, id string) (*domain.User, error) {
+	if id != u.user.ID {
+		return nil, domain.ErrNotFound
+	}
+	return u.user, nil
+}
+
+func (u *fakeResetUserUseCase) UpdateUser(_ context.Context, user *domain.User) (*domain.User, error) {
+	if u.failUpdate {
+		return nil, domain.ErrUnavailable
+	}
+	u.passwords = append(u.passwords, user.Password)
+	return u.user, nil
+}
+
+type fakeResetTokenService struct {
+	domain.TokenRevocationService
+	revoked []string
+}
+
+func (s *fakeResetTokenService) RevokeUserTokens(_ context.Context, userID string) error {
+	s.revoked = append(s.revoked, userID)
+	return nil
+}
+
+type fakeResetOAuthService struct {
+	domain.OAuthService
+	credentials map[string]string
+}
+
+func (s *fakeResetOAuthService) UpdateCredential(_ context.Context, userID string, password string) error {
+	s.credentials[userID] = password
+	return nil
+}
+
+var resetTokenPattern = regexp.MustCompile(`https://example\.com/reset\S+`)
+
+func TestResetPassword(t *testing.T) {
+	ctx := context.Background()
+
+	repo := newFakeEmailRepository()
+	mailer := &fakeMailer{}
+	emailService := service.NewEmailService(zap.NewNop(), repo, mailer, domain.EmailPolicy{
+		TokenSecret:      []byte("secret"),
+		VerifyURL:        "https://example.com/verify",
+		ResetPasswordURL: "https://example.com/reset",
+		VerifyTokenTTL:   time.Hour,
+		ResetTokenTTL:    time.Hour,
+	}, job.NewRunner(zap.NewNop()))
+
+	users := &fakeResetUserUseCase{user: &domain.User{ID: "user", Email: "a@example.com"}}
+	tokens := &fakeResetTokenService{}
+	oauth := &fakeResetOAuthService{credentials: make(map[string]string)}
+	uc := NewEmailUseCase(zap.NewNop(), emailService, users, tokens, oauth)
+
+	if err := uc.RequestPasswordReset(ctx, "a@example.com"); err != nil {
+		t.Fatalf("RequestPasswordReset() error = %v", err)
+	}
+	if len(mailer.sent) != 1 {
+		t.Fatalf("발송한 메일 = %d, want 1", len(mailer.sent))
+	}
+	link, err := url.Parse(resetTokenPattern.FindString(mailer.sent[0].Body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := link.Query().Get("token")
+
+	// 비밀번호 변경에 실패하면 같은 토큰으로 다시 시도할 수 있다.
+	users.failUpdate = true
+	if _, err := uc.ResetPassword(ctx, token, "new-password"); !errors.Is(err, domain.ErrUnavailable) {
+		t.Fatalf("ResetPassword() error = %v, want %v", err, domain.ErrUnavailable)
+	}
+	if len(tokens.revoked) != 0 || len(oauth.credentials) != 0 {
+		t.Fatalf("실패한 재설정에서 세션 폐기 %v, 소셜 계정 비밀번호 변경 %v", tokens.revoked, oauth.credentials)
+	}
+
+	users.failUpdate = false
+	userID, err := uc.ResetPassword(ctx, token, "new-password")
+	if err != nil || userID != "user" {
+		t.Fatalf("ResetPassword() = %q, %v", userID, err)
+	}
+	if len(users.passwords) != 1 || users.passwords[0] != "new-password" {
+		t.Errorf("변경된 비밀번호 = %v", users.passwords)
+	}
+	if oauth.credentials["user"] != "new-password" {
+		t.Errorf("소셜 계정 비밀번호 = %q, want new-password", oauth.credentials["user"])
+	}
+	if len(tokens.revoked) != 1 {
+		t.Errorf("세션 폐기 = %v, want [user]", tokens.revoked)
+	}
+	if verified, _ := uc.IsEmailVerified(ctx, "user"); !verified {
+		t.Error("재설정 후 이메일이 인증되지 않았습니다")
+	}
+
+	// 성공한 이후에는 다시 사용할 수 없다.
+	if _, err := uc.ResetPassword(ctx, token, "other-password"); !errors.Is(err, domain.ErrEmailTokenInvalid) {
+		t.Errorf("ResetPassword() error = %v, want %v", err, domain.ErrEmailTokenInvalid)
+	}
+	if _, err := uc.ResetPassword(ctx, token+"x", "other-password"); !errors.Is(err, domain.ErrEmailTokenInvalid) {
+		t.Errorf("위조된 토큰 ResetPassword() error = %v, want %v", err, domain.ErrEmailTokenInvalid)
+	}
+}
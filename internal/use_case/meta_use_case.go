@@ -8,8 +8,9 @@ import (
 )
 
 type metaUseCase struct {
-	log *zap.Logger
-	svc domain.MetaService
+	log           *zap.Logger
+	svc           domain.MetaService
+	deviceService domain.DeviceService
 }
 
 func (uc *metaUseCase) GetSensorList(ctx context.Context) ([]*domain.Sensor, error) {
@@ -20,6 +21,10 @@ func (uc *metaUseCase) GetSensorByParam(ctx context.Context, in *domain.Sensor)
 	return uc.svc.GetSensorByParam(ctx, in)
 }
 
+func (uc *metaUseCase) DeriveSensorValues(ctx context.Context, values map[string]float64) (map[string]float64, error) {
+	return uc.svc.DeriveSensorValues(ctx, values)
+}
+
 func (uc *metaUseCase) GetCropList(ctx context.Context) ([]*domain.Crop, error) {
 	return uc.svc.GetCropList(ctx)
 }
@@ -28,6 +33,22 @@ func (uc *metaUseCase) GetCropByParam(ctx context.Context, in *domain.Crop) (*do
 	return uc.svc.GetCropByParam(ctx, in)
 }
 
+func (uc *metaUseCase) GetGrowingDegreeDays(ctx context.Context, in *domain.GrowingDegreeDaysParam) (*domain.GrowingDegreeDays, error) {
+	// 다른 사용자의 장치는 없는 장치로 처리한다.
+	device, err := uc.deviceService.GetDevice(ctx, in.UserID, in.DeviceID)
+	if err != nil {
+		return nil, err
+	}
+	if device.Crop == "" {
+		return nil, domain.ErrNotFound
+	}
+
+	// 작물은 장치에 등록된 작물의 기준 온도를 사용한다.
+	param := *in
+	param.Crop = device.Crop
+	return uc.svc.GetGrowingDegreeDays(ctx, &param)
+}
+
 func (uc *metaUseCase) GetUpdateCycleList(ctx context.Context) ([]*domain.UpdateCycle, error) {
 	return uc.svc.GetUpdateCycleList(ctx)
 }
@@ -40,9 +61,10 @@ func (uc *metaUseCase) GetAddressCityListByState(ctx context.Context, state stri
 	return uc.svc.GetAddressCityListByState(ctx, state)
 }
 
-func NewMetaUseCase(log *zap.Logger, metaService domain.MetaService) domain.MetaUseCase {
+func NewMetaUseCase(log *zap.Logger, metaService domain.MetaService, deviceService domain.DeviceService) domain.MetaUseCase {
 	return &metaUseCase{
-		log: log,
-		svc: metaService,
+		log:           log,
+		svc:           metaService,
+		deviceService: deviceService,
 	}
 }
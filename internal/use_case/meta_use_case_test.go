@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// fakeOwnerDeviceService "device" 는 "user" 의 토마토 장치, "empty" 는 작물이 없는 장치 입니다.
+type fakeOwnerDeviceService struct {
+	domain.DeviceService
+}
+
+func (s *fakeOwnerDeviceService) GetDevice(_ context.Context, userID string, deviceID string) (*domain.DeviceInfo, error) {
+	if userID != "user" {
+		return nil, domain.ErrNotFound
+	}
+	switch deviceID {
+	case "device":
+		return &domain.DeviceInfo{ID: deviceID, UserID: userID, Crop: "토마토"}, nil
+	case "empty":
+		return &domain.DeviceInfo{ID: deviceID, UserID: userID}, nil
+	}
+	return nil, domain.ErrNotFound
+}
+
+type fakeGrowingDegreeDaysService struct {
+	domain.MetaService
+	called int
+}
+
+func (s *fakeGrowingDegreeDaysService) GetGrowingDegreeDays(_ context.Context, in *domain.GrowingDegreeDaysParam) (*domain.GrowingDegreeDays, error) {
+	s.called++
+	return &domain.GrowingDegreeDays{Crop: in.Crop}, nil
+}
+
+func TestGetGrowingDegreeDaysOwner(t *testing.T) {
+	svc := &fakeGrowingDegreeDaysService{}
+	uc := NewMetaUseCase(zap.NewNop(), svc, &fakeOwnerDeviceService{})
+
+	tests := []struct {
+		name     string
+		userID   string
+		deviceID string
+		want     error
+		called   int
+	}{
+		{name: "본인 장치", userID: "user", deviceID: "device", want: nil, called: 1},
+		{name: "다른 사용자의 장치", userID: "other", deviceID: "device", want: domain.ErrNotFound, called: 0},
+		{name: "작물이 없는 장치", userID: "user", deviceID: "empty", want: domain.ErrNotFound, called: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc.called = 0
+			gdd, err := uc.GetGrowingDegreeDays(context.Background(), &domain.GrowingDegreeDaysParam{UserID: tt.userID, DeviceID: tt.deviceID})
+			if !errors.Is(err, tt.want) {
+				t.Errorf("GetGrowingDegreeDays() error = %v, want %v", err, tt.want)
+			}
+			if svc.called != tt.called {
+				t.Errorf("생육 도일 계산 = %d, want %d", svc.called, tt.called)
+			}
+			// 장치에 등록된 작물로 계산한다.
+			if err == nil && gdd.Crop != "토마토" {
+				t.Errorf("Crop = %q, want 토마토", gdd.Crop)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+// oauthNameAttempts 닉네임이 중복될 때 접미사를 붙여 다시 시도하는 횟수
+const oauthNameAttempts = 5
+
+type oauthUseCase struct {
+	svc          domain.OAuthService
+	emailService domain.EmailService
+	userUseCase  domain.UserUseCase
+	authUseCase  domain.AuthUseCase
+	log          *zap.Logger
+}
+
+func (uc *oauthUseCase) GetOAuthProviderList() []string {
+	return uc.svc.ProviderList()
+}
+
+func (uc *oauthUseCase) GetOAuthAuthorizationURL(ctx context.Context, provider string, redirectURL string) (string, string, error) {
+	return uc.svc.AuthCodeURL(ctx, provider, redirectURL)
+}
+
+// OAuthLogin
+//
+// 인증 서버는 이메일, 비밀번호 로그인만 지원하므로 소셜 전용 계정은 서버에서 생성한 비밀번호로 로그인합니다.
+// 같은 이메일의 비밀번호 계정이 있으면 계정을 탈취할 수 없도록 연결하지 않고 ErrOAuthEmailConflict 를 반환합니다.
+func (uc *oauthUseCase) OAuthLogin(ctx context.Context, provider string, code string, state string) (*domain.Token, error) {
+	info, err := uc.svc.Exchange(ctx, provider, code, state)
+	if err != nil {
+		return nil, err
+	}
+
+	var userID string
+	identity, err := uc.svc.GetOAuthIdentity(ctx, provider, info.Subject)
+	switch {
+	case err == nil:
+		userID = identity.UserID
+	case errors.Is(err, domain.ErrNotFound):
+		userID, err = uc.link(ctx, provider, info)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	password, err := uc.svc.GetCredential(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrOAuthEmailConflict
+		}
+		return nil, err
+	}
+
+	// 가입 이후 이메일이 변경되었을 수 있으므로 현재 이메일로 로그인한다.
+	user, err := uc.userUseCase.GetUserInfoByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2단계 인증이 필요한 경우에도 소셜 계정 인증은 완료되었으므로 로그인 시각을 갱신한다.
+	token, err := uc.authUseCase.Login(ctx, user.Email, password)
+	if err != nil && !errors.Is(err, domain.ErrTwoFactorChallenge) {
+		return nil, err
+	}
+
+	if touchErr := uc.svc.TouchOAuthIdentity(ctx, provider, info.Subject); touchErr != nil {
+		requestid.Logger(ctx, uc.log).Warn("소셜 계정 로그인 시각 갱신 실패", zap.Error(touchErr))
+	}
+
+	return token, err
+}
+
+// link 같은 이메일의 소셜 전용 계정에 연결하거나 새 계정을 생성합니다.
+//
+// 제공자가 이메일 인증 여부를 알려주지 않거나 인증되지 않은 이메일이면 다른 사람의 계정에 연결될 수 있으므로
+// 기존 계정에 연결하지도, 새 계정을 만들지도 않는다.
+func (uc *oauthUseCase) link(ctx context.Context, provider string, info *domain.OAuthUserInfo) (string, error) {
+	if info.Email == "" || !info.EmailVerified {
+		return "", fmt.Errorf("%w: 인증된 이메일 제공 동의가 필요합니다", domain.ErrOAuthInvalidRequest)
+	}
+
+	user, err := uc.userUseCase.GetUserInfoByEmail(ctx, info.Email)
+	switch {
+	case err == nil:
+		if _, err := uc.svc.GetCredential(ctx, user.ID); err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return "", domain.ErrOAuthEmailConflict
+			}
+			return "", err
+		}
+	case errors.Is(err, domain.ErrNotFound):
+		user, err = uc.createUser(ctx, info)
+		if err != nil {
+			return "", err
+		}
+	default:
+		return "", err
+	}
+
+	if err := uc.svc.LinkOAuthIdentity(ctx, &domain.OAuthIdentity{
+		Provider: provider,
+		Subject:  info.Subject,
+		UserID:   user.ID,
+		Email:    info.Email,
+	}); err != nil {
+		return "", err
+	}
+	requestid.Logger(ctx, uc.log).Info("소셜 계정 연결",
+		zap.String("provider", provider),
+		zap.String("user_id", user.ID),
+	)
+
+	// 제공자가 인증한 이메일이므로 이메일 인증을 생략한다.
+	if uc.emailService != nil && strings.EqualFold(user.Email, info.Email) {
+		if err := uc.emailService.SetEmailVerified(ctx, user.ID, user.Email); err != nil {
+			requestid.Logger(ctx, uc.log).Warn("소셜 계정 이메일 인증 실패", zap.Error(err))
+		}
+	}
+
+	return user.ID, nil
+}
+
+func (uc *oauthUseCase) createUser(ctx context.Context, info *domain.OAuthUserInfo) (*domain.User, error) {
+	name, err := uc.availableName(ctx, info.Name)
+	if err != nil {
+		return nil, err
+	}
+	password, err := uc.svc.NewCredential()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.userUseCase.CreateUser(ctx, &domain.User{
+		Name:     name,
+		Email:    info.Email,
+		Password: password,
+		Role:     domain.UserRoleUser,
+	}); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userUseCase.GetUserInfoByEmail(ctx, info.Email)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.svc.SaveCredential(ctx, user.ID, password); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// availableName 제공자의 이름이 사용중이거나 3자 미만이면 접미사를 붙인 닉네임을 찾는다.
+func (uc *oauthUseCase) availableName(ctx context.Context, name string) (string, error) {
+	if utf8.RuneCountInString(name) < 3 {
+		name = "user" + name
+	}
+
+	candidate := name
+	for range oauthNameAttempts {
+		err := uc.userUseCase.CheckCreateUser(ctx, "", candidate)
+		if err == nil {
+			return candidate, nil
+		}
+		if errors.Is(err, domain.ErrUnavailable) {
+			return "", err
+		}
+		candidate = fmt.Sprintf("%s_%04d", name, rand.IntN(10000))
+	}
+
+	return "", fmt.Errorf("사용 가능한 닉네임을 찾지 못했습니다: %s", name)
+}
+
+// NewOAuthUseCase emailService 가 nil 이면 이메일 인증 여부를 기록하지 않습니다.
+func NewOAuthUseCase(logger *zap.Logger, svc domain.OAuthService, emailService domain.EmailService, userUseCase domain.UserUseCase, authUseCase domain.AuthUseCase) domain.OAuthUseCase {
+	return &oauthUseCase{
+		svc:          svc,
+		emailService: emailService,
+		userUseCase:  userUseCase,
+		authUseCase:  authUseCase,
+		log:          logger,
+	}
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+// fakeOAuthService 제공자가 info 를 응답하고 연결된 소셜 계정이 없는 상태 입니다.
+type fakeOAuthService struct {
+	domain.OAuthService
+	info   *domain.OAuthUserInfo
+	linked []*domain.OAuthIdentity
+}
+
+func (s *fakeOAuthService) Exchange(context.Context, string, string, string) (*domain.OAuthUserInfo, error) {
+	return s.info, nil
+}
+
+func (s *fakeOAuthService) GetOAuthIdentity(context.Context, string, string) (*domain.OAuthIdentity, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (s *fakeOAuthService) LinkOAuthIdentity(_ context.Context, identity *domain.OAuthIdentity) error {
+	s.linked = append(s.linked, identity)
+	return nil
+}
+
+func (s *fakeOAuthService) TouchOAuthIdentity(context.Context, string, string) error {
+	return nil
+}
+
+func (s *fakeOAuthService) GetCredential(context.Context, string) (string, error) {
+	return "password", nil
+}
+
+// fakeOAuthUserUseCase 같은 이메일의 소셜 전용 계정이 있는 상태 입니다.
+type fakeOAuthUserUseCase struct {
+	domain.UserUseCase
+}
+
+func (u *fakeOAuthUserUseCase) GetUserInfoByEmail(_ context.Context, email string) (*domain.User, error) {
+	return &domain.User{ID: "user", Email: email}, nil
+}
+
+func (u *fakeOAuthUserUseCase) GetUserInfoByUserID(_ context.Context, userID string) (*domain.User, error) {
+	return &domain.User{ID: userID, Email: "a@example.com"}, nil
+}
+
+type fakeOAuthAuthUseCase struct {
+	domain.AuthUseCase
+}
+
+func (a *fakeOAuthAuthUseCase) Login(context.Context, string, string) (*domain.Token, error) {
+	return &domain.Token{AccessToken: "access"}, nil
+}
+
+func TestOAuthLoginLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     *domain.OAuthUserInfo
+		wantLink bool
+	}{
+		{
+			name:     "인증된 이메일은 같은 이메일의 소셜 계정에 연결",
+			info:     &domain.OAuthUserInfo{Subject: "1", Email: "a@example.com", EmailVerified: true},
+			wantLink: true,
+		},
+		{
+			name: "인증되지 않은 이메일은 연결하지 않음",
+			info: &domain.OAuthUserInfo{Subject: "1", Email: "a@example.com"},
+		},
+		{
+			name: "이메일이 없으면 연결하지 않음",
+			info: &domain.OAuthUserInfo{Subject: "1", EmailVerified: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeOAuthService{info: tt.info}
+			uc := NewOAuthUseCase(zap.NewNop(), svc, nil, &fakeOAuthUserUseCase{}, &fakeOAuthAuthUseCase{})
+
+			token, err := uc.OAuthLogin(context.Background(), "kakao", "code", "state")
+			if tt.wantLink {
+				if err != nil || token == nil {
+					t.Fatalf("OAuthLogin() = %v, %v", token, err)
+				}
+				if len(svc.linked) != 1 || svc.linked[0].UserID != "user" {
+					t.Errorf("linked = %+v, want user", svc.linked)
+				}
+				return
+			}
+
+			if !errors.Is(err, domain.ErrOAuthInvalidRequest) {
+				t.Errorf("OAuthLogin() error = %v, want %v", err, domain.ErrOAuthInvalidRequest)
+			}
+			if len(svc.linked) != 0 {
+				t.Errorf("linked = %+v, want none", svc.linked)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+type personalAccessTokenUseCase struct {
+	personalAccessTokenService domain.PersonalAccessTokenService
+	suspensionService          domain.UserSuspensionService
+	log                        *zap.Logger
+}
+
+func (uc *personalAccessTokenUseCase) CreatePersonalAccessToken(ctx context.Context, userID string, name string, scopes []domain.TokenScope, expiresAt time.Time) (*domain.PersonalAccessToken, string, error) {
+	t, token, err := uc.personalAccessTokenService.CreatePersonalAccessToken(ctx, userID, name, scopes, expiresAt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	requestid.Logger(ctx, uc.log).Info("개인 액세스 토큰 생성", zap.String("user_id", userID), zap.String("token_id", t.ID))
+	return t, token, nil
+}
+
+// ValidatePersonalAccessToken
+//
+// 정지된 계정의 토큰은 폐기하지 않고 정지가 해제될 때까지 거절합니다.
+func (uc *personalAccessTokenUseCase) ValidatePersonalAccessToken(ctx context.Context, token string) (*domain.PersonalAccessToken, error) {
+	t, err := uc.personalAccessTokenService.ValidatePersonalAccessToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.suspensionService.CheckUser(ctx, t.UserID); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (uc *personalAccessTokenUseCase) GetPersonalAccessTokenList(ctx context.Context, userID string) ([]*domain.PersonalAccessToken, error) {
+	return uc.personalAccessTokenService.GetPersonalAccessTokenList(ctx, userID)
+}
+
+func (uc *personalAccessTokenUseCase) RevokePersonalAccessToken(ctx context.Context, userID string, tokenID string) error {
+	if err := uc.personalAccessTokenService.DeletePersonalAccessToken(ctx, userID, tokenID); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx, uc.log).Info("개인 액세스 토큰 폐기", zap.String("user_id", userID), zap.String("token_id", tokenID))
+	return nil
+}
+
+func NewPersonalAccessTokenUseCase(logger *zap.Logger, personalAccessTokenService domain.PersonalAccessTokenService, suspensionService domain.UserSuspensionService) domain.PersonalAccessTokenUseCase {
+	return &personalAccessTokenUseCase{
+		personalAccessTokenService: personalAccessTokenService,
+		suspensionService:          suspensionService,
+		log:                        logger,
+	}
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+type retentionUseCase struct {
+	log *zap.Logger
+	svc domain.RetentionService
+}
+
+func (uc *retentionUseCase) GetRetentionPolicyList(ctx context.Context) ([]*domain.RetentionPolicy, error) {
+	return uc.svc.GetRetentionPolicyList(ctx)
+}
+
+func (uc *retentionUseCase) PutRetentionPolicy(ctx context.Context, policy *domain.RetentionPolicy) error {
+	return uc.svc.PutRetentionPolicy(ctx, policy)
+}
+
+func (uc *retentionUseCase) DeleteRetentionPolicy(ctx context.Context, tier string) error {
+	return uc.svc.DeleteRetentionPolicy(ctx, tier)
+}
+
+func (uc *retentionUseCase) SetDeviceRetentionTier(ctx context.Context, deviceID string, tier string) error {
+	return uc.svc.SetDeviceRetentionTier(ctx, deviceID, tier)
+}
+
+func (uc *retentionUseCase) PreviewRetentionPolicy(ctx context.Context, policy *domain.RetentionPolicy) ([]*domain.RetentionItem, error) {
+	return uc.svc.PreviewRetentionPolicy(ctx, policy)
+}
+
+func (uc *retentionUseCase) GetRetentionRunList(ctx context.Context) ([]*domain.RetentionRun, error) {
+	return uc.svc.GetRetentionRunList(ctx)
+}
+
+func NewRetentionUseCase(log *zap.Logger, retentionService domain.RetentionService) domain.RetentionUseCase {
+	return &retentionUseCase{
+		log: log,
+		svc: retentionService,
+	}
+}
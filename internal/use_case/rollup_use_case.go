@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+type rollupUseCase struct {
+	log           *zap.Logger
+	svc           domain.RollupService
+	deviceService domain.DeviceService
+}
+
+func (uc *rollupUseCase) BackfillRollup(ctx context.Context, deviceID string, from, to time.Time) (int64, error) {
+	return uc.svc.BackfillRollup(ctx, deviceID, from, to)
+}
+
+func (uc *rollupUseCase) GetRollupStatus(ctx context.Context) (*domain.RollupStatus, error) {
+	return uc.svc.GetRollupStatus(ctx)
+}
+
+func (uc *rollupUseCase) GetReadingSeries(ctx context.Context, q *domain.ReadingQuery) (*domain.ReadingSeries, error) {
+	return uc.svc.GetReadingSeries(ctx, q)
+}
+
+func (uc *rollupUseCase) GetDeviceReadingSeries(ctx context.Context, userID string, q *domain.ReadingQuery) (*domain.ReadingSeries, error) {
+	// 다른 사용자의 장치는 없는 장치로 처리한다.
+	if _, err := uc.deviceService.GetDevice(ctx, userID, q.DeviceID); err != nil {
+		return nil, err
+	}
+	return uc.svc.GetReadingSeries(ctx, q)
+}
+
+func NewRollupUseCase(log *zap.Logger, rollupService domain.RollupService, deviceService domain.DeviceService) domain.RollupUseCase {
+	return &rollupUseCase{
+		log:           log,
+		svc:           rollupService,
+		deviceService: deviceService,
+	}
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+type fakeReadingSeriesService struct {
+	domain.RollupService
+	called int
+}
+
+func (s *fakeReadingSeriesService) GetReadingSeries(_ context.Context, q *domain.ReadingQuery) (*domain.ReadingSeries, error) {
+	s.called++
+	return &domain.ReadingSeries{DeviceID: q.DeviceID, SensorID: q.SensorID}, nil
+}
+
+func TestGetDeviceReadingSeriesOwner(t *testing.T) {
+	svc := &fakeReadingSeriesService{}
+	uc := NewRollupUseCase(zap.NewNop(), svc, &fakeOwnerDeviceService{})
+
+	tests := []struct {
+		name   string
+		userID string
+		want   error
+		called int
+	}{
+		{name: "본인 장치", userID: "user", want: nil, called: 1},
+		{name: "다른 사용자의 장치", userID: "other", want: domain.ErrNotFound, called: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc.called = 0
+			_, err := uc.GetDeviceReadingSeries(context.Background(), tt.userID, &domain.ReadingQuery{DeviceID: "device", SensorID: 1})
+			if !errors.Is(err, tt.want) {
+				t.Errorf("GetDeviceReadingSeries() error = %v, want %v", err, tt.want)
+			}
+			if svc.called != tt.called {
+				t.Errorf("센서 데이터 조회 = %d, want %d", svc.called, tt.called)
+			}
+		})
+	}
+}
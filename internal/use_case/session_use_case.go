@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+type sessionUseCase struct {
+	sessionService domain.SessionService
+	log            *zap.Logger
+}
+
+func (uc *sessionUseCase) GetSessionList(ctx context.Context, userID string, currentToken string) ([]*domain.Session, error) {
+	return uc.sessionService.GetSessionList(ctx, userID, currentToken)
+}
+
+func (uc *sessionUseCase) RevokeSession(ctx context.Context, userID string, sessionID string) error {
+	if err := uc.sessionService.RevokeSession(ctx, userID, sessionID); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx, uc.log).Info("세션 폐기", zap.String("user_id", userID), zap.String("session_id", sessionID))
+	return nil
+}
+
+func (uc *sessionUseCase) RevokeOtherSessions(ctx context.Context, userID string, currentToken string) (int64, error) {
+	revoked, err := uc.sessionService.RevokeOtherSessions(ctx, userID, currentToken)
+	if err != nil {
+		return 0, err
+	}
+
+	requestid.Logger(ctx, uc.log).Info("다른 세션 전체 폐기", zap.String("user_id", userID), zap.Int64("revoked", revoked))
+	return revoked, nil
+}
+
+func NewSessionUseCase(logger *zap.Logger, sessionService domain.SessionService) domain.SessionUseCase {
+	return &sessionUseCase{
+		sessionService: sessionService,
+		log:            logger,
+	}
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
+	"go.uber.org/zap"
+)
+
+type twoFactorUseCase struct {
+	twoFactorService domain.TwoFactorService
+	userUseCase      domain.UserUseCase
+	lockoutService   domain.LockoutService
+	log              *zap.Logger
+}
+
+func (uc *twoFactorUseCase) GetTwoFactorStatus(ctx context.Context, userID string, role domain.UserRole) (*domain.TwoFactorStatus, error) {
+	return uc.twoFactorService.GetStatus(ctx, userID, role)
+}
+
+// BeginTwoFactorEnrollment
+//
+// OTP 앱에는 사용자의 이메일을 계정 이름으로 표시합니다. 확인 전에 다시 요청하면 새로운 비밀키로 교체합니다.
+func (uc *twoFactorUseCase) BeginTwoFactorEnrollment(ctx context.Context, userID string) (*domain.TwoFactorEnrollment, error) {
+	user, err := uc.userUseCase.GetUserInfoByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.twoFactorService.BeginEnrollment(ctx, userID, user.Email)
+}
+
+func (uc *twoFactorUseCase) ConfirmTwoFactorEnrollment(ctx context.Context, userID string, code string) ([]string, error) {
+	codes, err := uc.twoFactorService.ConfirmEnrollment(ctx, userID, code)
+	if err != nil {
+		return nil, err
+	}
+
+	requestid.Logger(ctx, uc.log).Info("2단계 인증 사용", zap.String("user_id", userID))
+	return codes, nil
+}
+
+func (uc *twoFactorUseCase) DisableTwoFactor(ctx context.Context, userID string, code string) error {
+	if err := uc.verify(ctx, userID, func() error {
+		return uc.twoFactorService.Disable(ctx, userID, code)
+	}); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx, uc.log).Info("2단계 인증 해제", zap.String("user_id", userID))
+	return nil
+}
+
+func (uc *twoFactorUseCase) RegenerateRecoveryCodes(ctx context.Context, userID string, code string) ([]string, error) {
+	var codes []string
+	err := uc.verify(ctx, userID, func() (err error) {
+		codes, err = uc.twoFactorService.RegenerateRecoveryCodes(ctx, userID, code)
+		return err
+	})
+
+	return codes, err
+}
+
+func (uc *twoFactorUseCase) GetTwoFactorRequiredRoleList(ctx context.Context) ([]domain.UserRole, error) {
+	return uc.twoFactorService.GetRequiredRoleList(ctx)
+}
+
+// SetTwoFactorRequiredRoleList
+//
+// 설정한 관리자가 바로 관리자 API 를 사용할 수 없게 되지 않도록, 관리자 권한에 요구하려면 본인이 먼저 2단계 인증을 사용해야 합니다.
+func (uc *twoFactorUseCase) SetTwoFactorRequiredRoleList(ctx context.Context, roles []domain.UserRole, adminID string) error {
+	if slices.Contains(roles, domain.UserRoleAdmin) {
+		enabled, err := uc.twoFactorService.IsEnabled(ctx, adminID)
+		if err != nil {
+			return err
+		}
+		if !enabled {
+			return domain.ErrTwoFactorNotEnabled
+		}
+	}
+
+	if err := uc.twoFactorService.SetRequiredRoleList(ctx, roles, adminID); err != nil {
+		return err
+	}
+
+	requestid.Logger(ctx, uc.log).Info("2단계 인증 필수 권한 변경", zap.String("admin_id", adminID), zap.Any("roles", roles))
+	return nil
+}
+
+func (uc *twoFactorUseCase) CheckTwoFactorRequirement(ctx context.Context, userID string, role domain.UserRole) error {
+	return uc.twoFactorService.CheckRole(ctx, userID, role)
+}
+
+// verify
+//
+// 로그인 이후에도 인증 코드를 추측할 수 없도록 로그인 2단계 인증과 같은 사용자별 실패 기록을 사용합니다.
+func (uc *twoFactorUseCase) verify(ctx context.Context, userID string, fn func() error) error {
+	if err := uc.lockoutService.CheckTwoFactor(ctx, userID); err != nil {
+		return err
+	}
+
+	err := fn()
+	switch {
+	case err == nil:
+		if resetErr := uc.lockoutService.RecordTwoFactorSuccess(ctx, userID); resetErr != nil {
+			requestid.Logger(ctx, uc.log).Warn("2단계 인증 실패 기록 초기화 오류", zap.Error(resetErr))
+		}
+	case errors.Is(err, domain.ErrTwoFactorInvalidCode):
+		if recordErr := uc.lockoutService.RecordTwoFactorFailure(ctx, userID); recordErr != nil {
+			requestid.Logger(ctx, uc.log).Warn("2단계 인증 실패 기록 오류", zap.Error(recordErr))
+		}
+	}
+
+	return err
+}
+
+func NewTwoFactorUseCase(logger *zap.Logger, twoFactorService domain.TwoFactorService, userUseCase domain.UserUseCase, lockoutService domain.LockoutService) domain.TwoFactorUseCase {
+	return &twoFactorUseCase{
+		twoFactorService: twoFactorService,
+		userUseCase:      userUseCase,
+		lockoutService:   lockoutService,
+		log:              logger,
+	}
+}
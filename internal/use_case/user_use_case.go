@@ -4,12 +4,14 @@ import (
 	"context"
 
 	"github.com/GDH-Project/api/internal/domain"
+	"github.com/GDH-Project/api/internal/requestid"
 	"go.uber.org/zap"
 )
 
 type userUseCase struct {
-	svc domain.UserService
-	log *zap.Logger
+	svc          domain.UserService
+	oauthService domain.OAuthService
+	log          *zap.Logger
 }
 
 func (uc *userUseCase) CheckCreateUser(ctx context.Context, email string, name string) error {
@@ -29,16 +31,30 @@ func (uc *userUseCase) CreateUser(ctx context.Context, user *domain.User) error
 }
 
 func (uc *userUseCase) UpdateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
-	return uc.svc.UpdateUser(ctx, user)
+	updated, err := uc.svc.UpdateUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	// 소셜 전용 계정은 저장된 비밀번호로 로그인하므로 함께 변경한다.
+	if user.Password != "" && uc.oauthService != nil {
+		if err := uc.oauthService.UpdateCredential(ctx, user.ID, user.Password); err != nil {
+			requestid.Logger(ctx, uc.log).Error("비밀번호 변경 후 소셜 계정 비밀번호 변경 실패", zap.Error(err), zap.String("user_id", user.ID))
+		}
+	}
+
+	return updated, nil
 }
 
 func (uc *userUseCase) DeleteUser(ctx context.Context, id string, password string) error {
 	return uc.svc.DeleteUser(ctx, id, password)
 }
 
-func NewUserUseCase(logger *zap.Logger, svc domain.UserService) domain.UserUseCase {
+// NewUserUseCase oauthService 는 소셜 로그인을 사용하지 않으면 nil 입니다.
+func NewUserUseCase(logger *zap.Logger, svc domain.UserService, oauthService domain.OAuthService) domain.UserUseCase {
 	return &userUseCase{
-		svc: svc,
-		log: logger,
+		svc:          svc,
+		oauthService: oauthService,
+		log:          logger,
 	}
 }
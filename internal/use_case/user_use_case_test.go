@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GDH-Project/api/internal/domain"
+	"go.uber.org/zap"
+)
+
+type fakeUpdateUserService struct {
+	domain.UserService
+}
+
+func (s *fakeUpdateUserService) UpdateUser(_ context.Context, user *domain.User) (*domain.User, error) {
+	return &domain.User{ID: user.ID, Name: user.Name}, nil
+}
+
+func TestUpdateUserOAuthCredential(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		want     map[string]string
+	}{
+		{name: "비밀번호 변경", password: "change_password", want: map[string]string{"user": "change_password"}},
+		{name: "닉네임만 변경", password: "", want: map[string]string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oauth := &fakeResetOAuthService{credentials: make(map[string]string)}
+			uc := NewUserUseCase(zap.NewNop(), &fakeUpdateUserService{}, oauth)
+
+			if _, err := uc.UpdateUser(context.Background(), &domain.User{ID: "user", Name: "사용자", Password: tt.password}); err != nil {
+				t.Fatalf("UpdateUser() error = %v", err)
+			}
+			if len(oauth.credentials) != len(tt.want) || oauth.credentials["user"] != tt.want["user"] {
+				t.Errorf("소셜 계정 비밀번호 = %v, want %v", oauth.credentials, tt.want)
+			}
+		})
+	}
+
+	// 소셜 로그인을 사용하지 않으면 비밀번호만 변경한다.
+	uc := NewUserUseCase(zap.NewNop(), &fakeUpdateUserService{}, nil)
+	if _, err := uc.UpdateUser(context.Background(), &domain.User{ID: "user", Password: "change_password"}); err != nil {
+		t.Errorf("UpdateUser() error = %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package useragent
+
+import (
+	"strings"
+)
+
+// Unknown 브라우저, 기기를 알 수 없는 경우 입니다.
+const Unknown = "알 수 없음"
+
+// client User-Agent 에 포함된 토큰과 표시할 이름 입니다. 앞에서부터 먼저 일치하는 것을 사용한다.
+var clients = []struct {
+	token string
+	name  string
+}{
+	{"KAKAOTALK", "카카오톡"},
+	{"NAVER(inapp", "네이버 앱"},
+	{"SamsungBrowser/", "Samsung Internet"},
+	{"Whale/", "Whale"},
+	{"Edg/", "Edge"},
+	{"OPR/", "Opera"},
+	{"Firefox/", "Firefox"},
+	{"CriOS/", "Chrome"},
+	{"Chrome/", "Chrome"},
+	{"Version/", "Safari"},
+	{"curl/", "curl"},
+	{"Wget/", "Wget"},
+	{"python-requests/", "Python"},
+	{"Go-http-client/", "Go"},
+	{"okhttp/", "OkHttp"},
+	{"PostmanRuntime/", "Postman"},
+}
+
+var platforms = []struct {
+	token string
+	name  string
+}{
+	{"iPhone", "iOS"},
+	{"iPad", "iPadOS"},
+	{"Android", "Android"},
+	{"Windows", "Windows"},
+	{"Mac OS X", "macOS"},
+	{"CrOS", "ChromeOS"},
+	{"Linux", "Linux"},
+}
+
+// Describe
+//
+// User-Agent 로 브라우저와 운영체제를 추정합니다. (예: Chrome (Windows))
+// 세션 목록 표시용으로 정확하지 않을 수 있습니다.
+func Describe(userAgent string) string {
+	var client, platform string
+	for _, c := range clients {
+		if strings.Contains(userAgent, c.token) {
+			client = c.name
+			break
+		}
+	}
+	for _, p := range platforms {
+		if strings.Contains(userAgent, p.token) {
+			platform = p.name
+			break
+		}
+	}
+
+	switch {
+	case client != "" && platform != "":
+		return client + " (" + platform + ")"
+	case client != "":
+		return client
+	case platform != "":
+		return platform
+	}
+
+	return Unknown
+}
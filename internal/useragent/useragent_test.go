@@ -0,0 +1,75 @@
+package useragent
+
+import "testing"
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent string
+		want      string
+	}{
+		{
+			name:      "Chrome Windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36",
+			want:      "Chrome (Windows)",
+		},
+		{
+			name:      "Edge 는 Chrome 보다 먼저",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36 Edg/126.0.0.0",
+			want:      "Edge (Windows)",
+		},
+		{
+			name:      "Safari macOS",
+			userAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_5) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Safari/605.1.15",
+			want:      "Safari (macOS)",
+		},
+		{
+			name:      "iOS Chrome",
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/126.0.6478.54 Mobile/15E148 Safari/604.1",
+			want:      "Chrome (iOS)",
+		},
+		{
+			name:      "Samsung Internet Android",
+			userAgent: "Mozilla/5.0 (Linux; Android 14; SM-S921N) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/25.0 Chrome/121.0.0.0 Mobile Safari/537.36",
+			want:      "Samsung Internet (Android)",
+		},
+		{
+			name:      "카카오톡 인앱",
+			userAgent: "Mozilla/5.0 (Linux; Android 14; SM-S921N) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Mobile Safari/537.36 KAKAOTALK 10.8.0",
+			want:      "카카오톡 (Android)",
+		},
+		{
+			name:      "Firefox Linux",
+			userAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:127.0) Gecko/20100101 Firefox/127.0",
+			want:      "Firefox (Linux)",
+		},
+		{
+			name:      "ChromeOS 는 Linux 보다 먼저",
+			userAgent: "Mozilla/5.0 (X11; CrOS x86_64 14541.0.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36",
+			want:      "Chrome (ChromeOS)",
+		},
+		{
+			name:      "curl",
+			userAgent: "curl/8.7.1",
+			want:      "curl",
+		},
+		{
+			name:      "운영체제만",
+			userAgent: "Mozilla/5.0 (Linux; Android 14)",
+			want:      "Android",
+		},
+		{
+			name:      "빈 값",
+			userAgent: "",
+			want:      Unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Describe(tt.userAgent); got != tt.want {
+				t.Errorf("Describe() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}